@@ -34,31 +34,33 @@ import (
 // AgentSpec defines the desired state of the Agent and includes all relevant information required to fully configure
 // the switch and manage its lifecycle. It is not intended to be used directly by users.
 type AgentSpec struct {
-	Role                 wiringapi.SwitchRole                     `json:"role,omitempty"`
-	Description          string                                   `json:"description,omitempty"`
-	Config               AgentSpecConfig                          `json:"config,omitempty"`
-	Alloy                meta.AlloyConfig                         `json:"alloy,omitempty"` // TODO ignored, remove in future releases
-	Version              AgentVersion                             `json:"version,omitempty"`
-	Users                []UserCreds                              `json:"users,omitempty"`
-	Switch               wiringapi.SwitchSpec                     `json:"switch,omitempty"`
-	SwitchProfile        *wiringapi.SwitchProfileSpec             `json:"switchProfile,omitempty"`
-	Switches             map[string]wiringapi.SwitchSpec          `json:"switches,omitempty"`
-	RedundancyGroupPeers []string                                 `json:"redundancyGroupPeers,omitempty"`
-	Connections          map[string]wiringapi.ConnectionSpec      `json:"connections,omitempty"`
-	VPCs                 map[string]vpcapi.VPCSpec                `json:"vpcs,omitempty"`
-	VPCAttachments       map[string]VPCAttachmentSpecAnn          `json:"vpcAttachments,omitempty"`
-	VPCPeerings          map[string]vpcapi.VPCPeeringSpec         `json:"vpcPeers,omitempty"`
-	IPv4Namespaces       map[string]vpcapi.IPv4NamespaceSpec      `json:"ipv4Namespaces,omitempty"`
-	VLANNamespaces       map[string]wiringapi.VLANNamespaceSpec   `json:"vlanNamespaces,omitempty"`
-	Externals            map[string]vpcapi.ExternalSpec           `json:"externals,omitempty"`
-	ExternalAttachments  map[string]vpcapi.ExternalAttachmentSpec `json:"externalAttachments,omitempty"`
-	ExternalPeerings     map[string]vpcapi.ExternalPeeringSpec    `json:"externalPeerings,omitempty"`
-	ConfiguredVPCSubnets map[string]bool                          `json:"configuredVPCSubnets,omitempty"`
-	AttachedVPCs         map[string]bool                          `json:"attachedVPCs,omitempty"`
-	Reinstall            string                                   `json:"reinstall,omitempty"`  // set to InstallID to reinstall NOS
-	Reboot               string                                   `json:"reboot,omitempty"`     // set to RunID to reboot
-	PowerReset           string                                   `json:"powerReset,omitempty"` // set to RunID to power reset
-	Catalog              CatalogSpec                              `json:"catalog,omitempty"`
+	Role                 wiringapi.SwitchRole                   `json:"role,omitempty"`
+	Description          string                                 `json:"description,omitempty"`
+	Config               AgentSpecConfig                        `json:"config,omitempty"`
+	Alloy                meta.AlloyConfig                       `json:"alloy,omitempty"` // TODO ignored, remove in future releases
+	Version              AgentVersion                           `json:"version,omitempty"`
+	Users                []UserCreds                            `json:"users,omitempty"`
+	Switch               wiringapi.SwitchSpec                   `json:"switch,omitempty"`
+	SwitchProfile        *wiringapi.SwitchProfileSpec           `json:"switchProfile,omitempty"`
+	Switches             map[string]wiringapi.SwitchSpec        `json:"switches,omitempty"`
+	RedundancyGroupPeers []string                               `json:"redundancyGroupPeers,omitempty"`
+	Connections          map[string]wiringapi.ConnectionSpec    `json:"connections,omitempty"`
+	VPCs                 map[string]vpcapi.VPCSpec              `json:"vpcs,omitempty"`
+	VPCAttachments       map[string]VPCAttachmentSpecAnn        `json:"vpcAttachments,omitempty"`
+	VPCPeerings          map[string]vpcapi.VPCPeeringSpec       `json:"vpcPeers,omitempty"`
+	SecurityPolicies     map[string]vpcapi.SecurityPolicySpec   `json:"securityPolicies,omitempty"`
+	IPv4Namespaces       map[string]vpcapi.IPv4NamespaceSpec    `json:"ipv4Namespaces,omitempty"`
+	VLANNamespaces       map[string]wiringapi.VLANNamespaceSpec `json:"vlanNamespaces,omitempty"`
+	MirrorSessions       map[string]wiringapi.MirrorSessionSpec `json:"mirrorSessions,omitempty"`
+	Externals            map[string]vpcapi.ExternalSpec         `json:"externals,omitempty"`
+	ExternalAttachments  map[string]ExternalAttachmentSpecCreds `json:"externalAttachments,omitempty"`
+	ExternalPeerings     map[string]vpcapi.ExternalPeeringSpec  `json:"externalPeerings,omitempty"`
+	ConfiguredVPCSubnets map[string]bool                        `json:"configuredVPCSubnets,omitempty"`
+	AttachedVPCs         map[string]bool                        `json:"attachedVPCs,omitempty"`
+	Reinstall            string                                 `json:"reinstall,omitempty"`  // set to InstallID to reinstall NOS
+	Reboot               string                                 `json:"reboot,omitempty"`     // set to RunID to reboot
+	PowerReset           string                                 `json:"powerReset,omitempty"` // set to RunID to power reset
+	Catalog              CatalogSpec                            `json:"catalog,omitempty"`
 
 	// TODO impl
 	StatusUpdates []ApplyStatusUpdate `json:"statusUpdates,omitempty"`
@@ -69,30 +71,62 @@ type VPCAttachmentSpecAnn struct {
 	Annotations              map[string]string `json:"annotations,omitempty"`
 }
 
+// ExternalAttachmentSpecCreds is the ExternalAttachmentSpec with the credentials resolved from the referenced Secrets
+type ExternalAttachmentSpecCreds struct {
+	vpcapi.ExternalAttachmentSpec `json:",inline"`
+	// NeighborPassword is the BGP neighbor password read from the Secret referenced in the neighbor passwordSecret
+	NeighborPassword string `json:"neighborPassword,omitempty"`
+}
+
 type AgentSpecConfig struct {
-	DeploymentID          string                    `json:"deploymentID,omitempty"`
-	ControlVIP            string                    `json:"controlVIP,omitempty"`
-	VPCPeeringDisabled    bool                      `json:"vpcPeeringDisabled,omitempty"`
-	SpineLeaf             *AgentSpecConfigSpineLeaf `json:"spineLeaf,omitempty"`
-	BaseVPCCommunity      string                    `json:"baseVPCCommunity,omitempty"`
-	VPCLoopbackSubnet     string                    `json:"vpcLoopbackSubnet,omitempty"`
-	FabricMTU             uint16                    `json:"fabricMTU,omitempty"`
-	ServerFacingMTUOffset uint16                    `json:"serverFacingMTUOffset,omitempty"`
-	ESLAGMACBase          string                    `json:"eslagMACBase,omitempty"`
-	ESLAGESIPrefix        string                    `json:"eslagESIPrefix,omitempty"`
-	DefaultMaxPathsEBGP   uint32                    `json:"defaultMaxPathsEBGP,omitempty"`
-	MCLAGSessionSubnet    string                    `json:"mclagSessionSubnet,omitempty"`
-	GatewayASN            uint32                    `json:"gatewayASN,omitempty"`
-	SpineASN              uint32                    `json:"spineASN,omitempty"`
-	LoopbackWorkaround    bool                      `json:"loopbackWorkaround,omitempty"`
-	ProtocolSubnet        string                    `json:"protocolSubnet,omitempty"`
-	VTEPSubnet            string                    `json:"vtepSubnet,omitempty"`
-	FabricSubnet          string                    `json:"fabricSubnet,omitempty"`
-	ProxyExternalSubnet   string                    `json:"proxyExternalSubnet,omitempty"`
-	DisableBFD            bool                      `json:"disableBFD,omitempty"`
-	GatewayBFD            bool                      `json:"gatewayBFD,omitempty"`
-	Alloy                 alloy.Config              `json:"alloy,omitempty"`
-	GatewayCommunities    map[string]string         `json:"gatewayCommunities,omitempty"`
+	DeploymentID          string                           `json:"deploymentID,omitempty"`
+	ControlVIP            string                           `json:"controlVIP,omitempty"`
+	VPCPeeringDisabled    bool                             `json:"vpcPeeringDisabled,omitempty"`
+	SpineLeaf             *AgentSpecConfigSpineLeaf        `json:"spineLeaf,omitempty"`
+	BaseVPCCommunity      string                           `json:"baseVPCCommunity,omitempty"`
+	VPCLoopbackSubnet     string                           `json:"vpcLoopbackSubnet,omitempty"`
+	FabricMTU             uint16                           `json:"fabricMTU,omitempty"`
+	ServerFacingMTUOffset uint16                           `json:"serverFacingMTUOffset,omitempty"`
+	ESLAGMACBase          string                           `json:"eslagMACBase,omitempty"`
+	ESLAGESIPrefix        string                           `json:"eslagESIPrefix,omitempty"`
+	DefaultMaxPathsEBGP   uint32                           `json:"defaultMaxPathsEBGP,omitempty"`
+	MCLAGSessionSubnet    string                           `json:"mclagSessionSubnet,omitempty"`
+	GatewayASN            uint32                           `json:"gatewayASN,omitempty"`
+	SpineASN              uint32                           `json:"spineASN,omitempty"`
+	LoopbackWorkaround    bool                             `json:"loopbackWorkaround,omitempty"`
+	ProtocolSubnet        string                           `json:"protocolSubnet,omitempty"`
+	VTEPSubnet            string                           `json:"vtepSubnet,omitempty"`
+	FabricSubnet          string                           `json:"fabricSubnet,omitempty"`
+	ProxyExternalSubnet   string                           `json:"proxyExternalSubnet,omitempty"`
+	DisableBFD            bool                             `json:"disableBFD,omitempty"`
+	GatewayBFD            bool                             `json:"gatewayBFD,omitempty"`
+	Alloy                 alloy.Config                     `json:"alloy,omitempty"`
+	GatewayCommunities    map[string]string                `json:"gatewayCommunities,omitempty"`
+	SFlow                 meta.ObservabilitySFlow          `json:"sflow,omitempty"`
+	SyslogServers         []meta.ObservabilitySyslogServer `json:"syslogServers,omitempty"`
+	AAA                   *AAAConfigCreds                  `json:"aaa,omitempty"`
+	SNMP                  *SNMPConfigCreds                 `json:"snmp,omitempty"`
+	System                meta.SystemConfig                `json:"system,omitempty"`
+}
+
+// AAAConfigCreds is the AAAConfig with the shared secret resolved from the referenced Secret
+type AAAConfigCreds struct {
+	meta.AAAConfig `json:",inline"`
+	// Secret is the shared secret read from the Secret referenced in the secretName, it's stored in plaintext in the
+	// Agent object, so access to Agents should be restricted the same way as to Secrets
+	Secret string `json:"secret,omitempty"`
+}
+
+// SNMPConfigCreds is the SNMPConfig with the users keys resolved from the referenced Secrets
+type SNMPConfigCreds struct {
+	meta.SNMPConfig `json:",inline"`
+	// UserKeys are the users auth and priv keys read from the Secrets referenced in the users secretName
+	UserKeys map[string]SNMPUserKeys `json:"userKeys,omitempty"`
+}
+
+type SNMPUserKeys struct {
+	Auth string `json:"auth,omitempty"`
+	Priv string `json:"priv,omitempty"`
 }
 
 type AgentSpecConfigSpineLeaf struct{}
@@ -8,12 +8,29 @@
 package v1beta1
 
 import (
+	"go.githedgehog.com/fabric/api/meta"
 	vpcv1beta1 "go.githedgehog.com/fabric/api/vpc/v1beta1"
 	wiringv1beta1 "go.githedgehog.com/fabric/api/wiring/v1beta1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AAAConfigCreds) DeepCopyInto(out *AAAConfigCreds) {
+	*out = *in
+	in.AAAConfig.DeepCopyInto(&out.AAAConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AAAConfigCreds.
+func (in *AAAConfigCreds) DeepCopy() *AAAConfigCreds {
+	if in == nil {
+		return nil
+	}
+	out := new(AAAConfigCreds)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Agent) DeepCopyInto(out *Agent) {
 	*out = *in
@@ -132,6 +149,13 @@ func (in *AgentSpec) DeepCopyInto(out *AgentSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.SecurityPolicies != nil {
+		in, out := &in.SecurityPolicies, &out.SecurityPolicies
+		*out = make(map[string]vpcv1beta1.SecurityPolicySpec, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.IPv4Namespaces != nil {
 		in, out := &in.IPv4Namespaces, &out.IPv4Namespaces
 		*out = make(map[string]vpcv1beta1.IPv4NamespaceSpec, len(*in))
@@ -146,6 +170,13 @@ func (in *AgentSpec) DeepCopyInto(out *AgentSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.MirrorSessions != nil {
+		in, out := &in.MirrorSessions, &out.MirrorSessions
+		*out = make(map[string]wiringv1beta1.MirrorSessionSpec, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.Externals != nil {
 		in, out := &in.Externals, &out.Externals
 		*out = make(map[string]vpcv1beta1.ExternalSpec, len(*in))
@@ -155,7 +186,7 @@ func (in *AgentSpec) DeepCopyInto(out *AgentSpec) {
 	}
 	if in.ExternalAttachments != nil {
 		in, out := &in.ExternalAttachments, &out.ExternalAttachments
-		*out = make(map[string]vpcv1beta1.ExternalAttachmentSpec, len(*in))
+		*out = make(map[string]ExternalAttachmentSpecCreds, len(*in))
 		for key, val := range *in {
 			(*out)[key] = *val.DeepCopy()
 		}
@@ -215,6 +246,23 @@ func (in *AgentSpecConfig) DeepCopyInto(out *AgentSpecConfig) {
 			(*out)[key] = val
 		}
 	}
+	out.SFlow = in.SFlow
+	if in.SyslogServers != nil {
+		in, out := &in.SyslogServers, &out.SyslogServers
+		*out = make([]meta.ObservabilitySyslogServer, len(*in))
+		copy(*out, *in)
+	}
+	if in.AAA != nil {
+		in, out := &in.AAA, &out.AAA
+		*out = new(AAAConfigCreds)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SNMP != nil {
+		in, out := &in.SNMP, &out.SNMP
+		*out = new(SNMPConfigCreds)
+		(*in).DeepCopyInto(*out)
+	}
+	in.System.DeepCopyInto(&out.System)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentSpecConfig.
@@ -519,6 +567,60 @@ func (in *CatalogStatus) DeepCopy() *CatalogStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAttachmentSpecCreds) DeepCopyInto(out *ExternalAttachmentSpecCreds) {
+	*out = *in
+	in.ExternalAttachmentSpec.DeepCopyInto(&out.ExternalAttachmentSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAttachmentSpecCreds.
+func (in *ExternalAttachmentSpecCreds) DeepCopy() *ExternalAttachmentSpecCreds {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAttachmentSpecCreds)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNMPConfigCreds) DeepCopyInto(out *SNMPConfigCreds) {
+	*out = *in
+	in.SNMPConfig.DeepCopyInto(&out.SNMPConfig)
+	if in.UserKeys != nil {
+		in, out := &in.UserKeys, &out.UserKeys
+		*out = make(map[string]SNMPUserKeys, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNMPConfigCreds.
+func (in *SNMPConfigCreds) DeepCopy() *SNMPConfigCreds {
+	if in == nil {
+		return nil
+	}
+	out := new(SNMPConfigCreds)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNMPUserKeys) DeepCopyInto(out *SNMPUserKeys) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNMPUserKeys.
+func (in *SNMPUserKeys) DeepCopy() *SNMPUserKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(SNMPUserKeys)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SwitchState) DeepCopyInto(out *SwitchState) {
 	*out = *in
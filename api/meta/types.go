@@ -97,6 +97,9 @@ type FabricConfig struct {
 	Alloy                    AlloyConfig       `json:"alloy,omitempty"` // TODO: not used anymore, remove in future releases
 	AlloyTargets             alloy.Targets     `json:"alloyTargets,omitempty"`
 	Observability            Observability     `json:"observability,omitempty"`
+	AAA                      AAAConfig         `json:"aaa,omitempty"`
+	SNMP                     SNMPConfig        `json:"snmp,omitempty"`
+	System                   SystemConfig      `json:"system,omitempty"`
 	ControlProxyURL          string            `json:"controlProxyURL,omitempty"`
 	DefaultMaxPathsEBGP      uint32            `json:"defaultMaxPathsEBGP,omitempty"`
 	AllowExtraSwitchProfiles bool              `json:"allowExtraSwitchProfiles,omitempty"`
@@ -189,6 +192,9 @@ var NOSTypesCumulus = []NOSType{
 type Observability struct {
 	Agent ObservabilityAgent `json:"agent,omitempty"`
 	Unix  ObservabilityUnix  `json:"unix,omitempty"`
+	SFlow ObservabilitySFlow `json:"sflow,omitempty"`
+	// SyslogServers is the list of the remote syslog servers the switch NOS forwards its logs to
+	SyslogServers []ObservabilitySyslogServer `json:"syslogServers,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
@@ -208,6 +214,657 @@ type ObservabilityUnix struct {
 	Syslog            bool                      `json:"syslog,omitempty"`
 }
 
+const (
+	ObservabilityVRFDefault = "default"
+	ObservabilityVRFMgmt    = "mgmt"
+)
+
+var ObservabilityVRFs = []string{
+	ObservabilityVRFDefault,
+	ObservabilityVRFMgmt,
+}
+
+const (
+	SFlowDefaultCollectorPort = 6343
+	SFlowMinSamplingRate      = 256
+	SFlowMaxSamplingRate      = 8388608
+	SFlowMinPollingInterval   = 5
+	SFlowMaxPollingInterval   = 300
+)
+
+// ObservabilitySFlow is the sFlow export configuration, sFlow is only enabled if the collector is set
+// +kubebuilder:object:generate=true
+type ObservabilitySFlow struct {
+	// Collector is the sFlow collector address in the "ip" or "ip:port" format, port 6343 is used if not set
+	Collector string `json:"collector,omitempty"`
+	// VRF is the VRF used to reach the collector, "default" or "mgmt", default VRF is used if not set
+	VRF string `json:"vrf,omitempty"`
+	// SamplingRate is the packet sampling rate (1 out of N packets), NOS default based on port speed is used if not set
+	SamplingRate uint32 `json:"samplingRate,omitempty"`
+	// PollingInterval is the counter polling interval in seconds, NOS default is used if not set
+	PollingInterval uint16 `json:"pollingInterval,omitempty"`
+}
+
+func (cfg *ObservabilitySFlow) IsEnabled() bool {
+	return cfg != nil && cfg.Collector != ""
+}
+
+// CollectorAddrPort returns the parsed collector address with the default port applied if not specified
+func (cfg *ObservabilitySFlow) CollectorAddrPort() (netip.AddrPort, error) {
+	if addrPort, err := netip.ParseAddrPort(cfg.Collector); err == nil {
+		return addrPort, nil
+	}
+
+	addr, err := netip.ParseAddr(cfg.Collector)
+	if err != nil {
+		return netip.AddrPort{}, errors.Errorf("invalid collector %q, should be ip or ip:port", cfg.Collector)
+	}
+
+	return netip.AddrPortFrom(addr, SFlowDefaultCollectorPort), nil
+}
+
+// Validate checks the sFlow fields that are set, so it could be used for partial (override) configs as well
+func (cfg *ObservabilitySFlow) Validate() error {
+	if cfg == nil {
+		return nil
+	}
+
+	if cfg.Collector != "" {
+		if _, err := cfg.CollectorAddrPort(); err != nil {
+			return err
+		}
+	}
+
+	if cfg.VRF != "" && !slices.Contains(ObservabilityVRFs, cfg.VRF) {
+		return errors.Errorf("invalid vrf %q, should be one of %v", cfg.VRF, ObservabilityVRFs)
+	}
+
+	if cfg.SamplingRate != 0 && (cfg.SamplingRate < SFlowMinSamplingRate || cfg.SamplingRate > SFlowMaxSamplingRate) {
+		return errors.Errorf("sampling rate should be in [%d, %d] range", SFlowMinSamplingRate, SFlowMaxSamplingRate)
+	}
+
+	if cfg.PollingInterval != 0 && (cfg.PollingInterval < SFlowMinPollingInterval || cfg.PollingInterval > SFlowMaxPollingInterval) {
+		return errors.Errorf("polling interval should be in [%d, %d] range", SFlowMinPollingInterval, SFlowMaxPollingInterval)
+	}
+
+	return nil
+}
+
+type SyslogProtocol string
+
+const (
+	SyslogProtocolUDP SyslogProtocol = "udp"
+	SyslogProtocolTCP SyslogProtocol = "tcp"
+)
+
+var SyslogProtocols = []SyslogProtocol{
+	SyslogProtocolUDP,
+	SyslogProtocolTCP,
+}
+
+type SyslogSeverity string
+
+const (
+	SyslogSeverityEmergency SyslogSeverity = "emergency"
+	SyslogSeverityAlert     SyslogSeverity = "alert"
+	SyslogSeverityCritical  SyslogSeverity = "critical"
+	SyslogSeverityError     SyslogSeverity = "error"
+	SyslogSeverityWarning   SyslogSeverity = "warning"
+	SyslogSeverityNotice    SyslogSeverity = "notice"
+	SyslogSeverityInfo      SyslogSeverity = "info"
+	SyslogSeverityDebug     SyslogSeverity = "debug"
+)
+
+var SyslogSeverities = []SyslogSeverity{
+	SyslogSeverityEmergency,
+	SyslogSeverityAlert,
+	SyslogSeverityCritical,
+	SyslogSeverityError,
+	SyslogSeverityWarning,
+	SyslogSeverityNotice,
+	SyslogSeverityInfo,
+	SyslogSeverityDebug,
+}
+
+type SyslogSourceInterface string
+
+const (
+	SyslogSourceInterfaceManagement SyslogSourceInterface = "management"
+	SyslogSourceInterfaceLoopback   SyslogSourceInterface = "loopback"
+)
+
+var SyslogSourceInterfaces = []SyslogSourceInterface{
+	SyslogSourceInterfaceManagement,
+	SyslogSourceInterfaceLoopback,
+}
+
+const SyslogDefaultPort = 514
+
+// ObservabilitySyslogServer is the remote syslog server the switch NOS forwards its logs to
+// +kubebuilder:object:generate=true
+type ObservabilitySyslogServer struct {
+	// Host is the IP address of the syslog server
+	Host string `json:"host,omitempty"`
+	// Port is the port of the syslog server, 514 is used if not set
+	Port uint16 `json:"port,omitempty"`
+	// Protocol is the transport protocol, "udp" (default) or "tcp"
+	Protocol SyslogProtocol `json:"protocol,omitempty"`
+	// VRF is the VRF used to reach the syslog server, "default" or "mgmt", default VRF is used if not set
+	VRF string `json:"vrf,omitempty"`
+	// Severity is the minimum severity of the messages forwarded to the server, NOS default is used if not set
+	Severity SyslogSeverity `json:"severity,omitempty"`
+	// SourceInterface is the switch interface the messages are sent from, "management" or "loopback" (protocol IP),
+	// NOS picks it based on the routing if not set
+	SourceInterface SyslogSourceInterface `json:"sourceInterface,omitempty"`
+}
+
+func (srv *ObservabilitySyslogServer) Validate() error {
+	if srv.Host == "" {
+		return errors.Errorf("host is required")
+	}
+	if _, err := netip.ParseAddr(srv.Host); err != nil {
+		return errors.Errorf("invalid host %q, should be an IP address", srv.Host)
+	}
+
+	if srv.Protocol != "" && !slices.Contains(SyslogProtocols, srv.Protocol) {
+		return errors.Errorf("invalid protocol %q, should be one of %v", srv.Protocol, SyslogProtocols)
+	}
+
+	if srv.VRF != "" && !slices.Contains(ObservabilityVRFs, srv.VRF) {
+		return errors.Errorf("invalid vrf %q, should be one of %v", srv.VRF, ObservabilityVRFs)
+	}
+
+	if srv.Severity != "" && !slices.Contains(SyslogSeverities, srv.Severity) {
+		return errors.Errorf("invalid severity %q, should be one of %v", srv.Severity, SyslogSeverities)
+	}
+
+	if srv.SourceInterface != "" && !slices.Contains(SyslogSourceInterfaces, srv.SourceInterface) {
+		return errors.Errorf("invalid source interface %q, should be one of %v", srv.SourceInterface, SyslogSourceInterfaces)
+	}
+
+	return nil
+}
+
+type AAAProtocol string
+
+const (
+	AAAProtocolTACACS AAAProtocol = "tacacs"
+	AAAProtocolRADIUS AAAProtocol = "radius"
+)
+
+var AAAProtocols = []AAAProtocol{
+	AAAProtocolTACACS,
+	AAAProtocolRADIUS,
+}
+
+type AAALoginMethod string
+
+const (
+	AAALoginMethodTACACS AAALoginMethod = "tacacs"
+	AAALoginMethodRADIUS AAALoginMethod = "radius"
+	AAALoginMethodLocal  AAALoginMethod = "local"
+)
+
+var AAALoginMethods = []AAALoginMethod{
+	AAALoginMethodTACACS,
+	AAALoginMethodRADIUS,
+	AAALoginMethodLocal,
+}
+
+const (
+	AAATACACSDefaultPort      = 49
+	AAARADIUSDefaultPort      = 1812
+	AAADefaultAdminPrivLevel  = 15
+	AAAMaxPrivLevel           = 15
+	AAAMaxServersPerProtocol  = 8
+	AAASecretKey              = "secret"
+	AAADefaultVRF             = ObservabilityVRFDefault
+	AAAMaxSecretLength        = 64
+	AAASecretForbiddenSymbols = " ,#'\""
+)
+
+// AAAConfig is the remote authentication of the switch logins using TACACS+ and RADIUS servers, it's only enabled if
+// at least one server is configured
+// +kubebuilder:object:generate=true
+type AAAConfig struct {
+	// Servers is the list of the TACACS+ and RADIUS servers, servers of the same protocol are tried in the listed order
+	Servers []AAAServer `json:"servers,omitempty"`
+	// SecretName is the name of the Secret in the fabric namespace with the shared secret stored under the "secret" key
+	SecretName string `json:"secretName,omitempty"`
+	// VRF is the VRF used to reach the servers, "default" or "mgmt", default VRF is used if not set
+	VRF string `json:"vrf,omitempty"`
+	// AdminPrivLevel is the minimum privilege level (priv-lvl) returned by the server for the user to get the admin role,
+	// users with the lower privilege level get the operator role, 15 is used if not set
+	AdminPrivLevel uint8 `json:"adminPrivLevel,omitempty"`
+	// LoginOrder is the order of the login methods, "tacacs", "radius" and "local", "local" is always required so the
+	// local users are still able to login, servers with local fallback (in the tacacs, radius, local order) are used if not set
+	LoginOrder []AAALoginMethod `json:"loginOrder,omitempty"`
+}
+
+// AAAServer is the TACACS+ or RADIUS server used to authenticate the switch logins
+// +kubebuilder:object:generate=true
+type AAAServer struct {
+	// Host is the IP address of the server
+	Host string `json:"host,omitempty"`
+	// Port is the port of the server, 49 is used for TACACS+ and 1812 for RADIUS if not set
+	Port uint16 `json:"port,omitempty"`
+	// Protocol is the protocol of the server, "tacacs" or "radius"
+	Protocol AAAProtocol `json:"protocol,omitempty"`
+}
+
+func (cfg *AAAConfig) IsEnabled() bool {
+	return cfg != nil && len(cfg.Servers) > 0
+}
+
+// ServersFor returns the servers of the given protocol in the configured order
+func (cfg *AAAConfig) ServersFor(protocol AAAProtocol) []AAAServer {
+	servers := []AAAServer{}
+	for _, srv := range cfg.Servers {
+		if srv.Protocol == protocol {
+			servers = append(servers, srv)
+		}
+	}
+
+	return servers
+}
+
+// EffectiveLoginOrder returns the configured login order or the default one with the local fallback
+func (cfg *AAAConfig) EffectiveLoginOrder() []AAALoginMethod {
+	if len(cfg.LoginOrder) > 0 {
+		return cfg.LoginOrder
+	}
+
+	order := []AAALoginMethod{}
+	if len(cfg.ServersFor(AAAProtocolTACACS)) > 0 {
+		order = append(order, AAALoginMethodTACACS)
+	}
+	if len(cfg.ServersFor(AAAProtocolRADIUS)) > 0 {
+		order = append(order, AAALoginMethodRADIUS)
+	}
+
+	return append(order, AAALoginMethodLocal)
+}
+
+// EffectiveAdminPrivLevel returns the configured admin privilege level or the default one
+func (cfg *AAAConfig) EffectiveAdminPrivLevel() uint8 {
+	if cfg.AdminPrivLevel == 0 {
+		return AAADefaultAdminPrivLevel
+	}
+
+	return cfg.AdminPrivLevel
+}
+
+// EffectiveVRF returns the configured VRF or the default one
+func (cfg *AAAConfig) EffectiveVRF() string {
+	if cfg.VRF == "" {
+		return AAADefaultVRF
+	}
+
+	return cfg.VRF
+}
+
+func (srv *AAAServer) EffectivePort() uint16 {
+	if srv.Port != 0 {
+		return srv.Port
+	}
+	if srv.Protocol == AAAProtocolRADIUS {
+		return AAARADIUSDefaultPort
+	}
+
+	return AAATACACSDefaultPort
+}
+
+func (cfg *AAAConfig) Validate() error {
+	if !cfg.IsEnabled() {
+		if cfg != nil && (cfg.SecretName != "" || len(cfg.LoginOrder) > 0) {
+			return errors.Errorf("servers are required if secretName or loginOrder is set")
+		}
+
+		return nil
+	}
+
+	if cfg.SecretName == "" {
+		return errors.Errorf("secretName is required")
+	}
+
+	hosts := map[string]bool{}
+	for idx, srv := range cfg.Servers {
+		if srv.Host == "" {
+			return errors.Errorf("servers: %d: host is required", idx)
+		}
+		if _, err := netip.ParseAddr(srv.Host); err != nil {
+			return errors.Errorf("servers: %d: invalid host %q, should be an IP address", idx, srv.Host)
+		}
+		if !slices.Contains(AAAProtocols, srv.Protocol) {
+			return errors.Errorf("servers: %d: invalid protocol %q, should be one of %v", idx, srv.Protocol, AAAProtocols)
+		}
+
+		key := string(srv.Protocol) + "/" + srv.Host
+		if hosts[key] {
+			return errors.Errorf("servers: %d: %s server %s is duplicated", idx, srv.Protocol, srv.Host)
+		}
+		hosts[key] = true
+	}
+
+	for _, protocol := range AAAProtocols {
+		if len(cfg.ServersFor(protocol)) > AAAMaxServersPerProtocol {
+			return errors.Errorf("servers: no more than %d %s servers are supported", AAAMaxServersPerProtocol, protocol)
+		}
+	}
+
+	if cfg.VRF != "" && !slices.Contains(ObservabilityVRFs, cfg.VRF) {
+		return errors.Errorf("invalid vrf %q, should be one of %v", cfg.VRF, ObservabilityVRFs)
+	}
+
+	if cfg.AdminPrivLevel > AAAMaxPrivLevel {
+		return errors.Errorf("adminPrivLevel should be in [1, %d] range", AAAMaxPrivLevel)
+	}
+
+	methods := map[AAALoginMethod]bool{}
+	for _, method := range cfg.LoginOrder {
+		if !slices.Contains(AAALoginMethods, method) {
+			return errors.Errorf("loginOrder: invalid method %q, should be one of %v", method, AAALoginMethods)
+		}
+		if methods[method] {
+			return errors.Errorf("loginOrder: method %q is duplicated", method)
+		}
+		methods[method] = true
+
+		if method != AAALoginMethodLocal && len(cfg.ServersFor(AAAProtocol(method))) == 0 {
+			return errors.Errorf("loginOrder: method %q has no servers configured", method)
+		}
+	}
+	if len(cfg.LoginOrder) > 0 && !methods[AAALoginMethodLocal] {
+		return errors.Errorf("loginOrder: %q method is required", AAALoginMethodLocal)
+	}
+
+	return nil
+}
+
+// ValidateAAASecret checks that the shared secret could be used by all supported NOSes
+func ValidateAAASecret(secret string) error {
+	if secret == "" {
+		return errors.Errorf("secret is empty")
+	}
+	if len(secret) > AAAMaxSecretLength {
+		return errors.Errorf("secret is longer than %d symbols", AAAMaxSecretLength)
+	}
+	if strings.ContainsAny(secret, AAASecretForbiddenSymbols) {
+		return errors.Errorf("secret should not contain any of %q", AAASecretForbiddenSymbols)
+	}
+
+	return nil
+}
+
+type SNMPAuthProtocol string
+
+const (
+	SNMPAuthProtocolMD5 SNMPAuthProtocol = "md5"
+	SNMPAuthProtocolSHA SNMPAuthProtocol = "sha"
+)
+
+var SNMPAuthProtocols = []SNMPAuthProtocol{
+	SNMPAuthProtocolMD5,
+	SNMPAuthProtocolSHA,
+}
+
+type SNMPPrivProtocol string
+
+const (
+	SNMPPrivProtocolDES SNMPPrivProtocol = "des"
+	SNMPPrivProtocolAES SNMPPrivProtocol = "aes"
+)
+
+var SNMPPrivProtocols = []SNMPPrivProtocol{
+	SNMPPrivProtocolDES,
+	SNMPPrivProtocolAES,
+}
+
+const (
+	SNMPAuthKeySecretKey = "authKey"
+	SNMPPrivKeySecretKey = "privKey"
+	SNMPMinKeyLength     = 8
+	SNMPMaxInfoLength    = 255
+)
+
+// SNMPConfig is the SNMPv3 read-only agent configuration of the switches, it's only enabled if at least one user is
+// configured
+// +kubebuilder:object:generate=true
+type SNMPConfig struct {
+	// Users is the list of the SNMPv3 users allowed to poll the switches, all of them are read-only and use authPriv
+	Users []SNMPUser `json:"users,omitempty"`
+	// ManagerPrefixes is the list of the IPv4 prefixes of the SNMP managers allowed to poll the switches, any source is
+	// allowed if not set
+	ManagerPrefixes []string `json:"managerPrefixes,omitempty"`
+	// Location is the switch location (sysLocation) reported over SNMP
+	Location string `json:"location,omitempty"`
+	// Contact is the switch contact (sysContact) reported over SNMP
+	Contact string `json:"contact,omitempty"`
+}
+
+// SNMPUser is the SNMPv3 read-only user
+// +kubebuilder:object:generate=true
+type SNMPUser struct {
+	// Name is the name of the user
+	Name string `json:"name,omitempty"`
+	// AuthProtocol is the authentication protocol, "md5" or "sha", "sha" is used if not set
+	AuthProtocol SNMPAuthProtocol `json:"authProtocol,omitempty"`
+	// PrivProtocol is the privacy (encryption) protocol, "des" or "aes", "aes" is used if not set
+	PrivProtocol SNMPPrivProtocol `json:"privProtocol,omitempty"`
+	// SecretName is the name of the Secret in the fabric namespace with the keys stored under the "authKey" and
+	// "privKey" keys
+	SecretName string `json:"secretName,omitempty"`
+}
+
+func (cfg *SNMPConfig) IsEnabled() bool {
+	return cfg != nil && len(cfg.Users) > 0
+}
+
+// EffectiveAuthProtocol returns the configured authentication protocol or the default one
+func (user *SNMPUser) EffectiveAuthProtocol() SNMPAuthProtocol {
+	if user.AuthProtocol == "" {
+		return SNMPAuthProtocolSHA
+	}
+
+	return user.AuthProtocol
+}
+
+// EffectivePrivProtocol returns the configured privacy protocol or the default one
+func (user *SNMPUser) EffectivePrivProtocol() SNMPPrivProtocol {
+	if user.PrivProtocol == "" {
+		return SNMPPrivProtocolAES
+	}
+
+	return user.PrivProtocol
+}
+
+func (cfg *SNMPConfig) Validate() error {
+	if !cfg.IsEnabled() {
+		if cfg != nil && (len(cfg.ManagerPrefixes) > 0 || cfg.Location != "" || cfg.Contact != "") {
+			return errors.Errorf("users are required if managerPrefixes, location or contact is set")
+		}
+
+		return nil
+	}
+
+	names := map[string]bool{}
+	for idx, user := range cfg.Users {
+		if user.Name == "" {
+			return errors.Errorf("users: %d: name is required", idx)
+		}
+		if strings.ContainsAny(user.Name, " \t\n\"'") {
+			return errors.Errorf("users: %d: name should not contain whitespaces or quotes", idx)
+		}
+		if names[user.Name] {
+			return errors.Errorf("users: %d: name %s is duplicated", idx, user.Name)
+		}
+		names[user.Name] = true
+
+		if user.AuthProtocol != "" && !slices.Contains(SNMPAuthProtocols, user.AuthProtocol) {
+			return errors.Errorf("users: %s: invalid authProtocol %q, should be one of %v", user.Name, user.AuthProtocol, SNMPAuthProtocols)
+		}
+		if user.PrivProtocol != "" && !slices.Contains(SNMPPrivProtocols, user.PrivProtocol) {
+			return errors.Errorf("users: %s: invalid privProtocol %q, should be one of %v", user.Name, user.PrivProtocol, SNMPPrivProtocols)
+		}
+		if user.SecretName == "" {
+			return errors.Errorf("users: %s: secretName is required", user.Name)
+		}
+	}
+
+	prefixes := map[netip.Prefix]bool{}
+	for _, prefixStr := range cfg.ManagerPrefixes {
+		prefix, err := netip.ParsePrefix(prefixStr)
+		if err != nil {
+			return errors.Wrapf(err, "managerPrefixes: invalid prefix %q", prefixStr)
+		}
+		if !prefix.Addr().Is4() {
+			return errors.Errorf("managerPrefixes: prefix %s should be IPv4", prefixStr)
+		}
+		if prefix.Masked() != prefix {
+			return errors.Errorf("managerPrefixes: prefix %s should be a network address", prefixStr)
+		}
+		if prefixes[prefix] {
+			return errors.Errorf("managerPrefixes: prefix %s is duplicated", prefixStr)
+		}
+		prefixes[prefix] = true
+	}
+
+	if err := validateSNMPInfo(cfg.Location); err != nil {
+		return errors.Wrapf(err, "location")
+	}
+	if err := validateSNMPInfo(cfg.Contact); err != nil {
+		return errors.Wrapf(err, "contact")
+	}
+
+	return nil
+}
+
+func validateSNMPInfo(value string) error {
+	if len(value) > SNMPMaxInfoLength {
+		return errors.Errorf("should be no longer than %d symbols", SNMPMaxInfoLength)
+	}
+	if strings.ContainsAny(value, "\n\r\"'") {
+		return errors.Errorf("should not contain new lines or quotes")
+	}
+
+	return nil
+}
+
+// ValidateSNMPKey checks that the user auth or priv key is long enough and could be used by all supported NOSes
+func ValidateSNMPKey(key string) error {
+	if len(key) < SNMPMinKeyLength {
+		return errors.Errorf("key should be at least %d symbols long", SNMPMinKeyLength)
+	}
+	if strings.ContainsAny(key, " \t\n\"'") {
+		return errors.Errorf("key should not contain whitespaces or quotes")
+	}
+
+	return nil
+}
+
+const (
+	SystemMaxNTPServers      = 8
+	SystemMaxDNSServers      = 3
+	SystemMaxSearchDomainLen = 253
+	SystemMaxTimezoneLen     = 64
+)
+
+var (
+	timezoneChecker     = regexp.MustCompile(`^[A-Za-z0-9_+-]+(/[A-Za-z0-9_+-]+)*$`)
+	searchDomainChecker = regexp.MustCompile(`^([a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`)
+)
+
+// SystemConfig is the switch system services configuration: time sources, DNS resolvers and timezone. All fields are
+// optional, switches are using the control node as the only NTP server, no DNS resolvers and UTC timezone by default
+// +kubebuilder:object:generate=true
+type SystemConfig struct {
+	// NTP is the NTP configuration, switches are using the control node as the only NTP server if not set
+	NTP NTPConfig `json:"ntp,omitempty"`
+	// DNS is the DNS resolvers configuration
+	DNS DNSConfig `json:"dns,omitempty"`
+	// Timezone is the IANA timezone name, such as "America/Los_Angeles", UTC is used if not set
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// NTPConfig is the list of the NTP servers the switches are syncing time with
+// +kubebuilder:object:generate=true
+type NTPConfig struct {
+	// Servers is the list of the NTP servers, replaces the control node as the NTP server if set
+	Servers []NTPServer `json:"servers,omitempty"`
+	// VRF is the VRF used to reach the NTP servers, "default" or "mgmt", NOS default is used if not set
+	VRF string `json:"vrf,omitempty"`
+}
+
+// NTPServer is the NTP server
+type NTPServer struct {
+	// Address is the IPv4 address of the NTP server
+	Address string `json:"address,omitempty"`
+	// Prefer marks the server as preferred over the other ones
+	Prefer bool `json:"prefer,omitempty"`
+}
+
+// DNSConfig is the DNS resolvers configuration of the switches
+// +kubebuilder:object:generate=true
+type DNSConfig struct {
+	// Servers is the list of the IPv4 addresses of the DNS name servers
+	Servers []string `json:"servers,omitempty"`
+	// SearchDomain is the domain appended to the non fully qualified names
+	SearchDomain string `json:"searchDomain,omitempty"`
+}
+
+// Validate checks the system fields that are set, so it could be used for partial (override) configs as well
+func (cfg *SystemConfig) Validate() error {
+	if cfg == nil {
+		return nil
+	}
+
+	if len(cfg.NTP.Servers) > SystemMaxNTPServers {
+		return errors.Errorf("ntp: no more than %d servers allowed", SystemMaxNTPServers)
+	}
+	ntpServers := map[netip.Addr]bool{}
+	for idx, server := range cfg.NTP.Servers {
+		addr, err := netip.ParseAddr(server.Address)
+		if err != nil || !addr.Is4() {
+			return errors.Errorf("ntp: servers: %d: address %q should be a valid IPv4 address", idx, server.Address)
+		}
+		if ntpServers[addr] {
+			return errors.Errorf("ntp: servers: %d: address %s is duplicated", idx, server.Address)
+		}
+		ntpServers[addr] = true
+	}
+	if cfg.NTP.VRF != "" && !slices.Contains(ObservabilityVRFs, cfg.NTP.VRF) {
+		return errors.Errorf("ntp: invalid vrf %q, should be one of %v", cfg.NTP.VRF, ObservabilityVRFs)
+	}
+
+	if len(cfg.DNS.Servers) > SystemMaxDNSServers {
+		return errors.Errorf("dns: no more than %d servers allowed", SystemMaxDNSServers)
+	}
+	dnsServers := map[netip.Addr]bool{}
+	for idx, server := range cfg.DNS.Servers {
+		addr, err := netip.ParseAddr(server)
+		if err != nil || !addr.Is4() {
+			return errors.Errorf("dns: servers: %d: address %q should be a valid IPv4 address", idx, server)
+		}
+		if dnsServers[addr] {
+			return errors.Errorf("dns: servers: %d: address %s is duplicated", idx, server)
+		}
+		dnsServers[addr] = true
+	}
+	if cfg.DNS.SearchDomain != "" {
+		if len(cfg.DNS.SearchDomain) > SystemMaxSearchDomainLen || !searchDomainChecker.MatchString(cfg.DNS.SearchDomain) {
+			return errors.Errorf("dns: invalid search domain %q", cfg.DNS.SearchDomain)
+		}
+	}
+
+	if cfg.Timezone != "" {
+		if len(cfg.Timezone) > SystemMaxTimezoneLen || !timezoneChecker.MatchString(cfg.Timezone) {
+			return errors.Errorf("invalid timezone %q, should be IANA timezone name", cfg.Timezone)
+		}
+	}
+
+	return nil
+}
+
 func (cfg *FabricConfig) ParsedReservedSubnets() []netip.Prefix {
 	return cfg.reservedSubnets
 }
@@ -348,6 +1005,33 @@ func (cfg *FabricConfig) Init() (*FabricConfig, error) {
 		return nil, errors.Wrapf(err, "error validating alloy targets")
 	}
 
+	if err := cfg.Observability.SFlow.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "config: observability: sflow")
+	}
+
+	syslogHosts := map[string]bool{}
+	for idx, srv := range cfg.Observability.SyslogServers {
+		if err := srv.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "config: observability: syslogServers: %d", idx)
+		}
+		if syslogHosts[srv.Host] {
+			return nil, errors.Errorf("config: observability: syslogServers: host %s is duplicated", srv.Host)
+		}
+		syslogHosts[srv.Host] = true
+	}
+
+	if err := cfg.AAA.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "config: aaa")
+	}
+
+	if err := cfg.SNMP.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "config: snmp")
+	}
+
+	if err := cfg.System.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "config: system")
+	}
+
 	if cfg.DefaultMaxPathsEBGP == 0 {
 		return nil, errors.Errorf("config: defaultMaxPathsEBGP is required")
 	}
@@ -0,0 +1,271 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package meta
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAAAConfigValidate(t *testing.T) {
+	tacacs := AAAServer{Host: "10.0.0.1", Protocol: AAAProtocolTACACS}
+	radius := AAAServer{Host: "10.0.0.2", Protocol: AAAProtocolRADIUS}
+
+	for _, tt := range []struct {
+		name  string
+		cfg   AAAConfig
+		order []AAALoginMethod
+		err   bool
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name: "secret-without-servers",
+			cfg:  AAAConfig{SecretName: "aaa"},
+			err:  true,
+		},
+		{
+			name:  "tacacs",
+			cfg:   AAAConfig{Servers: []AAAServer{tacacs}, SecretName: "aaa"},
+			order: []AAALoginMethod{AAALoginMethodTACACS, AAALoginMethodLocal},
+		},
+		{
+			name:  "both",
+			cfg:   AAAConfig{Servers: []AAAServer{radius, tacacs}, SecretName: "aaa", VRF: ObservabilityVRFMgmt},
+			order: []AAALoginMethod{AAALoginMethodTACACS, AAALoginMethodRADIUS, AAALoginMethodLocal},
+		},
+		{
+			name: "local-first",
+			cfg: AAAConfig{
+				Servers:    []AAAServer{radius},
+				SecretName: "aaa",
+				LoginOrder: []AAALoginMethod{AAALoginMethodLocal, AAALoginMethodRADIUS},
+			},
+			order: []AAALoginMethod{AAALoginMethodLocal, AAALoginMethodRADIUS},
+		},
+		{
+			name: "no-secret",
+			cfg:  AAAConfig{Servers: []AAAServer{tacacs}},
+			err:  true,
+		},
+		{
+			name: "invalid-host",
+			cfg:  AAAConfig{Servers: []AAAServer{{Host: "aaa.example.com", Protocol: AAAProtocolTACACS}}, SecretName: "aaa"},
+			err:  true,
+		},
+		{
+			name: "no-protocol",
+			cfg:  AAAConfig{Servers: []AAAServer{{Host: "10.0.0.1"}}, SecretName: "aaa"},
+			err:  true,
+		},
+		{
+			name: "duplicate-server",
+			cfg:  AAAConfig{Servers: []AAAServer{tacacs, tacacs}, SecretName: "aaa"},
+			err:  true,
+		},
+		{
+			name: "invalid-priv-level",
+			cfg:  AAAConfig{Servers: []AAAServer{tacacs}, SecretName: "aaa", AdminPrivLevel: 16},
+			err:  true,
+		},
+		{
+			name: "order-without-local",
+			cfg:  AAAConfig{Servers: []AAAServer{tacacs}, SecretName: "aaa", LoginOrder: []AAALoginMethod{AAALoginMethodTACACS}},
+			err:  true,
+		},
+		{
+			name: "order-without-servers",
+			cfg: AAAConfig{
+				Servers:    []AAAServer{tacacs},
+				SecretName: "aaa",
+				LoginOrder: []AAALoginMethod{AAALoginMethodRADIUS, AAALoginMethodLocal},
+			},
+			err: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.err {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.cfg.IsEnabled() {
+				require.Equal(t, tt.order, tt.cfg.EffectiveLoginOrder())
+			}
+		})
+	}
+}
+
+func TestValidateAAASecret(t *testing.T) {
+	require.NoError(t, ValidateAAASecret("s3cr3t!"))
+	require.Error(t, ValidateAAASecret(""))
+	require.Error(t, ValidateAAASecret("with space"))
+	require.Error(t, ValidateAAASecret("with'quote"))
+}
+
+func TestSNMPConfigValidate(t *testing.T) {
+	user := SNMPUser{Name: "monitor", SecretName: "snmp-monitor"}
+
+	for _, tt := range []struct {
+		name string
+		cfg  SNMPConfig
+		err  bool
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name: "info-without-users",
+			cfg:  SNMPConfig{Location: "DC1"},
+			err:  true,
+		},
+		{
+			name: "defaults",
+			cfg:  SNMPConfig{Users: []SNMPUser{user}},
+		},
+		{
+			name: "full",
+			cfg: SNMPConfig{
+				Users: []SNMPUser{{
+					Name:         "monitor",
+					AuthProtocol: SNMPAuthProtocolMD5,
+					PrivProtocol: SNMPPrivProtocolDES,
+					SecretName:   "snmp-monitor",
+				}},
+				ManagerPrefixes: []string{"10.10.0.0/24", "10.20.0.5/32"},
+				Location:        "DC1 Row 2",
+				Contact:         "noc@example.com",
+			},
+		},
+		{
+			name: "duplicate-user",
+			cfg:  SNMPConfig{Users: []SNMPUser{user, user}},
+			err:  true,
+		},
+		{
+			name: "user-without-secret",
+			cfg:  SNMPConfig{Users: []SNMPUser{{Name: "monitor"}}},
+			err:  true,
+		},
+		{
+			name: "invalid-auth-protocol",
+			cfg:  SNMPConfig{Users: []SNMPUser{{Name: "monitor", AuthProtocol: "sha512", SecretName: "snmp-monitor"}}},
+			err:  true,
+		},
+		{
+			name: "ipv6-prefix",
+			cfg:  SNMPConfig{Users: []SNMPUser{user}, ManagerPrefixes: []string{"fd00::/64"}},
+			err:  true,
+		},
+		{
+			name: "not-network-prefix",
+			cfg:  SNMPConfig{Users: []SNMPUser{user}, ManagerPrefixes: []string{"10.10.0.1/24"}},
+			err:  true,
+		},
+		{
+			name: "duplicate-prefix",
+			cfg:  SNMPConfig{Users: []SNMPUser{user}, ManagerPrefixes: []string{"10.10.0.0/24", "10.10.0.0/24"}},
+			err:  true,
+		},
+		{
+			name: "quoted-location",
+			cfg:  SNMPConfig{Users: []SNMPUser{user}, Location: "DC1 'Row 2'"},
+			err:  true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSNMPKey(t *testing.T) {
+	require.NoError(t, ValidateSNMPKey("authpass1"))
+	require.Error(t, ValidateSNMPKey("short"))
+	require.Error(t, ValidateSNMPKey("with space"))
+}
+
+func TestSystemConfigValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		cfg  *SystemConfig
+		err  bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "empty",
+			cfg:  &SystemConfig{},
+		},
+		{
+			name: "full",
+			cfg: &SystemConfig{
+				NTP: NTPConfig{
+					Servers: []NTPServer{{Address: "10.0.0.1", Prefer: true}, {Address: "10.0.0.2"}},
+					VRF:     ObservabilityVRFMgmt,
+				},
+				DNS: DNSConfig{
+					Servers:      []string{"10.0.0.53", "10.0.1.53"},
+					SearchDomain: "dc1.example.com",
+				},
+				Timezone: "America/Argentina/Buenos_Aires",
+			},
+		},
+		{
+			name: "ntp-hostname",
+			cfg:  &SystemConfig{NTP: NTPConfig{Servers: []NTPServer{{Address: "pool.ntp.org"}}}},
+			err:  true,
+		},
+		{
+			name: "ntp-duplicate",
+			cfg:  &SystemConfig{NTP: NTPConfig{Servers: []NTPServer{{Address: "10.0.0.1"}, {Address: "10.0.0.1"}}}},
+			err:  true,
+		},
+		{
+			name: "ntp-invalid-vrf",
+			cfg:  &SystemConfig{NTP: NTPConfig{VRF: "vrf1"}},
+			err:  true,
+		},
+		{
+			name: "dns-ipv6",
+			cfg:  &SystemConfig{DNS: DNSConfig{Servers: []string{"fd00::53"}}},
+			err:  true,
+		},
+		{
+			name: "dns-too-many",
+			cfg:  &SystemConfig{DNS: DNSConfig{Servers: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"}}},
+			err:  true,
+		},
+		{
+			name: "dns-invalid-search-domain",
+			cfg:  &SystemConfig{DNS: DNSConfig{SearchDomain: "example..com"}},
+			err:  true,
+		},
+		{
+			name: "invalid-timezone",
+			cfg:  &SystemConfig{Timezone: "Europe/Berlin; reboot"},
+			err:  true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -11,6 +11,46 @@ import (
 	"go.githedgehog.com/libmeta/pkg/alloy"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AAAConfig) DeepCopyInto(out *AAAConfig) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]AAAServer, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoginOrder != nil {
+		in, out := &in.LoginOrder, &out.LoginOrder
+		*out = make([]AAALoginMethod, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AAAConfig.
+func (in *AAAConfig) DeepCopy() *AAAConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AAAConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AAAServer) DeepCopyInto(out *AAAServer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AAAServer.
+func (in *AAAServer) DeepCopy() *AAAServer {
+	if in == nil {
+		return nil
+	}
+	out := new(AAAServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlloyConfig) DeepCopyInto(out *AlloyConfig) {
 	*out = *in
@@ -100,11 +140,57 @@ func (in *AlloyTarget) DeepCopy() *AlloyTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSConfig) DeepCopyInto(out *DNSConfig) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSConfig.
+func (in *DNSConfig) DeepCopy() *DNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NTPConfig) DeepCopyInto(out *NTPConfig) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]NTPServer, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NTPConfig.
+func (in *NTPConfig) DeepCopy() *NTPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NTPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Observability) DeepCopyInto(out *Observability) {
 	*out = *in
 	in.Agent.DeepCopyInto(&out.Agent)
 	in.Unix.DeepCopyInto(&out.Unix)
+	out.SFlow = in.SFlow
+	if in.SyslogServers != nil {
+		in, out := &in.SyslogServers, &out.SyslogServers
+		*out = make([]ObservabilitySyslogServer, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Observability.
@@ -139,6 +225,36 @@ func (in *ObservabilityAgent) DeepCopy() *ObservabilityAgent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilitySFlow) DeepCopyInto(out *ObservabilitySFlow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilitySFlow.
+func (in *ObservabilitySFlow) DeepCopy() *ObservabilitySFlow {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilitySFlow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilitySyslogServer) DeepCopyInto(out *ObservabilitySyslogServer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilitySyslogServer.
+func (in *ObservabilitySyslogServer) DeepCopy() *ObservabilitySyslogServer {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilitySyslogServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObservabilityUnix) DeepCopyInto(out *ObservabilityUnix) {
 	*out = *in
@@ -165,3 +281,60 @@ func (in *ObservabilityUnix) DeepCopy() *ObservabilityUnix {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNMPConfig) DeepCopyInto(out *SNMPConfig) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]SNMPUser, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagerPrefixes != nil {
+		in, out := &in.ManagerPrefixes, &out.ManagerPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNMPConfig.
+func (in *SNMPConfig) DeepCopy() *SNMPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SNMPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SNMPUser) DeepCopyInto(out *SNMPUser) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNMPUser.
+func (in *SNMPUser) DeepCopy() *SNMPUser {
+	if in == nil {
+		return nil
+	}
+	out := new(SNMPUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemConfig) DeepCopyInto(out *SystemConfig) {
+	*out = *in
+	in.NTP.DeepCopyInto(&out.NTP)
+	in.DNS.DeepCopyInto(&out.DNS)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemConfig.
+func (in *SystemConfig) DeepCopy() *SystemConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemConfig)
+	in.DeepCopyInto(out)
+	return out
+}
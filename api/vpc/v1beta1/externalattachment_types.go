@@ -19,6 +19,7 @@ import (
 	"net"
 	"net/netip"
 	"slices"
+	"strings"
 
 	"github.com/pkg/errors"
 	"go.githedgehog.com/fabric/api/meta"
@@ -27,6 +28,7 @@ import (
 	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ktypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
@@ -37,18 +39,22 @@ type ACLProtocol string
 type ACLAction string
 
 const (
-	ACLProtocolIP    ACLProtocol = "ip"
-	ACLProtocolTCP   ACLProtocol = "tcp"
-	ACLProtocolUDP   ACLProtocol = "udp"
-	ACLProtocolICMP  ACLProtocol = "icmp"
-	ACLAny                       = "any"
-	ACLActionPermit  ACLAction   = "permit"
-	ACLActionDeny    ACLAction   = "deny"
-	ACLActionDiscard ACLAction   = "discard"
-	ACLActionTransit ACLAction   = "transit"
+	ACLProtocolIP     ACLProtocol = "ip"
+	ACLProtocolTCP    ACLProtocol = "tcp"
+	ACLProtocolUDP    ACLProtocol = "udp"
+	ACLProtocolICMP   ACLProtocol = "icmp"
+	ACLProtocolICMPv6 ACLProtocol = "icmpv6"
+	ACLAny                        = "any"
+	ACLActionPermit   ACLAction   = "permit"
+	ACLActionDeny     ACLAction   = "deny"
+	ACLActionDiscard  ACLAction   = "discard"
+	ACLActionTransit  ACLAction   = "transit"
 	// ACLUserMinSeq is the minimum sequence number allowed for user-defined ACL rules.
 	// Sequence numbers below this are reserved for hardcoded security rules.
 	ACLUserMinSeq = 10
+	// ACLOutboundMaxSeq is the maximum sequence number allowed for user-defined outbound ACL rules.
+	// Sequence numbers above this are reserved for the IPv4 namespace protection and default permit rules.
+	ACLOutboundMaxSeq = 64999
 )
 
 var ACLProtocols = []ACLProtocol{
@@ -56,6 +62,7 @@ var ACLProtocols = []ACLProtocol{
 	ACLProtocolTCP,
 	ACLProtocolUDP,
 	ACLProtocolICMP,
+	ACLProtocolICMPv6,
 }
 
 var ACLActions = []ACLAction{
@@ -94,11 +101,60 @@ type ACLStatement struct {
 	ICMPCode       *uint8         `json:"icmpCode,omitempty"`
 }
 
-// ACLSpec defines an IPv4 Access Control List applied to inbound traffic on an external attachment.
+// IsIPv4 returns true if the statement applies to the IPv4 traffic. Statements with "any" source and destination
+// prefixes apply to both IPv4 and IPv6 traffic unless the protocol is family specific (icmp or icmpv6).
+func (stmt *ACLStatement) IsIPv4() bool {
+	if stmt.Protocol == ACLProtocolICMPv6 {
+		return false
+	}
+
+	return !aclPrefixIsIPv6(stmt.SrcPrefix) && !aclPrefixIsIPv6(stmt.DstPrefix)
+}
+
+// IsIPv6 returns true if the statement applies to the IPv6 traffic, see IsIPv4 for details
+func (stmt *ACLStatement) IsIPv6() bool {
+	if stmt.Protocol == ACLProtocolICMP {
+		return false
+	}
+
+	return !aclPrefixIsIPv4(stmt.SrcPrefix) && !aclPrefixIsIPv4(stmt.DstPrefix)
+}
+
+func aclPrefixIsIPv4(prefix string) bool {
+	if prefix == ACLAny {
+		return false
+	}
+
+	parsed, err := netip.ParsePrefix(prefix)
+
+	return err == nil && parsed.Addr().Is4()
+}
+
+func aclPrefixIsIPv6(prefix string) bool {
+	if prefix == ACLAny {
+		return false
+	}
+
+	parsed, err := netip.ParsePrefix(prefix)
+
+	return err == nil && parsed.Addr().Is6()
+}
+
+// ACLSpec defines an Access Control List applied to inbound or outbound traffic on an external attachment. IPv4 and
+// IPv6 statements are applied as separate ACLs on the switch, statements with "any" prefixes are added to both of them.
 type ACLSpec struct {
 	Statements []ACLStatement `json:"statements,omitempty"`
 }
 
+// HasIPv6 returns true if any of the statements applies to the IPv6 traffic, so IPv6 ACL is needed
+func (spec *ACLSpec) HasIPv6() bool {
+	if spec == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(spec.Statements, func(stmt ACLStatement) bool { return stmt.IsIPv6() })
+}
+
 func (spec *ACLSpec) Validate() (admission.Warnings, error) {
 	if spec == nil {
 		return nil, nil
@@ -139,6 +195,17 @@ func (spec *ACLSpec) Validate() (admission.Warnings, error) {
 			}
 		}
 
+		if aclPrefixIsIPv4(stmt.SrcPrefix) && aclPrefixIsIPv6(stmt.DstPrefix) ||
+			aclPrefixIsIPv6(stmt.SrcPrefix) && aclPrefixIsIPv4(stmt.DstPrefix) {
+			return nil, errors.Errorf("srcPrefix and dstPrefix must be of the same IP family in statement %d", stmt.Seq)
+		}
+		if stmt.Protocol == ACLProtocolICMP && !stmt.IsIPv4() {
+			return nil, errors.Errorf("icmp protocol can only be used with IPv4 prefixes in statement %d, use icmpv6 instead", stmt.Seq)
+		}
+		if stmt.Protocol == ACLProtocolICMPv6 && !stmt.IsIPv6() {
+			return nil, errors.Errorf("icmpv6 protocol can only be used with IPv6 prefixes in statement %d, use icmp instead", stmt.Seq)
+		}
+
 		if stmt.TCPFilters != nil {
 			if stmt.Protocol != ACLProtocolTCP {
 				return nil, errors.Errorf("tcpFilters can only be used with tcp protocol in statement %d", stmt.Seq)
@@ -160,8 +227,8 @@ func (spec *ACLSpec) Validate() (admission.Warnings, error) {
 				return nil, errors.Errorf("contradictory TCP flags (flag and its negation) in statement %d", stmt.Seq)
 			}
 		}
-		if (stmt.ICMPType != nil || stmt.ICMPCode != nil) && stmt.Protocol != ACLProtocolICMP {
-			return nil, errors.Errorf("icmpType/icmpCode can only be used with icmp protocol in statement %d", stmt.Seq)
+		if (stmt.ICMPType != nil || stmt.ICMPCode != nil) && stmt.Protocol != ACLProtocolICMP && stmt.Protocol != ACLProtocolICMPv6 {
+			return nil, errors.Errorf("icmpType/icmpCode can only be used with icmp or icmpv6 protocol in statement %d", stmt.Seq)
 		}
 		if stmt.PortRangeEnd > 0 || stmt.PortRangeBegin > 0 {
 			if stmt.Protocol != ACLProtocolTCP && stmt.Protocol != ACLProtocolUDP {
@@ -195,6 +262,10 @@ type ExternalAttachmentSpec struct {
 	// InboundACL defines the ACL statements to apply to inbound traffic on this external attachment
 	// +optional
 	InboundACL *ACLSpec `json:"inboundACL,omitempty"`
+	// OutboundACL defines the ACL statements to apply to outbound traffic on this external attachment (from the fabric
+	// towards the external), traffic not matching any of the statements is permitted
+	// +optional
+	OutboundACL *ACLSpec `json:"outboundACL,omitempty"`
 }
 
 // ExternalAttachmentSwitch defines the switch port configuration for the external attachment
@@ -211,6 +282,40 @@ type ExternalAttachmentNeighbor struct {
 	ASN uint32 `json:"asn,omitempty"`
 	// IP is the IP address of the BGP neighbor to peer with (without prefix length)
 	IP string `json:"ip,omitempty"`
+	// PasswordSecret is the name of the Secret in the same namespace holding the password (under the "password" key)
+	// used to authenticate the BGP session with the neighbor using TCP MD5 signature
+	// +optional
+	PasswordSecret string `json:"passwordSecret,omitempty"`
+	// MaxPrefix limits the number of prefixes accepted from the neighbor
+	// +optional
+	MaxPrefix *ExternalAttachmentNeighborMaxPrefix `json:"maxPrefix,omitempty"`
+	// KeepaliveTime is the BGP keepalive interval in seconds, should be set together with HoldTime
+	// +optional
+	KeepaliveTime uint16 `json:"keepaliveTime,omitempty"`
+	// HoldTime is the BGP hold time in seconds, should be set together with KeepaliveTime
+	// +optional
+	HoldTime uint16 `json:"holdTime,omitempty"`
+}
+
+type ExternalAttachmentMaxPrefixAction string
+
+const (
+	ExternalAttachmentMaxPrefixActionShutdown ExternalAttachmentMaxPrefixAction = "shutdown"
+	ExternalAttachmentMaxPrefixActionWarning  ExternalAttachmentMaxPrefixAction = "warning"
+)
+
+// ExternalAttachmentPasswordSecretKey is the key in the Secret referenced by the neighbor PasswordSecret
+const ExternalAttachmentPasswordSecretKey = "password"
+
+// ExternalAttachmentNeighborMaxPrefix defines the limit of the prefixes accepted from the BGP neighbor
+type ExternalAttachmentNeighborMaxPrefix struct {
+	// Limit is the maximum number of prefixes accepted from the neighbor
+	Limit uint32 `json:"limit"`
+	// Action is the action taken when the limit is exceeded: "shutdown" (default) tears the session down and "warning"
+	// only logs a warning
+	// +kubebuilder:validation:Enum=shutdown;warning
+	// +optional
+	Action ExternalAttachmentMaxPrefixAction `json:"action,omitempty"`
 }
 
 // ExternalAttachmentStatic defines parameters used for staticexternal attachments
@@ -225,8 +330,35 @@ type ExternalAttachmentStatic struct {
 	Proxy bool `json:"proxy,omitempty"`
 }
 
+// ExternalAttachmentConditionBGPEstablished is true when the BGP session with the external neighbor is established
+const ExternalAttachmentConditionBGPEstablished = "BGPEstablished"
+
 // ExternalAttachmentStatus defines the observed state of ExternalAttachment
-type ExternalAttachmentStatus struct{}
+type ExternalAttachmentStatus struct {
+	// BGP is the observed state of the BGP session with the neighbor as reported by the switch agent, not set for
+	// static external attachments
+	BGP *ExternalAttachmentBGPStatus `json:"bgp,omitempty"`
+	// Conditions of the external attachment, includes BGPEstablished condition for the BGP external attachments
+	Conditions []kmetav1.Condition `json:"conditions,omitempty"`
+}
+
+// ExternalAttachmentBGPStatus defines the observed state of the BGP session with the external neighbor
+type ExternalAttachmentBGPStatus struct {
+	// Switch is the name of the switch the BGP session is configured on
+	Switch string `json:"switch,omitempty"`
+	// SessionState is the state of the BGP session, e.g. idle, active or established
+	SessionState string `json:"sessionState,omitempty"`
+	// EstablishedSince is the time the BGP session was last established, it's used to calculate the session uptime
+	EstablishedSince *kmetav1.Time `json:"establishedSince,omitempty"`
+	// PrefixesReceived is the number of IPv4 unicast prefixes received from the neighbor before applying the policies
+	PrefixesReceived uint32 `json:"prefixesReceived,omitempty"`
+	// PrefixesAccepted is the number of IPv4 unicast prefixes received from the neighbor and accepted by the policies
+	PrefixesAccepted uint32 `json:"prefixesAccepted,omitempty"`
+	// LastResetReason is the reason of the last BGP session reset
+	LastResetReason string `json:"lastResetReason,omitempty"`
+	// LastResetTime is the time of the last BGP session reset
+	LastResetTime *kmetav1.Time `json:"lastResetTime,omitempty"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
@@ -237,6 +369,9 @@ type ExternalAttachmentStatus struct{}
 // +kubebuilder:printcolumn:name="SwIP",type=string,JSONPath=`.spec.switch.ip`,priority=1
 // +kubebuilder:printcolumn:name="NeighASN",type=string,JSONPath=`.spec.neighbor.asn`,priority=1
 // +kubebuilder:printcolumn:name="NeighIP",type=string,JSONPath=`.spec.neighbor.ip`,priority=1
+// +kubebuilder:printcolumn:name="BGP",type=string,JSONPath=`.status.bgp.sessionState`,priority=0
+// +kubebuilder:printcolumn:name="Uptime",type=date,JSONPath=`.status.bgp.establishedSince`,priority=1
+// +kubebuilder:printcolumn:name="Accepted",type=string,JSONPath=`.status.bgp.prefixesAccepted`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,priority=0
 // ExternalAttachment is a definition of how specific switch is connected with external system (External object).
 // Effectively it represents BGP peering between the switch and external system including all needed configuration.
@@ -292,6 +427,10 @@ func (attach *ExternalAttachment) Default() {
 
 	attach.Labels[wiringapi.LabelConnection] = attach.Spec.Connection
 	attach.Labels[LabelExternal] = attach.Spec.External
+
+	if attach.Spec.Neighbor.MaxPrefix != nil && attach.Spec.Neighbor.MaxPrefix.Action == "" {
+		attach.Spec.Neighbor.MaxPrefix.Action = ExternalAttachmentMaxPrefixActionShutdown
+	}
 }
 
 func (attach *ExternalAttachment) Validate(ctx context.Context, kube kclient.Reader, _ *meta.FabricConfig) (admission.Warnings, error) {
@@ -321,11 +460,15 @@ func (attach *ExternalAttachment) Validate(ctx context.Context, kube kclient.Rea
 		if ip := net.ParseIP(attach.Spec.Neighbor.IP); ip == nil {
 			return nil, errors.New("neighbor.ip is not a valid IP address") //nolint: goerr113
 		}
+		if err := attach.Spec.Neighbor.validateSession(); err != nil {
+			return nil, err
+		}
 	} else {
 		if attach.Spec.Switch.IP != "" || attach.Spec.Switch.VLAN != 0 {
 			return nil, errors.Errorf("switch parameters must not be set for static external attachment")
 		}
-		if attach.Spec.Neighbor.ASN != 0 || attach.Spec.Neighbor.IP != "" {
+		if attach.Spec.Neighbor.ASN != 0 || attach.Spec.Neighbor.IP != "" || attach.Spec.Neighbor.PasswordSecret != "" ||
+			attach.Spec.Neighbor.MaxPrefix != nil || attach.Spec.Neighbor.KeepaliveTime != 0 || attach.Spec.Neighbor.HoldTime != 0 {
 			return nil, errors.Errorf("neighbor parameters must not be set for static external attachment")
 		}
 		if attach.Spec.Static.RemoteIP == "" {
@@ -348,6 +491,16 @@ func (attach *ExternalAttachment) Validate(ctx context.Context, kube kclient.Rea
 	if _, err := attach.Spec.InboundACL.Validate(); err != nil {
 		return nil, errors.Wrapf(err, "invalid inboundACL")
 	}
+	if _, err := attach.Spec.OutboundACL.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid outboundACL")
+	}
+	if attach.Spec.OutboundACL != nil {
+		for _, stmt := range attach.Spec.OutboundACL.Statements {
+			if stmt.Seq > ACLOutboundMaxSeq {
+				return nil, errors.Errorf("sequence number %d is too large in outboundACL (user-defined rules must use seq <= %d)", stmt.Seq, ACLOutboundMaxSeq)
+			}
+		}
+	}
 
 	if kube != nil {
 		ext := &External{}
@@ -378,6 +531,17 @@ func (attach *ExternalAttachment) Validate(ctx context.Context, kube kclient.Rea
 			return nil, errors.Errorf("connection %s is not external", attach.Spec.Connection)
 		}
 
+		if attach.Spec.InboundACL != nil || attach.Spec.OutboundACL != nil {
+			switchName := conn.Spec.External.SwitchName()
+			sp, err := getSwitchProfile(ctx, kube, attach.Namespace, switchName)
+			if err != nil {
+				return nil, err
+			}
+			if !sp.Spec.Features.ACLs {
+				return nil, errors.Errorf("switch %s with profile %s doesn't support ACLs required by external attachment", switchName, sp.Name)
+			}
+		}
+
 		// validate VLAN collision
 		attaches := &ExternalAttachmentList{}
 		if err := kube.List(ctx, attaches, kclient.MatchingLabels{wiringapi.LabelName("connection"): attach.Spec.Connection}); err != nil {
@@ -403,3 +567,36 @@ func (attach *ExternalAttachment) Validate(ctx context.Context, kube kclient.Rea
 
 	return nil, nil
 }
+
+// validateSession validates the optional BGP session parameters of the neighbor
+func (neigh *ExternalAttachmentNeighbor) validateSession() error {
+	if neigh.PasswordSecret != "" {
+		if errs := validation.IsDNS1123Subdomain(neigh.PasswordSecret); len(errs) > 0 {
+			return errors.Errorf("neighbor.passwordSecret %q is not a valid secret name: %s", neigh.PasswordSecret, strings.Join(errs, ", "))
+		}
+	}
+
+	if neigh.MaxPrefix != nil {
+		if neigh.MaxPrefix.Limit == 0 {
+			return errors.Errorf("neighbor.maxPrefix.limit must be > 0")
+		}
+		if neigh.MaxPrefix.Action != ExternalAttachmentMaxPrefixActionShutdown && neigh.MaxPrefix.Action != ExternalAttachmentMaxPrefixActionWarning {
+			return errors.Errorf("invalid neighbor.maxPrefix.action %q, must be %s or %s", neigh.MaxPrefix.Action,
+				ExternalAttachmentMaxPrefixActionShutdown, ExternalAttachmentMaxPrefixActionWarning)
+		}
+	}
+
+	if neigh.KeepaliveTime != 0 || neigh.HoldTime != 0 {
+		if neigh.KeepaliveTime == 0 || neigh.HoldTime == 0 {
+			return errors.Errorf("neighbor.keepaliveTime and neighbor.holdTime must be set together")
+		}
+		if neigh.HoldTime < 3 {
+			return errors.Errorf("neighbor.holdTime must be >= 3 seconds")
+		}
+		if neigh.KeepaliveTime >= neigh.HoldTime {
+			return errors.Errorf("neighbor.keepaliveTime must be less than neighbor.holdTime")
+		}
+	}
+
+	return nil
+}
@@ -115,6 +115,39 @@ func TestExternalAttachmentValidation(t *testing.T) {
 				},
 			},
 		},
+		&wiringapi.Switch{
+			ObjectMeta: kmetav1.ObjectMeta{
+				Name:      "leaf-01",
+				Namespace: kmetav1.NamespaceDefault,
+			},
+			Spec: wiringapi.SwitchSpec{
+				Profile: "test-profile",
+			},
+		},
+		&wiringapi.SwitchProfile{
+			ObjectMeta: kmetav1.ObjectMeta{
+				Name:      "test-profile",
+				Namespace: kmetav1.NamespaceDefault,
+			},
+			Spec: wiringapi.SwitchProfileSpec{
+				Features: wiringapi.SwitchProfileFeatures{
+					ACLs: true,
+				},
+			},
+		},
+	}
+	noACLsObjs := withObjs(baseObjs[:len(baseObjs)-1], &wiringapi.SwitchProfile{
+		ObjectMeta: kmetav1.ObjectMeta{
+			Name:      "test-profile",
+			Namespace: kmetav1.NamespaceDefault,
+		},
+	})
+	denyStmt := v1beta1.ACLStatement{
+		Seq:       10,
+		Action:    v1beta1.ACLActionDeny,
+		Protocol:  v1beta1.ACLProtocolIP,
+		SrcPrefix: "10.0.1.0/24",
+		DstPrefix: v1beta1.ACLAny,
 	}
 	tests := []struct {
 		name    string
@@ -227,6 +260,112 @@ func TestExternalAttachmentValidation(t *testing.T) {
 			objects: baseObjs,
 			err:     true,
 		},
+		{
+			name: "valid attachment with inline outbound ACL",
+			extAtt: l3ExtAttGen("ext-att-12", func(att *v1beta1.ExternalAttachment) {
+				att.Spec.OutboundACL = &v1beta1.ACLSpec{Statements: []v1beta1.ACLStatement{denyStmt}}
+			}),
+			objects: baseObjs,
+		},
+		{
+			name: "valid static attachment with inline outbound ACL",
+			extAtt: staticExtAttGen("ext-att-13", func(att *v1beta1.ExternalAttachment) {
+				att.Spec.OutboundACL = &v1beta1.ACLSpec{Statements: []v1beta1.ACLStatement{denyStmt}}
+			}),
+			objects: baseObjs,
+		},
+		{
+			name: "invalid inline outbound ACL",
+			extAtt: l3ExtAttGen("ext-att-14", func(att *v1beta1.ExternalAttachment) {
+				stmt := denyStmt
+				stmt.Seq = 5
+				att.Spec.OutboundACL = &v1beta1.ACLSpec{Statements: []v1beta1.ACLStatement{stmt}}
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name: "outbound ACL seq in the reserved range",
+			extAtt: l3ExtAttGen("ext-att-14", func(att *v1beta1.ExternalAttachment) {
+				stmt := denyStmt
+				stmt.Seq = 65000
+				att.Spec.OutboundACL = &v1beta1.ACLSpec{Statements: []v1beta1.ACLStatement{stmt}}
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name: "outbound ACL on switch without ACLs support",
+			extAtt: l3ExtAttGen("ext-att-15", func(att *v1beta1.ExternalAttachment) {
+				att.Spec.OutboundACL = &v1beta1.ACLSpec{Statements: []v1beta1.ACLStatement{denyStmt}}
+			}),
+			objects: noACLsObjs,
+			err:     true,
+		},
+		{
+			name: "inbound ACL on switch without ACLs support",
+			extAtt: l3ExtAttGen("ext-att-16", func(att *v1beta1.ExternalAttachment) {
+				att.Spec.InboundACL = &v1beta1.ACLSpec{Statements: []v1beta1.ACLStatement{denyStmt}}
+			}),
+			objects: noACLsObjs,
+			err:     true,
+		},
+		{
+			name:    "no ACLs on switch without ACLs support",
+			extAtt:  l3ExtAttGen("ext-att-17"),
+			objects: noACLsObjs,
+		},
+		{
+			name: "valid neighbor with password, max prefix and timers",
+			extAtt: l3ExtAttGen("ext-att-18", func(att *v1beta1.ExternalAttachment) {
+				att.Spec.Neighbor.PasswordSecret = "ext-bgp-password"
+				att.Spec.Neighbor.MaxPrefix = &v1beta1.ExternalAttachmentNeighborMaxPrefix{Limit: 1000}
+				att.Spec.Neighbor.KeepaliveTime = 10
+				att.Spec.Neighbor.HoldTime = 30
+			}),
+			objects: baseObjs,
+		},
+		{
+			name: "invalid password secret name",
+			extAtt: l3ExtAttGen("ext-att-19", func(att *v1beta1.ExternalAttachment) {
+				att.Spec.Neighbor.PasswordSecret = "Invalid_Secret"
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name: "max prefix limit is zero",
+			extAtt: l3ExtAttGen("ext-att-20", func(att *v1beta1.ExternalAttachment) {
+				att.Spec.Neighbor.MaxPrefix = &v1beta1.ExternalAttachmentNeighborMaxPrefix{Limit: 0}
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name: "only keepalive time set",
+			extAtt: l3ExtAttGen("ext-att-21", func(att *v1beta1.ExternalAttachment) {
+				att.Spec.Neighbor.KeepaliveTime = 10
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name: "keepalive time not less than hold time",
+			extAtt: l3ExtAttGen("ext-att-22", func(att *v1beta1.ExternalAttachment) {
+				att.Spec.Neighbor.KeepaliveTime = 30
+				att.Spec.Neighbor.HoldTime = 30
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name: "static attach with neighbor password",
+			extAtt: staticExtAttGen("ext-att-23", func(att *v1beta1.ExternalAttachment) {
+				att.Spec.Neighbor.PasswordSecret = "ext-bgp-password"
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
 	}
 
 	scheme := runtime.NewScheme()
@@ -501,6 +640,51 @@ func TestExternalAttachmentInboundACLValidation(t *testing.T) {
 			}),
 			err: true,
 		},
+		{
+			name: "valid IPv6 TCP statement",
+			extAtt: extAttWithACL(v1beta1.ACLStatement{
+				Seq:            10,
+				Action:         v1beta1.ACLActionPermit,
+				Protocol:       v1beta1.ACLProtocolTCP,
+				SrcPrefix:      "2001:db8::/32",
+				DstPrefix:      v1beta1.ACLAny,
+				PortRangeBegin: 443,
+				PortRangeEnd:   443,
+			}),
+		},
+		{
+			name: "valid ICMPv6 statement with type and code",
+			extAtt: extAttWithACL(v1beta1.ACLStatement{
+				Seq:       10,
+				Action:    v1beta1.ACLActionPermit,
+				Protocol:  v1beta1.ACLProtocolICMPv6,
+				SrcPrefix: v1beta1.ACLAny,
+				DstPrefix: "2001:db8:1::/48",
+				ICMPType:  ptr[uint8](128),
+				ICMPCode:  ptr[uint8](0),
+			}),
+		},
+		{
+			name: "mixed IPv4 and IPv6 prefixes",
+			extAtt: extAttWithACL(v1beta1.ACLStatement{
+				Seq: 10, Action: v1beta1.ACLActionPermit, Protocol: v1beta1.ACLProtocolIP, SrcPrefix: "10.0.0.0/8", DstPrefix: "2001:db8::/32",
+			}),
+			err: true,
+		},
+		{
+			name: "ICMP protocol with IPv6 prefix",
+			extAtt: extAttWithACL(v1beta1.ACLStatement{
+				Seq: 10, Action: v1beta1.ACLActionPermit, Protocol: v1beta1.ACLProtocolICMP, SrcPrefix: "2001:db8::/32", DstPrefix: v1beta1.ACLAny,
+			}),
+			err: true,
+		},
+		{
+			name: "ICMPv6 protocol with IPv4 prefix",
+			extAtt: extAttWithACL(v1beta1.ACLStatement{
+				Seq: 10, Action: v1beta1.ACLActionPermit, Protocol: v1beta1.ACLProtocolICMPv6, SrcPrefix: v1beta1.ACLAny, DstPrefix: "10.0.0.0/8",
+			}),
+			err: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -514,3 +698,44 @@ func TestExternalAttachmentInboundACLValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestACLStatementFamily(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		stmt v1beta1.ACLStatement
+		ipv4 bool
+		ipv6 bool
+	}{
+		{
+			name: "any prefixes",
+			stmt: v1beta1.ACLStatement{Protocol: v1beta1.ACLProtocolTCP, SrcPrefix: v1beta1.ACLAny, DstPrefix: v1beta1.ACLAny},
+			ipv4: true,
+			ipv6: true,
+		},
+		{
+			name: "IPv4 prefix",
+			stmt: v1beta1.ACLStatement{Protocol: v1beta1.ACLProtocolIP, SrcPrefix: v1beta1.ACLAny, DstPrefix: "10.0.0.0/8"},
+			ipv4: true,
+		},
+		{
+			name: "IPv6 prefix",
+			stmt: v1beta1.ACLStatement{Protocol: v1beta1.ACLProtocolUDP, SrcPrefix: "2001:db8::/32", DstPrefix: v1beta1.ACLAny},
+			ipv6: true,
+		},
+		{
+			name: "ICMP with any prefixes",
+			stmt: v1beta1.ACLStatement{Protocol: v1beta1.ACLProtocolICMP, SrcPrefix: v1beta1.ACLAny, DstPrefix: v1beta1.ACLAny},
+			ipv4: true,
+		},
+		{
+			name: "ICMPv6 with any prefixes",
+			stmt: v1beta1.ACLStatement{Protocol: v1beta1.ACLProtocolICMPv6, SrcPrefix: v1beta1.ACLAny, DstPrefix: v1beta1.ACLAny},
+			ipv6: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.ipv4, test.stmt.IsIPv4())
+			require.Equal(t, test.ipv6, test.stmt.IsIPv6())
+		})
+	}
+}
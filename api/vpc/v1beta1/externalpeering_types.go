@@ -16,6 +16,8 @@ package v1beta1
 
 import (
 	"context"
+	"regexp"
+	"slices"
 	"sort"
 
 	"github.com/pkg/errors"
@@ -29,6 +31,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
+// asPathChecker allows only AS numbers and the metacharacters supported by the FRR AS-path regexes (POSIX extended
+// with "_" matching any AS-path delimiter), so it's not possible to use RE2 specific syntax like escapes or classes
+var asPathChecker = regexp.MustCompile(`^[0-9 _^$.*+?()\[\]{}|,-]+$`)
+
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // ExternalPeeringSpec defines the desired state of ExternalPeering
@@ -59,6 +65,23 @@ type ExternalPeeringSpecExternal struct {
 	Name string `json:"name,omitempty"`
 	// Prefixes is the list of prefixes to permit from the External to the VPC
 	Prefixes []ExternalPeeringSpecPrefix `json:"prefixes,omitempty"`
+	// RoutePolicy is the optional policy to further select the routes imported from the External to the VPC and to
+	// set their local preference
+	RoutePolicy *ExternalPeeringSpecRoutePolicy `json:"routePolicy,omitempty"`
+}
+
+// ExternalPeeringSpecRoutePolicy defines which of the permitted routes are imported from the External to the VPC and
+// with which local preference. If both AS-path regexes and communities are specified, routes should match both.
+type ExternalPeeringSpecRoutePolicy struct {
+	// ASPaths is the list of AS-path regular expressions, routes with AS-path matching any of them are imported,
+	// e.g. "^65102_" for routes received from AS 65102. Only the POSIX extended syntax with "_" matching any AS-path
+	// delimiter is supported, so regexes could contain only AS numbers, spaces and the _^$.*+?()[]{}|,- characters
+	ASPaths []string `json:"asPaths,omitempty"`
+	// Communities is the list of communities, routes with any of them are imported (e.g. 65102:100)
+	Communities []string `json:"communities,omitempty"`
+	// LocalPreference is the local preference set on the imported routes, it allows to use one External as a primary
+	// and another one as a backup for the same prefixes, default is 150
+	LocalPreference uint32 `json:"localPreference,omitempty"`
 }
 
 // ExternalPeeringSpecPrefix defines the prefix to permit from the External to the VPC
@@ -74,7 +97,12 @@ type ExternalPeeringSpecPrefix struct {
 }
 
 // ExternalPeeringStatus defines the observed state of ExternalPeering
-type ExternalPeeringStatus struct{}
+type ExternalPeeringStatus struct {
+	// Switches is the list of switches the peering is configured on with the generation applied by each of them
+	Switches []SwitchApplyStatus `json:"switches,omitempty"`
+	// Conditions of the peering, includes Applied condition for use with kubectl wait
+	Conditions []kmetav1.Condition `json:"conditions,omitempty"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
@@ -83,6 +111,7 @@ type ExternalPeeringStatus struct{}
 // +kubebuilder:printcolumn:name="VPCSubnets",type=string,JSONPath=`.spec.permit.vpc.subnets`,priority=1
 // +kubebuilder:printcolumn:name="External",type=string,JSONPath=`.spec.permit.external.name`,priority=0
 // +kubebuilder:printcolumn:name="ExtPrefixes",type=string,JSONPath=`.spec.permit.external.prefixes`,priority=1
+// +kubebuilder:printcolumn:name="Applied",type=string,JSONPath=`.status.conditions[?(@.type=="Applied")].status`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,priority=0
 // ExternalPeering is the Schema for the externalpeerings API
 type ExternalPeering struct {
@@ -144,6 +173,10 @@ func (peering *ExternalPeering) Default() {
 	sort.Slice(peering.Spec.Permit.External.Prefixes, func(i, j int) bool {
 		return peering.Spec.Permit.External.Prefixes[i].Prefix < peering.Spec.Permit.External.Prefixes[j].Prefix
 	})
+
+	if policy := peering.Spec.Permit.External.RoutePolicy; policy != nil {
+		sort.Strings(policy.Communities)
+	}
 }
 
 func (peering *ExternalPeering) Validate(ctx context.Context, kube kclient.Reader, _ *meta.FabricConfig) (admission.Warnings, error) {
@@ -175,6 +208,36 @@ func (peering *ExternalPeering) Validate(ctx context.Context, kube kclient.Reade
 		// TODO add more validation for prefix/ge/le
 	}
 
+	if policy := peering.Spec.Permit.External.RoutePolicy; policy != nil {
+		if len(policy.ASPaths) == 0 && len(policy.Communities) == 0 && policy.LocalPreference == 0 {
+			return nil, errors.Errorf("external.routePolicy must have at least one of asPaths, communities or localPreference")
+		}
+
+		for idx, asPath := range policy.ASPaths {
+			if asPath == "" {
+				return nil, errors.Errorf("external.routePolicy.asPaths regex is required (idx %d)", idx)
+			}
+			if !asPathChecker.MatchString(asPath) {
+				return nil, errors.Errorf("external.routePolicy.asPaths regex %q has unsupported characters, only AS numbers, spaces and _^$.*+?()[]{}|,- are allowed", asPath)
+			}
+			if _, err := regexp.CompilePOSIX(asPath); err != nil {
+				return nil, errors.Wrapf(err, "external.routePolicy.asPaths regex %q is invalid", asPath)
+			}
+			if slices.Contains(policy.ASPaths[idx+1:], asPath) {
+				return nil, errors.Errorf("external.routePolicy.asPaths regex %q is duplicated", asPath)
+			}
+		}
+
+		for idx, comm := range policy.Communities {
+			if !communityCheck.MatchString(comm) {
+				return nil, errors.Errorf("external.routePolicy.communities %s is not a valid community, example 50000:50001", comm)
+			}
+			if slices.Contains(policy.Communities[idx+1:], comm) {
+				return nil, errors.Errorf("external.routePolicy.communities %s is duplicated", comm)
+			}
+		}
+	}
+
 	if kube != nil {
 		vpc := &VPC{}
 		if err := kube.Get(ctx, ktypes.NamespacedName{Name: peering.Spec.Permit.VPC.Name, Namespace: peering.Namespace}, vpc); err != nil {
@@ -198,6 +261,12 @@ func (peering *ExternalPeering) Validate(ctx context.Context, kube kclient.Reade
 			return nil, errors.Errorf("vpc's IPv4 namespace %s is different from the external's IPv4 namespace %s", vpc.Spec.IPv4Namespace, ext.Spec.IPv4Namespace)
 		}
 
+		if policy := peering.Spec.Permit.External.RoutePolicy; policy != nil && ext.Spec.Static != nil {
+			if len(policy.ASPaths) > 0 || len(policy.Communities) > 0 {
+				return nil, errors.Errorf("external.routePolicy asPaths and communities aren't supported for static external %s", peering.Spec.Permit.External.Name)
+			}
+		}
+
 		for _, subnet := range peering.Spec.Permit.VPC.Subnets {
 			if _, exists := vpc.Spec.Subnets[subnet]; !exists {
 				return nil, errors.Errorf("vpc %s does not have subnet %s", peering.Spec.Permit.VPC.Name, subnet)
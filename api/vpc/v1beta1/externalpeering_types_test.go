@@ -82,6 +82,18 @@ func TestExternalPeeringValidation(t *testing.T) {
 				OutboundCommunity: "50000:2002",
 			},
 		},
+		&v1beta1.External{
+			ObjectMeta: kmetav1.ObjectMeta{
+				Name:      "external-static",
+				Namespace: kmetav1.NamespaceDefault,
+			},
+			Spec: v1beta1.ExternalSpec{
+				IPv4Namespace: "default",
+				Static: &v1beta1.ExternalStaticSpec{
+					Prefixes: []string{"10.99.0.0/16"},
+				},
+			},
+		},
 	}
 
 	tests := []struct {
@@ -182,6 +194,118 @@ func TestExternalPeeringValidation(t *testing.T) {
 			objects: baseObjs,
 			err:     false,
 		},
+		{
+			name: "valid route policy",
+			peering: extPeeringGen("ext-peer-14", func(peering *v1beta1.ExternalPeering) {
+				peering.Spec.Permit.External.RoutePolicy = &v1beta1.ExternalPeeringSpecRoutePolicy{
+					ASPaths:         []string{"^65102_", "_65103$"},
+					Communities:     []string{"65102:200", "65102:100"},
+					LocalPreference: 200,
+				}
+			}),
+			objects: baseObjs,
+			err:     false,
+		},
+		{
+			name: "valid route policy with local preference only",
+			peering: extPeeringGen("ext-peer-15", func(peering *v1beta1.ExternalPeering) {
+				peering.Spec.Permit.External.RoutePolicy = &v1beta1.ExternalPeeringSpecRoutePolicy{
+					LocalPreference: 50,
+				}
+			}),
+			objects: baseObjs,
+			err:     false,
+		},
+		{
+			name: "empty route policy",
+			peering: extPeeringGen("ext-peer-16", func(peering *v1beta1.ExternalPeering) {
+				peering.Spec.Permit.External.RoutePolicy = &v1beta1.ExternalPeeringSpecRoutePolicy{}
+			}),
+			objects: nil,
+			err:     true,
+		},
+		{
+			name: "invalid route policy as-path regex",
+			peering: extPeeringGen("ext-peer-17", func(peering *v1beta1.ExternalPeering) {
+				peering.Spec.Permit.External.RoutePolicy = &v1beta1.ExternalPeeringSpecRoutePolicy{
+					ASPaths: []string{"^(65102_"},
+				}
+			}),
+			objects: nil,
+			err:     true,
+		},
+		{
+			name: "valid route policy with frr as-path regex",
+			peering: extPeeringGen("ext-peer-23", func(peering *v1beta1.ExternalPeering) {
+				peering.Spec.Permit.External.RoutePolicy = &v1beta1.ExternalPeeringSpecRoutePolicy{
+					ASPaths: []string{"^6510[0-9]_", "^(65102_){2}", "_(65103|65104)$"},
+				}
+			}),
+			objects: baseObjs,
+			err:     false,
+		},
+		{
+			name: "unsupported route policy as-path regex syntax",
+			peering: extPeeringGen("ext-peer-24", func(peering *v1beta1.ExternalPeering) {
+				peering.Spec.Permit.External.RoutePolicy = &v1beta1.ExternalPeeringSpecRoutePolicy{
+					ASPaths: []string{`^\d+_`},
+				}
+			}),
+			objects: nil,
+			err:     true,
+		},
+		{
+			name: "duplicate route policy as-path regex",
+			peering: extPeeringGen("ext-peer-18", func(peering *v1beta1.ExternalPeering) {
+				peering.Spec.Permit.External.RoutePolicy = &v1beta1.ExternalPeeringSpecRoutePolicy{
+					ASPaths: []string{"^65102_", "^65102_"},
+				}
+			}),
+			objects: nil,
+			err:     true,
+		},
+		{
+			name: "invalid route policy community",
+			peering: extPeeringGen("ext-peer-19", func(peering *v1beta1.ExternalPeering) {
+				peering.Spec.Permit.External.RoutePolicy = &v1beta1.ExternalPeeringSpecRoutePolicy{
+					Communities: []string{"65102:70000"},
+				}
+			}),
+			objects: nil,
+			err:     true,
+		},
+		{
+			name: "duplicate route policy community",
+			peering: extPeeringGen("ext-peer-20", func(peering *v1beta1.ExternalPeering) {
+				peering.Spec.Permit.External.RoutePolicy = &v1beta1.ExternalPeeringSpecRoutePolicy{
+					Communities: []string{"65102:100", "65102:100"},
+				}
+			}),
+			objects: nil,
+			err:     true,
+		},
+		{
+			name: "route policy matching for static external",
+			peering: extPeeringGen("ext-peer-21", func(peering *v1beta1.ExternalPeering) {
+				peering.Spec.Permit.External.Name = "external-static"
+				peering.Spec.Permit.External.RoutePolicy = &v1beta1.ExternalPeeringSpecRoutePolicy{
+					Communities: []string{"65102:100"},
+				}
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name: "route policy local preference for static external",
+			peering: extPeeringGen("ext-peer-22", func(peering *v1beta1.ExternalPeering) {
+				peering.Spec.Permit.External.Name = "external-static"
+				peering.Spec.Permit.External.RoutePolicy = &v1beta1.ExternalPeeringSpecRoutePolicy{
+					LocalPreference: 200,
+				}
+			}),
+			objects: baseObjs,
+			err:     false,
+		},
 		{
 			name:    "kube nil still validates required fields only",
 			peering: extPeeringGen("ext-peer-12"),
@@ -0,0 +1,132 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	"context"
+	"maps"
+	"net/netip"
+	"sort"
+
+	"github.com/pkg/errors"
+	"go.githedgehog.com/fabric/api/meta"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	"go.githedgehog.com/fabric/pkg/util/iputil"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const MaxIPv6NamespaceSubnets = 10
+
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// IPv6NamespaceSpec defines the desired state of IPv6Namespace
+type IPv6NamespaceSpec struct {
+	//+kubebuilder:validation:MinItems=1
+	//+kubebuilder:validation:MaxItems=10
+	// Subnets is the list of IPv6 prefixes to allocate VPC IPv6 subnets from, couldn't overlap between each other
+	Subnets []string `json:"subnets,omitempty"`
+}
+
+// IPv6NamespaceStatus defines the observed state of IPv6Namespace
+type IPv6NamespaceStatus struct{}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=hedgehog;fabric,shortName=ip6ns
+// +kubebuilder:printcolumn:name="Subnets",type=string,JSONPath=`.spec.subnets`,priority=0
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,priority=0
+// IPv6Namespace represents a namespace for VPC IPv6 subnets allocation. All VPC IPv6 subnets within a single
+// IPv6Namespace are non-overlapping. Users can create multiple IPv6Namespaces to allocate same VPC IPv6 subnets.
+type IPv6Namespace struct {
+	kmetav1.TypeMeta   `json:",inline"`
+	kmetav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the IPv6Namespace
+	Spec IPv6NamespaceSpec `json:"spec,omitempty"`
+	// Status is the observed state of the IPv6Namespace
+	Status IPv6NamespaceStatus `json:"status,omitempty"`
+}
+
+const KindIPv6Namespace = "IPv6Namespace"
+
+//+kubebuilder:object:root=true
+
+// IPv6NamespaceList contains a list of IPv6Namespace
+type IPv6NamespaceList struct {
+	kmetav1.TypeMeta `json:",inline"`
+	kmetav1.ListMeta `json:"metadata,omitempty"`
+	Items            []IPv6Namespace `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(func(s *runtime.Scheme) error {
+		s.AddKnownTypes(GroupVersion, &IPv6Namespace{}, &IPv6NamespaceList{})
+
+		return nil
+	})
+}
+
+var (
+	_ meta.Object     = (*IPv6Namespace)(nil)
+	_ meta.ObjectList = (*IPv6NamespaceList)(nil)
+)
+
+func (ipNsList *IPv6NamespaceList) GetItems() []meta.Object {
+	items := make([]meta.Object, len(ipNsList.Items))
+	for i := range ipNsList.Items {
+		items[i] = &ipNsList.Items[i]
+	}
+
+	return items
+}
+
+func (ns *IPv6NamespaceSpec) Labels() map[string]string {
+	return map[string]string{}
+}
+
+func (ns *IPv6Namespace) Default() {
+	meta.DefaultObjectMetadata(ns)
+
+	if ns.Labels == nil {
+		ns.Labels = map[string]string{}
+	}
+
+	wiringapi.CleanupFabricLabels(ns.Labels)
+
+	maps.Copy(ns.Labels, ns.Spec.Labels())
+
+	sort.Strings(ns.Spec.Subnets)
+}
+
+func (ns *IPv6Namespace) Validate(_ context.Context, _ kclient.Reader, _ *meta.FabricConfig) (admission.Warnings, error) {
+	if err := meta.ValidateObjectMetadata(ns); err != nil {
+		return nil, errors.Wrapf(err, "failed to validate metadata")
+	}
+
+	if len(ns.Name) > 11 {
+		return nil, errors.Errorf("name %s is too long, must be <= 11 characters", ns.Name)
+	}
+
+	subnets := []netip.Prefix{}
+	for _, subnet := range ns.Spec.Subnets {
+		prefix, err := netip.ParsePrefix(subnet)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse cidr %s", subnet)
+		}
+		if !prefix.Addr().Is6() || prefix.Addr().Is4In6() {
+			return nil, errors.Errorf("subnet %s is not an IPv6 prefix", subnet)
+		}
+
+		subnets = append(subnets, prefix.Masked())
+	}
+
+	if len(subnets) > MaxIPv6NamespaceSubnets {
+		return nil, errors.Errorf("too many subnets defined (%d), maximum is %d", len(subnets), MaxIPv6NamespaceSubnets)
+	}
+
+	return nil, errors.Wrapf(iputil.VerifyNoOverlapNetip(subnets), "subnets overlap")
+}
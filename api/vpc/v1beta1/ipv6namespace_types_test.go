@@ -0,0 +1,71 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.githedgehog.com/fabric/api/meta"
+	"go.githedgehog.com/fabric/api/vpc/v1beta1"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIPv6NamespaceValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		subnets []string
+		err     bool
+	}{
+		{
+			name:    "valid",
+			subnets: []string{"fd00::/48", "fd01::/48"},
+		},
+		{
+			name:    "invalid prefix",
+			subnets: []string{"fd00::/129"},
+			err:     true,
+		},
+		{
+			name:    "ipv4 prefix",
+			subnets: []string{"10.0.0.0/8"},
+			err:     true,
+		},
+		{
+			name:    "overlapping prefixes",
+			subnets: []string{"fd00::/48", "fd00:0:0:1::/64"},
+			err:     true,
+		},
+		{
+			name: "too many prefixes",
+			subnets: []string{
+				"fd00::/48", "fd01::/48", "fd02::/48", "fd03::/48", "fd04::/48", "fd05::/48",
+				"fd06::/48", "fd07::/48", "fd08::/48", "fd09::/48", "fd0a::/48",
+			},
+			err: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ns := &v1beta1.IPv6Namespace{
+				ObjectMeta: kmetav1.ObjectMeta{
+					Name:      "default",
+					Namespace: kmetav1.NamespaceDefault,
+				},
+				Spec: v1beta1.IPv6NamespaceSpec{
+					Subnets: test.subnets,
+				},
+			}
+			ns.Default()
+
+			_, err := ns.Validate(t.Context(), nil, &meta.FabricConfig{})
+			if test.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
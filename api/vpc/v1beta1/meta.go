@@ -14,7 +14,21 @@
 
 package v1beta1
 
-const DefaultIPv4Namespace = "default"
+const (
+	DefaultIPv4Namespace = "default"
+	DefaultIPv6Namespace = "default"
+)
+
+// ConditionApplied is true when all switches the object is configured on have applied its latest generation
+const ConditionApplied = "Applied"
+
+// SwitchApplyStatus is the generation of the object applied by a specific switch
+type SwitchApplyStatus struct {
+	// Switch is the name of the switch the object is configured on
+	Switch string `json:"switch"`
+	// Generation is the generation of the object last applied by the switch, 0 if not applied yet
+	Generation int64 `json:"generation,omitempty"`
+}
 
 var (
 	LabelPrefix          = "fabric.githedgehog.com/"
@@ -23,6 +37,7 @@ var (
 	LabelVPC2            = LabelName("vpc2")
 	LabelSubnet          = LabelName("subnet")
 	LabelIPv4NS          = LabelName("ipv4ns")
+	LabelIPv6NS          = LabelName("ipv6ns")
 	LabelVLANNS          = LabelName("vlanns")
 	LabelExternal        = LabelName("external")
 	LabelNativeVLAN      = LabelName("nativevlan")
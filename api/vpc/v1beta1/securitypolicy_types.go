@@ -0,0 +1,354 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"net/netip"
+	"slices"
+
+	"github.com/pkg/errors"
+	"go.githedgehog.com/fabric/api/meta"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SecurityPolicyMaxSeq is the maximum sequence number allowed for security policy statements, as they are placed
+// into the VPC filtering ACLs before the subnet isolation and VPC peering rules
+const SecurityPolicyMaxSeq = 99
+
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// SecurityPolicySpec defines the desired state of SecurityPolicy
+type SecurityPolicySpec struct {
+	// VPC is the name of the VPC the policy is applied to
+	VPC string `json:"vpc,omitempty"`
+	// Subnets is the list of VPC subnets the policy is applied to for the traffic originating from them, all VPC
+	// subnets are used if empty
+	Subnets []string `json:"subnets,omitempty"`
+	// Peering is the name of the VPCPeering of the VPC to scope the policy to, if set the statements are only applied
+	// while the peering exists and their destination prefixes must be within the peer VPC subnets, policies for the
+	// traffic in the opposite direction should be created for the peer VPC
+	Peering string `json:"peering,omitempty"`
+	// Statements is the list of ACL statements to permit or deny traffic to other subnets of the same VPC, peered VPCs
+	// or specific prefixes, sequence numbers should be in [10, 99] range and unique across all policies of the VPC
+	Statements []ACLStatement `json:"statements,omitempty"`
+}
+
+// SecurityPolicyStatus defines the observed state of SecurityPolicy
+type SecurityPolicyStatus struct{}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=hedgehog;fabric,shortName=secpol
+// +kubebuilder:printcolumn:name="VPC",type=string,JSONPath=`.spec.vpc`,priority=0
+// +kubebuilder:printcolumn:name="Subnets",type=string,JSONPath=`.spec.subnets`,priority=0
+// +kubebuilder:printcolumn:name="Peering",type=string,JSONPath=`.spec.peering`,priority=0
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,priority=0
+// SecurityPolicy is a set of L3/L4 filtering rules applied to the traffic originating from the VPC subnets. It allows
+// to permit or deny traffic between subnets of the same VPC, to the peered VPCs or to specific prefixes on top of the
+// coarse isolated/restricted subnet flags and VPC permit lists.
+type SecurityPolicy struct {
+	kmetav1.TypeMeta   `json:",inline"`
+	kmetav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the SecurityPolicy
+	Spec SecurityPolicySpec `json:"spec,omitempty"`
+	// Status is the observed state of the SecurityPolicy
+	Status SecurityPolicyStatus `json:"status,omitempty"`
+}
+
+const KindSecurityPolicy = "SecurityPolicy"
+
+//+kubebuilder:object:root=true
+
+// SecurityPolicyList contains a list of SecurityPolicy
+type SecurityPolicyList struct {
+	kmetav1.TypeMeta `json:",inline"`
+	kmetav1.ListMeta `json:"metadata,omitempty"`
+	Items            []SecurityPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(func(s *runtime.Scheme) error {
+		s.AddKnownTypes(GroupVersion, &SecurityPolicy{}, &SecurityPolicyList{})
+
+		return nil
+	})
+}
+
+var (
+	_ meta.Object     = (*SecurityPolicy)(nil)
+	_ meta.ObjectList = (*SecurityPolicyList)(nil)
+)
+
+func (policyList *SecurityPolicyList) GetItems() []meta.Object {
+	items := make([]meta.Object, len(policyList.Items))
+	for i := range policyList.Items {
+		items[i] = &policyList.Items[i]
+	}
+
+	return items
+}
+
+func (s *SecurityPolicySpec) Labels() map[string]string {
+	return map[string]string{
+		LabelVPC: s.VPC,
+	}
+}
+
+// AppliesTo returns true if the policy is applied to the specified subnet of its VPC
+func (s *SecurityPolicySpec) AppliesTo(subnet string) bool {
+	return len(s.Subnets) == 0 || slices.Contains(s.Subnets, subnet)
+}
+
+func (policy *SecurityPolicy) Default() {
+	meta.DefaultObjectMetadata(policy)
+
+	if policy.Labels == nil {
+		policy.Labels = map[string]string{}
+	}
+
+	wiringapi.CleanupFabricLabels(policy.Labels)
+
+	maps.Copy(policy.Labels, policy.Spec.Labels())
+
+	slices.Sort(policy.Spec.Subnets)
+	slices.SortFunc(policy.Spec.Statements, func(a, b ACLStatement) int {
+		return cmp.Compare(a.Seq, b.Seq)
+	})
+}
+
+func (policy *SecurityPolicy) Validate(ctx context.Context, kube kclient.Reader, _ *meta.FabricConfig) (admission.Warnings, error) {
+	if err := meta.ValidateObjectMetadata(policy); err != nil {
+		return nil, errors.Wrapf(err, "failed to validate metadata")
+	}
+
+	if policy.Spec.VPC == "" {
+		return nil, errors.Errorf("vpc is required")
+	}
+
+	for idx, subnet := range policy.Spec.Subnets {
+		if subnet == "" {
+			return nil, errors.Errorf("subnet name is required (idx %d)", idx)
+		}
+		if slices.Contains(policy.Spec.Subnets[idx+1:], subnet) {
+			return nil, errors.Errorf("duplicate subnet %s", subnet)
+		}
+	}
+
+	if len(policy.Spec.Statements) == 0 {
+		return nil, errors.Errorf("at least one statement is required")
+	}
+
+	acl := &ACLSpec{Statements: policy.Spec.Statements}
+	if _, err := acl.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid statements")
+	}
+
+	for _, stmt := range policy.Spec.Statements {
+		if stmt.Seq > SecurityPolicyMaxSeq {
+			return nil, errors.Errorf("sequence number %d is too large (security policy rules must use seq <= %d)", stmt.Seq, SecurityPolicyMaxSeq)
+		}
+		if !stmt.IsIPv4() {
+			return nil, errors.Errorf("IPv6 statements aren't supported in security policies yet (statement %d)", stmt.Seq)
+		}
+		if policy.Spec.Peering != "" && stmt.DstPrefix == ACLAny {
+			return nil, errors.Errorf("dstPrefix must be within the peer VPC subnets for peering scoped policy (statement %d)", stmt.Seq)
+		}
+	}
+
+	if kube != nil {
+		vpc := &VPC{}
+		err := kube.Get(ctx, ktypes.NamespacedName{Name: policy.Spec.VPC, Namespace: policy.Namespace}, vpc)
+		if kapierrors.IsNotFound(err) {
+			return nil, errors.Errorf("vpc %s not found", policy.Spec.VPC)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get vpc %s", policy.Spec.VPC) // TODO replace with some internal error to not expose to the user
+		}
+
+		if vpc.Spec.Mode == VPCModeL3Flat {
+			return nil, errors.Errorf("security policies are not supported for vpc mode %s", vpc.Spec.Mode)
+		}
+
+		for _, subnet := range policy.Spec.Subnets {
+			if _, exists := vpc.Spec.Subnets[subnet]; !exists {
+				return nil, errors.Errorf("subnet %s not found in vpc %s", subnet, policy.Spec.VPC)
+			}
+		}
+
+		if policy.Spec.Peering != "" {
+			if err := policy.validatePeering(ctx, kube); err != nil {
+				return nil, err
+			}
+		}
+
+		others := &SecurityPolicyList{}
+		if err := kube.List(ctx, others, kclient.InNamespace(policy.Namespace), kclient.MatchingLabels{
+			LabelVPC: policy.Spec.VPC,
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to list security policies") // TODO replace with some internal error to not expose to the user
+		}
+
+		for _, other := range others.Items {
+			if other.Name == policy.Name {
+				continue
+			}
+
+			overlap := false
+			for subnet := range vpc.Spec.Subnets {
+				if policy.Spec.AppliesTo(subnet) && other.Spec.AppliesTo(subnet) {
+					overlap = true
+
+					break
+				}
+			}
+			if !overlap {
+				continue
+			}
+
+			for _, stmt := range policy.Spec.Statements {
+				if slices.ContainsFunc(other.Spec.Statements, func(otherStmt ACLStatement) bool { return otherStmt.Seq == stmt.Seq }) {
+					return nil, errors.Errorf("sequence number %d is already used by security policy %s for the same subnets", stmt.Seq, other.Name)
+				}
+			}
+		}
+
+		if err := validateVPCSwitchesSupportACLs(ctx, kube, policy.Namespace, policy.Spec.VPC); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// validatePeering checks that the peering exists, includes the policy VPC and that all statements are only matching
+// the traffic towards the peer VPC subnets
+func (policy *SecurityPolicy) validatePeering(ctx context.Context, kube kclient.Reader) error {
+	peering := &VPCPeering{}
+	err := kube.Get(ctx, ktypes.NamespacedName{Name: policy.Spec.Peering, Namespace: policy.Namespace}, peering)
+	if kapierrors.IsNotFound(err) {
+		return errors.Errorf("vpc peering %s not found", policy.Spec.Peering)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get vpc peering %s", policy.Spec.Peering) // TODO replace with some internal error to not expose to the user
+	}
+
+	vpc1, vpc2, err := peering.Spec.VPCs()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get vpcs for vpc peering %s", policy.Spec.Peering)
+	}
+
+	peerName := ""
+	switch policy.Spec.VPC {
+	case vpc1:
+		peerName = vpc2
+	case vpc2:
+		peerName = vpc1
+	default:
+		return errors.Errorf("vpc %s is not part of vpc peering %s", policy.Spec.VPC, policy.Spec.Peering)
+	}
+
+	peer := &VPC{}
+	err = kube.Get(ctx, ktypes.NamespacedName{Name: peerName, Namespace: policy.Namespace}, peer)
+	if kapierrors.IsNotFound(err) {
+		return errors.Errorf("peer vpc %s not found", peerName)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get peer vpc %s", peerName) // TODO replace with some internal error to not expose to the user
+	}
+
+	for _, stmt := range policy.Spec.Statements {
+		dst, err := netip.ParsePrefix(stmt.DstPrefix)
+		if err != nil {
+			return errors.Errorf("invalid dstPrefix %q in statement %d", stmt.DstPrefix, stmt.Seq)
+		}
+
+		within := false
+		for _, subnet := range peer.Spec.Subnets {
+			peerPrefix, err := netip.ParsePrefix(subnet.Subnet)
+			if err != nil {
+				continue
+			}
+			if peerPrefix.Bits() <= dst.Bits() && peerPrefix.Contains(dst.Addr()) {
+				within = true
+
+				break
+			}
+		}
+		if !within {
+			return errors.Errorf("dstPrefix %s of statement %d is not within peer vpc %s subnets", stmt.DstPrefix, stmt.Seq, peerName)
+		}
+	}
+
+	return nil
+}
+
+// validateVPCSwitchesSupportACLs checks that all switches the VPC is attached to support ACLs
+func validateVPCSwitchesSupportACLs(ctx context.Context, kube kclient.Reader, ns, vpcName string) error {
+	attaches := &VPCAttachmentList{}
+	if err := kube.List(ctx, attaches, kclient.InNamespace(ns), kclient.MatchingLabels{
+		LabelVPC: vpcName,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to list vpc attachments for vpc %s", vpcName) // TODO replace with some internal error to not expose to the user
+	}
+
+	checked := map[string]bool{}
+	for _, attach := range attaches.Items {
+		conn := &wiringapi.Connection{}
+		if err := kube.Get(ctx, ktypes.NamespacedName{Name: attach.Spec.Connection, Namespace: ns}, conn); err != nil {
+			if kapierrors.IsNotFound(err) {
+				continue
+			}
+
+			return errors.Wrapf(err, "failed to get connection %s", attach.Spec.Connection) // TODO replace with some internal error to not expose to the user
+		}
+
+		switchNames, _, _, _, err := conn.Spec.Endpoints()
+		if err != nil {
+			return errors.Wrapf(err, "failed to get endpoints for connection %s", attach.Spec.Connection) // TODO replace with some internal error to not expose to the user
+		}
+
+		for _, switchName := range switchNames {
+			if checked[switchName] {
+				continue
+			}
+			checked[switchName] = true
+
+			sp, err := getSwitchProfile(ctx, kube, ns, switchName)
+			if err != nil {
+				return err
+			}
+
+			if !sp.Spec.Features.ACLs {
+				return errors.Errorf("switch %s with profile %s doesn't support ACLs required by security policies of vpc %s", switchName, sp.Name, vpcName)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getSwitchProfile returns the switch profile used by the specified switch
+func getSwitchProfile(ctx context.Context, kube kclient.Reader, ns, switchName string) (*wiringapi.SwitchProfile, error) {
+	sw := &wiringapi.Switch{}
+	if err := kube.Get(ctx, ktypes.NamespacedName{Name: switchName, Namespace: ns}, sw); err != nil {
+		return nil, errors.Wrapf(err, "failed to get switch %s", switchName) // TODO replace with some internal error to not expose to the user
+	}
+
+	sp := &wiringapi.SwitchProfile{}
+	if err := kube.Get(ctx, ktypes.NamespacedName{Name: sw.Spec.Profile, Namespace: ns}, sp); err != nil {
+		return nil, errors.Wrapf(err, "failed to get switch profile %s used in switch %s", sw.Spec.Profile, switchName) // TODO replace with some internal error to not expose to the user
+	}
+
+	return sp, nil
+}
@@ -0,0 +1,348 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.githedgehog.com/fabric/api/meta"
+	"go.githedgehog.com/fabric/api/vpc/v1beta1"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func secPolicyGen(name string, f ...func(policy *v1beta1.SecurityPolicy)) *v1beta1.SecurityPolicy {
+	base := &v1beta1.SecurityPolicy{
+		ObjectMeta: kmetav1.ObjectMeta{
+			Name:      name,
+			Namespace: kmetav1.NamespaceDefault,
+		},
+		Spec: v1beta1.SecurityPolicySpec{
+			VPC:     "vpc-01",
+			Subnets: []string{"subnet-a"},
+			Statements: []v1beta1.ACLStatement{
+				{
+					Seq:            10,
+					Action:         v1beta1.ACLActionPermit,
+					Protocol:       v1beta1.ACLProtocolTCP,
+					SrcPrefix:      v1beta1.ACLAny,
+					DstPrefix:      "10.0.2.0/24",
+					PortRangeBegin: 443,
+					PortRangeEnd:   443,
+				},
+				{
+					Seq:       20,
+					Action:    v1beta1.ACLActionDeny,
+					Protocol:  v1beta1.ACLProtocolIP,
+					SrcPrefix: v1beta1.ACLAny,
+					DstPrefix: "10.0.2.0/24",
+				},
+			},
+		},
+	}
+
+	for _, fn := range f {
+		fn(base)
+	}
+	base.Default()
+
+	return base
+}
+
+func secPolicySwitchObjs(acls bool) []kclient.Object {
+	attach := &v1beta1.VPCAttachment{
+		ObjectMeta: kmetav1.ObjectMeta{
+			Name:      "vpc-01--server-01",
+			Namespace: kmetav1.NamespaceDefault,
+		},
+		Spec: v1beta1.VPCAttachmentSpec{
+			Subnet:     "vpc-01/subnet-a",
+			Connection: "server-01--unbundled--leaf-01",
+		},
+	}
+	attach.Default()
+
+	return []kclient.Object{
+		attach,
+		&wiringapi.Connection{
+			ObjectMeta: kmetav1.ObjectMeta{
+				Name:      "server-01--unbundled--leaf-01",
+				Namespace: kmetav1.NamespaceDefault,
+			},
+			Spec: wiringapi.ConnectionSpec{
+				Unbundled: &wiringapi.ConnUnbundled{
+					Link: wiringapi.ServerToSwitchLink{
+						Server: wiringapi.BasePortName{Port: "server-01/enp2s1"},
+						Switch: wiringapi.BasePortName{Port: "leaf-01/E1/1"},
+					},
+				},
+			},
+		},
+		&wiringapi.Switch{
+			ObjectMeta: kmetav1.ObjectMeta{
+				Name:      "leaf-01",
+				Namespace: kmetav1.NamespaceDefault,
+			},
+			Spec: wiringapi.SwitchSpec{
+				Profile: "test-profile",
+			},
+		},
+		&wiringapi.SwitchProfile{
+			ObjectMeta: kmetav1.ObjectMeta{
+				Name:      "test-profile",
+				Namespace: kmetav1.NamespaceDefault,
+			},
+			Spec: wiringapi.SwitchProfileSpec{
+				Features: wiringapi.SwitchProfileFeatures{
+					ACLs: acls,
+				},
+			},
+		},
+	}
+}
+
+func TestSecurityPolicyValidation(t *testing.T) {
+	vpc := &v1beta1.VPC{
+		ObjectMeta: kmetav1.ObjectMeta{
+			Name:      "vpc-01",
+			Namespace: kmetav1.NamespaceDefault,
+		},
+		Spec: v1beta1.VPCSpec{
+			IPv4Namespace: "default",
+			Subnets: map[string]*v1beta1.VPCSubnet{
+				"subnet-a": {Subnet: "10.0.1.0/24", VLAN: 101},
+				"subnet-b": {Subnet: "10.0.2.0/24", VLAN: 102},
+			},
+		},
+	}
+	l3FlatVPC := vpc.DeepCopy()
+	l3FlatVPC.Name = "vpc-flat"
+	l3FlatVPC.Spec.Mode = v1beta1.VPCModeL3Flat
+	peerVPC := vpc.DeepCopy()
+	peerVPC.Name = "vpc-02"
+	peerVPC.Spec.Subnets = map[string]*v1beta1.VPCSubnet{
+		"subnet-a": {Subnet: "10.0.10.0/24", VLAN: 110},
+	}
+	peering := &v1beta1.VPCPeering{
+		ObjectMeta: kmetav1.ObjectMeta{
+			Name:      "vpc-01--vpc-02",
+			Namespace: kmetav1.NamespaceDefault,
+		},
+		Spec: v1beta1.VPCPeeringSpec{
+			Permit: []map[string]v1beta1.VPCPeer{
+				{"vpc-01": {}, "vpc-02": {}},
+			},
+		},
+	}
+	otherPeering := peering.DeepCopy()
+	otherPeering.Name = "vpc-02--vpc-flat"
+	otherPeering.Spec.Permit = []map[string]v1beta1.VPCPeer{
+		{"vpc-02": {}, "vpc-flat": {}},
+	}
+
+	baseObjs := []kclient.Object{vpc, l3FlatVPC, peerVPC, peering, otherPeering}
+
+	peeringPolicy := func(policy *v1beta1.SecurityPolicy) {
+		policy.Spec.Peering = "vpc-01--vpc-02"
+		for idx := range policy.Spec.Statements {
+			policy.Spec.Statements[idx].DstPrefix = "10.0.10.0/25"
+		}
+	}
+
+	tests := []struct {
+		name    string
+		policy  *v1beta1.SecurityPolicy
+		objects []kclient.Object
+		err     bool
+	}{
+		{
+			name:    "valid security policy",
+			policy:  secPolicyGen("secpol-01"),
+			objects: baseObjs,
+		},
+		{
+			name: "valid security policy for all subnets",
+			policy: secPolicyGen("secpol-01", func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.Subnets = nil
+			}),
+			objects: baseObjs,
+		},
+		{
+			name:    "kube nil still validates required fields only",
+			policy:  secPolicyGen("secpol-01"),
+			objects: nil,
+		},
+		{
+			name: "vpc is required",
+			policy: secPolicyGen("secpol-01", func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.VPC = ""
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name: "statements are required",
+			policy: secPolicyGen("secpol-01", func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.Statements = nil
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name: "duplicate subnets",
+			policy: secPolicyGen("secpol-01", func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.Subnets = []string{"subnet-a", "subnet-a"}
+			}),
+			objects: nil,
+			err:     true,
+		},
+		{
+			name: "seq is reserved",
+			policy: secPolicyGen("secpol-01", func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.Statements[0].Seq = 5
+			}),
+			objects: nil,
+			err:     true,
+		},
+		{
+			name: "seq is too large",
+			policy: secPolicyGen("secpol-01", func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.Statements[1].Seq = 100
+			}),
+			objects: nil,
+			err:     true,
+		},
+		{
+			name: "IPv6 statements are not supported",
+			policy: secPolicyGen("secpol-01", func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.Statements[1].DstPrefix = "2001:db8::/64"
+			}),
+			objects: nil,
+			err:     true,
+		},
+		{
+			name: "vpc does not exist",
+			policy: secPolicyGen("secpol-01", func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.VPC = "vpc-missing"
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name: "subnet does not exist",
+			policy: secPolicyGen("secpol-01", func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.Subnets = []string{"subnet-missing"}
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name: "l3flat vpc is not supported",
+			policy: secPolicyGen("secpol-01", func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.VPC = "vpc-flat"
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name:   "seq conflicts with other policy on the same subnet",
+			policy: secPolicyGen("secpol-01"),
+			objects: withObjs(baseObjs, secPolicyGen("secpol-02", func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.Subnets = nil
+				policy.Spec.Statements = policy.Spec.Statements[1:]
+			})),
+			err: true,
+		},
+		{
+			name:   "same seq in other policy on different subnets",
+			policy: secPolicyGen("secpol-01"),
+			objects: withObjs(baseObjs, secPolicyGen("secpol-02", func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.Subnets = []string{"subnet-b"}
+			})),
+		},
+		{
+			name:    "valid peering scoped policy",
+			policy:  secPolicyGen("secpol-01", peeringPolicy),
+			objects: baseObjs,
+		},
+		{
+			name: "peering does not exist",
+			policy: secPolicyGen("secpol-01", peeringPolicy, func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.Peering = "vpc-01--vpc-missing"
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name: "peering doesn't include the vpc",
+			policy: secPolicyGen("secpol-01", peeringPolicy, func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.Peering = "vpc-02--vpc-flat"
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name: "peering scoped policy with any destination",
+			policy: secPolicyGen("secpol-01", peeringPolicy, func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.Statements[0].DstPrefix = v1beta1.ACLAny
+			}),
+			objects: nil,
+			err:     true,
+		},
+		{
+			name: "peering scoped policy with destination outside of the peer vpc",
+			policy: secPolicyGen("secpol-01", peeringPolicy, func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.Statements[1].DstPrefix = "10.0.2.0/24"
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name: "peering scoped policy with destination larger than the peer subnet",
+			policy: secPolicyGen("secpol-01", peeringPolicy, func(policy *v1beta1.SecurityPolicy) {
+				policy.Spec.Statements[1].DstPrefix = "10.0.0.0/16"
+			}),
+			objects: baseObjs,
+			err:     true,
+		},
+		{
+			name:    "attached switch supports acls",
+			policy:  secPolicyGen("secpol-01"),
+			objects: withObjs(baseObjs, secPolicySwitchObjs(true)...),
+		},
+		{
+			name:    "attached switch doesn't support acls",
+			policy:  secPolicyGen("secpol-01"),
+			objects: withObjs(baseObjs, secPolicySwitchObjs(false)...),
+			err:     true,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta1.AddToScheme(scheme))
+	require.NoError(t, wiringapi.AddToScheme(scheme))
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var kube kclient.Reader
+			if test.objects != nil {
+				kube = fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(test.objects...).
+					Build()
+			}
+
+			_, err := test.policy.Validate(t.Context(), kube, &meta.FabricConfig{})
+			if test.err {
+				require.Error(t, err, "expected error but got none")
+			} else {
+				require.NoError(t, err, "unexpected error during validation")
+			}
+		})
+	}
+}
@@ -47,6 +47,8 @@ type VPCSpec struct {
 	Subnets map[string]*VPCSubnet `json:"subnets,omitempty"`
 	// IPv4Namespace is the name of the IPv4Namespace this VPC belongs to (if not specified, "default" is used)
 	IPv4Namespace string `json:"ipv4Namespace,omitempty"`
+	// IPv6Namespace is the name of the IPv6Namespace this VPC belongs to (if not specified and any of the subnets has IPv6 configured, "default" is used)
+	IPv6Namespace string `json:"ipv6Namespace,omitempty"`
 	// VLANNamespace is the name of the VLANNamespace this VPC belongs to (if not specified, "default" is used)
 	VLANNamespace string `json:"vlanNamespace,omitempty"`
 	// DefaultIsolated sets default behavior for isolated mode for the subnets (disabled by default)
@@ -85,6 +87,10 @@ type VPCSubnet struct {
 	Subnet string `json:"subnet,omitempty"`
 	// Gateway (optional) for the subnet, if not specified, the first IP (e.g. 10.0.0.1) in the subnet is used as the gateway
 	Gateway string `json:"gateway,omitempty"`
+	// SubnetIPv6 (optional) is the IPv6 subnet CIDR block, such as "fd00:1::/64", makes the subnet dual-stack, should belong to the IPv6Namespace and be unique within the namespace
+	SubnetIPv6 string `json:"subnetIPv6,omitempty"`
+	// GatewayIPv6 (optional) for the IPv6 subnet, if not specified, the first IP (e.g. fd00:1::1) in the IPv6 subnet is used as the gateway
+	GatewayIPv6 string `json:"gatewayIPv6,omitempty"`
 	// DHCP is the on-demand DHCP configuration for the subnet
 	DHCP VPCDHCP `json:"dhcp,omitempty"`
 	// VLAN is the VLAN ID for the subnet, should belong to the VLANNamespace and be unique within the namespace
@@ -185,8 +191,34 @@ type VPCStaticRoute struct {
 	NextHops []string `json:"nextHops,omitempty"`
 }
 
+const (
+	// VPCConditionReady is true when all switches the VPC is configured on have applied the latest config
+	VPCConditionReady = "Ready"
+	// VPCConditionDegraded is true when only some of the switches the VPC is configured on have applied the latest config
+	VPCConditionDegraded = "Degraded"
+)
+
 // VPCStatus defines the observed state of VPC
-type VPCStatus struct{}
+type VPCStatus struct {
+	// VNI is the VPC VNI allocated for the VPC
+	VNI uint32 `json:"vni,omitempty"`
+	// SubnetVNIs stores subnet name -> VNI allocated for the subnet
+	SubnetVNIs map[string]uint32 `json:"subnetVNIs,omitempty"`
+	// Switches is the list of switches the VPC is configured on
+	Switches []string `json:"switches,omitempty"`
+	// Subnets stores subnet name -> observed state of the subnet
+	Subnets map[string]VPCSubnetStatus `json:"subnets,omitempty"`
+	// Conditions of the VPC, Ready and Degraded are based on the config applied by the involved switches
+	Conditions []kmetav1.Condition `json:"conditions,omitempty"`
+}
+
+// VPCSubnetStatus defines the observed state of a VPC subnet
+type VPCSubnetStatus struct {
+	// DHCPAllocated is the number of IPs currently leased by the DHCP server in the subnet
+	DHCPAllocated uint32 `json:"dhcpAllocated,omitempty"`
+	// DHCPTotal is the number of IPs available in the DHCP range of the subnet
+	DHCPTotal uint32 `json:"dhcpTotal,omitempty"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
@@ -195,6 +227,7 @@ type VPCStatus struct{}
 // +kubebuilder:printcolumn:name="VLANNS",type=string,JSONPath=`.spec.vlanNamespace`,priority=0
 // +kubebuilder:printcolumn:name="Subnets",type=string,JSONPath=`.spec.subnets`,priority=1
 // +kubebuilder:printcolumn:name="VNI",type=string,JSONPath=`.status.vni`,priority=1
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,priority=0
 // VPC is Virtual Private Cloud, similar to the public cloud VPC it provides an isolated private network for the
 // resources with support for multiple subnets each with user-provided VLANs and on-demand DHCP.
@@ -257,12 +290,26 @@ func (vpc *VPCSpec) IsSubnetRestricted(subnetName string) bool {
 	return vpc.DefaultRestricted
 }
 
+// HasIPv6 returns true if any of the VPC subnets has IPv6 configured
+func (vpc *VPCSpec) HasIPv6() bool {
+	for _, subnet := range vpc.Subnets {
+		if subnet != nil && subnet.SubnetIPv6 != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (vpc *VPC) Default() {
 	meta.DefaultObjectMetadata(vpc)
 
 	if vpc.Spec.IPv4Namespace == "" {
 		vpc.Spec.IPv4Namespace = DefaultIPv4Namespace
 	}
+	if vpc.Spec.IPv6Namespace == "" && vpc.Spec.HasIPv6() {
+		vpc.Spec.IPv6Namespace = DefaultIPv6Namespace
+	}
 	if vpc.Spec.VLANNamespace == "" {
 		vpc.Spec.VLANNamespace = wiringapi.DefaultVLANNamespace
 	}
@@ -275,8 +322,17 @@ func (vpc *VPC) Default() {
 
 	vpc.Labels[LabelIPv4NS] = vpc.Spec.IPv4Namespace
 	vpc.Labels[LabelVLANNS] = vpc.Spec.VLANNamespace
+	if vpc.Spec.IPv6Namespace != "" {
+		vpc.Labels[LabelIPv6NS] = vpc.Spec.IPv6Namespace
+	}
 
 	for _, subnet := range vpc.Spec.Subnets {
+		if subnet.SubnetIPv6 != "" && subnet.GatewayIPv6 == "" && !subnet.HostBGP {
+			if cidr, err := iputil.ParseCIDR(subnet.SubnetIPv6); err == nil {
+				subnet.GatewayIPv6 = cidr.Gateway.String()
+			}
+		}
+
 		cidr, err := iputil.ParseCIDR(subnet.Subnet)
 		if err != nil {
 			continue
@@ -392,6 +448,7 @@ func (vpc *VPC) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *me
 	}
 
 	subnets := []netip.Prefix{}
+	subnetsIPv6 := []netip.Prefix{}
 	vlans := map[uint16]bool{}
 	hostBGPSubnets := 0
 	for subnetName, subnetCfg := range vpc.Spec.Subnets {
@@ -443,6 +500,17 @@ func (vpc *VPC) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *me
 
 		subnets = append(subnets, ipNet)
 
+		if subnetCfg.SubnetIPv6 != "" {
+			ipv6Net, err := validateSubnetIPv6(subnetName, subnetCfg)
+			if err != nil {
+				return nil, err
+			}
+
+			subnetsIPv6 = append(subnetsIPv6, ipv6Net)
+		} else if subnetCfg.GatewayIPv6 != "" {
+			return nil, errors.Errorf("subnet %s: IPv6 gateway is set but IPv6 subnet is not", subnetName)
+		}
+
 		if subnetCfg.DHCP.Relay != "" && subnetCfg.DHCP.Enable {
 			return nil, errors.Errorf("subnet %s: dhcp relay and dhcp server cannot be enabled at the same time", subnetName)
 		}
@@ -631,6 +699,14 @@ func (vpc *VPC) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *me
 		return nil, errors.Wrapf(err, "failed to verify no overlap subnets")
 	}
 
+	if err := iputil.VerifyNoOverlapNetip(subnetsIPv6); err != nil {
+		return nil, errors.Wrapf(err, "failed to verify no overlap IPv6 subnets")
+	}
+
+	if len(subnetsIPv6) > 0 && vpc.Spec.IPv6Namespace == "" {
+		return nil, errors.Errorf("ipv6Namespace is required if any of the subnets has IPv6 configured")
+	}
+
 	for permitIdx, permit := range vpc.Spec.Permit {
 		if len(permit) < 2 {
 			return nil, errors.Errorf("each permit policy must have at least 2 subnets in it")
@@ -692,6 +768,19 @@ func (vpc *VPC) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *me
 			return nil, errors.Wrapf(err, "failed to get VLANNamespace %s", vpc.Spec.VLANNamespace) // TODO replace with some internal error to not expose to the user
 		}
 
+		var ipv6Ns *IPv6Namespace
+		if len(subnetsIPv6) > 0 {
+			ipv6Ns = &IPv6Namespace{}
+			err = kube.Get(ctx, ktypes.NamespacedName{Name: vpc.Spec.IPv6Namespace, Namespace: vpc.Namespace}, ipv6Ns)
+			if err != nil {
+				if kapierrors.IsNotFound(err) {
+					return nil, errors.Errorf("IPv6Namespace %s not found", vpc.Spec.IPv6Namespace)
+				}
+
+				return nil, errors.Wrapf(err, "failed to get IPv6Namespace %s", vpc.Spec.IPv6Namespace) // TODO replace with some internal error to not expose to the user
+			}
+		}
+
 		for subnetName, subnetCfg := range vpc.Spec.Subnets {
 			vpcSubnet, err := netip.ParsePrefix(subnetCfg.Subnet)
 			if err != nil {
@@ -716,6 +805,31 @@ func (vpc *VPC) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *me
 				return nil, errors.Errorf("vpc subnet %s (%s) doesn't belong to the IPv4Namespace %s", subnetName, subnetCfg.Subnet, vpc.Spec.IPv4Namespace)
 			}
 
+			if subnetCfg.SubnetIPv6 != "" && ipv6Ns != nil {
+				vpcSubnetIPv6, err := netip.ParsePrefix(subnetCfg.SubnetIPv6)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to parse vpc IPv6 subnet %s", subnetCfg.SubnetIPv6)
+				}
+
+				ok := false
+				for _, ipNsSubnetCfg := range ipv6Ns.Spec.Subnets {
+					ipNsSubnet, err := netip.ParsePrefix(ipNsSubnetCfg)
+					if err != nil {
+						return nil, errors.Wrapf(err, "failed to parse IPv6Namespace %s subnet %s", vpc.Spec.IPv6Namespace, ipNsSubnetCfg)
+					}
+
+					if iputil.IsSubset(vpcSubnetIPv6, ipNsSubnet) {
+						ok = true
+
+						break
+					}
+				}
+
+				if !ok {
+					return nil, errors.Errorf("vpc subnet %s (%s) doesn't belong to the IPv6Namespace %s", subnetName, subnetCfg.SubnetIPv6, vpc.Spec.IPv6Namespace)
+				}
+			}
+
 			if !subnetCfg.HostBGP && !vlanNs.Spec.Contains(subnetCfg.VLAN) {
 				return nil, errors.Errorf("vpc subnet %s (%s) vlan %d doesn't belong to the VLANNamespace %s", subnetName, subnetCfg.Subnet, subnetCfg.VLAN, vpc.Spec.VLANNamespace)
 			}
@@ -763,6 +877,42 @@ func (vpc *VPC) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *me
 			}
 		}
 
+		if len(subnetsIPv6) > 0 {
+			vpcs = &VPCList{}
+			err = kube.List(ctx, vpcs, kclient.MatchingLabels{
+				LabelIPv6NS: vpc.Spec.IPv6Namespace,
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to list VPCs") // TODO replace with some internal error to not expose to the user
+			}
+
+			for _, other := range vpcs.Items {
+				if other.Name == vpc.Name {
+					continue
+				}
+				if other.Spec.IPv6Namespace != vpc.Spec.IPv6Namespace {
+					continue
+				}
+
+				for _, otherSubnet := range other.Spec.Subnets {
+					if otherSubnet.SubnetIPv6 == "" {
+						continue
+					}
+
+					otherNet, err := netip.ParsePrefix(otherSubnet.SubnetIPv6)
+					if err != nil {
+						return nil, errors.Wrapf(err, "failed to parse IPv6 subnet %s", otherSubnet.SubnetIPv6)
+					}
+
+					for _, subnet := range subnetsIPv6 {
+						if subnet.Overlaps(otherNet) {
+							return nil, errors.Errorf("IPv6 subnet %s overlaps with IPv6 subnet %s of VPC %s", subnet.String(), otherSubnet.SubnetIPv6, other.Name)
+						}
+					}
+				}
+			}
+		}
+
 		vpcs = &VPCList{}
 		err = kube.List(ctx, vpcs, kclient.MatchingLabels{
 			LabelVLANNS: vpc.Spec.VLANNamespace,
@@ -796,3 +946,40 @@ func (vpc *VPC) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *me
 
 	return nil, nil
 }
+
+func validateSubnetIPv6(subnetName string, subnetCfg *VPCSubnet) (netip.Prefix, error) {
+	ipNet, err := netip.ParsePrefix(subnetCfg.SubnetIPv6)
+	if err != nil {
+		return netip.Prefix{}, errors.Wrapf(err, "subnet %s: failed to parse IPv6 subnet %s", subnetName, subnetCfg.SubnetIPv6)
+	}
+	if !ipNet.Addr().Is6() || ipNet.Addr().Is4In6() {
+		return netip.Prefix{}, errors.Errorf("subnet %s: IPv6 subnet %s is not an IPv6 prefix", subnetName, subnetCfg.SubnetIPv6)
+	}
+	if ipNet.Addr() != ipNet.Masked().Addr() {
+		return netip.Prefix{}, errors.Errorf("subnet %s: IPv6 subnet %s is invalid: inconsistent IP address and mask", subnetName, subnetCfg.SubnetIPv6)
+	}
+	if prefixLen := ipNet.Bits(); prefixLen > 126 {
+		return netip.Prefix{}, errors.Errorf("subnet %s: IPv6 prefix length %d is too large, must be <= 126", subnetName, prefixLen)
+	}
+
+	if subnetCfg.HostBGP {
+		return netip.Prefix{}, errors.Errorf("subnet %s: IPv6 is not supported for hostBGP subnets", subnetName)
+	}
+
+	if subnetCfg.GatewayIPv6 == "" {
+		return netip.Prefix{}, errors.Errorf("subnet %s: IPv6 gateway is required", subnetName)
+	}
+
+	gateway, err := netip.ParseAddr(subnetCfg.GatewayIPv6)
+	if err != nil {
+		return netip.Prefix{}, errors.Errorf("subnet %s: IPv6 gateway %s is not a valid IP address", subnetName, subnetCfg.GatewayIPv6)
+	}
+	if !ipNet.Contains(gateway) {
+		return netip.Prefix{}, errors.Errorf("subnet %s: IPv6 gateway %s is not in the IPv6 subnet", subnetName, subnetCfg.GatewayIPv6)
+	}
+	if gateway == ipNet.Addr() {
+		return netip.Prefix{}, errors.Errorf("subnet %s: IPv6 gateway %s is the subnet-router anycast address", subnetName, subnetCfg.GatewayIPv6)
+	}
+
+	return ipNet, nil
+}
@@ -66,6 +66,23 @@ func TestVPCValidation(t *testing.T) {
 		},
 	}
 
+	ipv6KubeObjs := []kclient.Object{
+		&v1beta1.IPv6Namespace{
+			ObjectMeta: kmetav1.ObjectMeta{
+				Name:      "default",
+				Namespace: kmetav1.NamespaceDefault,
+			},
+			Spec: v1beta1.IPv6NamespaceSpec{
+				Subnets: []string{"fd00::/48"},
+			},
+		},
+	}
+	ipv6KubeObjs = append(ipv6KubeObjs, baseKubeObjs...)
+
+	withIPv6 := func(vpc *v1beta1.VPC) {
+		vpc.Spec.Subnets["default"].SubnetIPv6 = "fd00:0:0:1::/64"
+	}
+
 	tests := []struct {
 		name      string
 		vpc       *v1beta1.VPC
@@ -383,6 +400,102 @@ func TestVPCValidation(t *testing.T) {
 			}),
 			err: true,
 		},
+		{
+			name: "valid dual-stack vpc",
+			vpc:  vpcGen("vpc-01", withIPv6),
+			err:  false,
+		},
+		{
+			name:    "valid dual-stack vpc with kube",
+			vpc:     vpcGen("vpc-01", withIPv6),
+			objects: ipv6KubeObjs,
+			err:     false,
+		},
+		{
+			name:    "dual-stack vpc without ipv6 namespace",
+			vpc:     vpcGen("vpc-01", withIPv6),
+			objects: baseKubeObjs,
+			err:     true,
+		},
+		{
+			name: "ipv6 subnet is not ipv6",
+			vpc: vpcGen("vpc-01", func(vpc *v1beta1.VPC) {
+				vpc.Spec.Subnets["default"].SubnetIPv6 = "10.1.0.0/24"
+			}),
+			err: true,
+		},
+		{
+			name: "ipv6 prefix too long",
+			vpc: vpcGen("vpc-01", func(vpc *v1beta1.VPC) {
+				vpc.Spec.Subnets["default"].SubnetIPv6 = "fd00:0:0:1::/127"
+			}),
+			err: true,
+		},
+		{
+			name: "ipv6 gateway outside of ipv6 subnet",
+			vpc: vpcGen("vpc-01", func(vpc *v1beta1.VPC) {
+				vpc.Spec.Subnets["default"].SubnetIPv6 = "fd00:0:0:1::/64"
+				vpc.Spec.Subnets["default"].GatewayIPv6 = "fd00:0:0:2::1"
+			}),
+			err: true,
+		},
+		{
+			name: "ipv6 gateway without ipv6 subnet",
+			vpc: vpcGen("vpc-01", func(vpc *v1beta1.VPC) {
+				vpc.Spec.Subnets["default"].GatewayIPv6 = "fd00:0:0:1::1"
+			}),
+			err: true,
+		},
+		{
+			name: "overlapping ipv6 subnets",
+			vpc: vpcGen("vpc-01", withIPv6, func(vpc *v1beta1.VPC) {
+				vpc.Spec.Subnets["other"] = &v1beta1.VPCSubnet{
+					Subnet:     "10.0.2.0/24",
+					Gateway:    "10.0.2.1",
+					VLAN:       101,
+					SubnetIPv6: "fd00::/56",
+				}
+			}),
+			err: true,
+		},
+		{
+			name: "ipv6 subnet outside of ipv6 namespace",
+			vpc: vpcGen("vpc-01", func(vpc *v1beta1.VPC) {
+				vpc.Spec.Subnets["default"].SubnetIPv6 = "fd01:0:0:1::/64"
+			}),
+			objects: ipv6KubeObjs,
+			err:     true,
+		},
+		{
+			name: "ipv6 subnet overlaps with other vpc",
+			vpc:  vpcGen("vpc-01", withIPv6),
+			objects: append(append([]kclient.Object{}, ipv6KubeObjs...), &v1beta1.VPC{
+				ObjectMeta: kmetav1.ObjectMeta{
+					Name:      "vpc-02",
+					Namespace: kmetav1.NamespaceDefault,
+					Labels: map[string]string{
+						v1beta1.LabelIPv4NS: "default",
+						v1beta1.LabelIPv6NS: "default",
+						v1beta1.LabelVLANNS: "default",
+					},
+				},
+				Spec: v1beta1.VPCSpec{
+					IPv4Namespace: "default",
+					IPv6Namespace: "default",
+					VLANNamespace: "default",
+					Subnets: map[string]*v1beta1.VPCSubnet{
+						"default": {
+							Subnet:      "10.0.2.0/24",
+							Gateway:     "10.0.2.1",
+							VLAN:        200,
+							SubnetIPv6:  "fd00:0:0:1::/64",
+							GatewayIPv6: "fd00:0:0:1::1",
+						},
+					},
+				},
+			}),
+			err: true,
+		},
 	}
 
 	scheme := runtime.NewScheme()
@@ -50,7 +50,12 @@ type VPCAttachmentSpec struct {
 }
 
 // VPCAttachmentStatus defines the observed state of VPCAttachment
-type VPCAttachmentStatus struct{}
+type VPCAttachmentStatus struct {
+	// Switches is the list of switches the attachment is configured on with the generation applied by each of them
+	Switches []SwitchApplyStatus `json:"switches,omitempty"`
+	// Conditions of the attachment, includes Applied condition for use with kubectl wait
+	Conditions []kmetav1.Condition `json:"conditions,omitempty"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
@@ -58,6 +63,7 @@ type VPCAttachmentStatus struct{}
 // +kubebuilder:printcolumn:name="VPCSUBNET",type=string,JSONPath=`.spec.subnet`,priority=0
 // +kubebuilder:printcolumn:name="Connection",type=string,JSONPath=`.spec.connection`,priority=0
 // +kubebuilder:printcolumn:name="NativeVLAN",type=string,JSONPath=`.spec.nativeVLAN`,priority=0
+// +kubebuilder:printcolumn:name="Applied",type=string,JSONPath=`.status.conditions[?(@.type=="Applied")].status`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,priority=0
 // VPCAttachment is the Schema for the vpcattachments API
 type VPCAttachment struct {
@@ -203,6 +209,16 @@ func (attach *VPCAttachment) Validate(ctx context.Context, kube kclient.Reader,
 		}
 		subnetSpec := vpc.Spec.Subnets[subnet]
 
+		policies := &SecurityPolicyList{}
+		if err := kube.List(ctx, policies, kclient.InNamespace(attach.Namespace), kclient.MatchingLabels{
+			LabelVPC: vpcName,
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to list security policies for vpc %s", vpcName) // TODO replace with some internal error to not expose to the user
+		}
+		requiresACLs := slices.ContainsFunc(policies.Items, func(policy SecurityPolicy) bool {
+			return policy.Spec.AppliesTo(subnet)
+		})
+
 		conn := &wiringapi.Connection{}
 		err = kube.Get(ctx, ktypes.NamespacedName{Name: attach.Spec.Connection, Namespace: attach.Namespace}, conn)
 		if kapierrors.IsNotFound(err) {
@@ -268,6 +284,10 @@ func (attach *VPCAttachment) Validate(ctx context.Context, kube kclient.Reader,
 				}
 			}
 
+			if requiresACLs && !spf.ACLs {
+				return nil, errors.Errorf("switch profile %s doesn't support ACLs required by security policies of vpc %s", sw.Spec.Profile, vpcName)
+			}
+
 			if subnetSpec.HostBGP && sw.Spec.Redundancy.Group != "" && sw.Spec.Redundancy.Type == meta.RedundancyTypeMCLAG {
 				return nil, errors.Errorf("cannot attach hostBGP subnet to switch %s which is an MCLAG peer", sw.Name)
 			}
@@ -50,7 +50,12 @@ type VPCPeer struct {
 }
 
 // VPCPeeringStatus defines the observed state of VPCPeering
-type VPCPeeringStatus struct{}
+type VPCPeeringStatus struct {
+	// Switches is the list of switches the peering is configured on with the generation applied by each of them
+	Switches []SwitchApplyStatus `json:"switches,omitempty"`
+	// Conditions of the peering, includes Applied condition for use with kubectl wait
+	Conditions []kmetav1.Condition `json:"conditions,omitempty"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
@@ -58,6 +63,7 @@ type VPCPeeringStatus struct{}
 // +kubebuilder:printcolumn:name="VPC1",type=string,JSONPath=`.metadata.labels.fabric\.githedgehog\.com/vpc1`,priority=0
 // +kubebuilder:printcolumn:name="VPC2",type=string,JSONPath=`.metadata.labels.fabric\.githedgehog\.com/vpc2`,priority=0
 // +kubebuilder:printcolumn:name="Remote",type=string,JSONPath=`.spec.remote`,priority=0
+// +kubebuilder:printcolumn:name="Applied",type=string,JSONPath=`.status.conditions[?(@.type=="Applied")].status`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,priority=0
 // VPCPeering represents a peering between two VPCs with corresponding filtering rules.
 // Minimal example of the VPC peering showing vpc-1 to vpc-2 peering with all subnets allowed:
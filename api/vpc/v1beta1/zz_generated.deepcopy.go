@@ -8,6 +8,7 @@
 package v1beta1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -111,7 +112,7 @@ func (in *ExternalAttachment) DeepCopyInto(out *ExternalAttachment) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAttachment.
@@ -132,6 +133,29 @@ func (in *ExternalAttachment) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAttachmentBGPStatus) DeepCopyInto(out *ExternalAttachmentBGPStatus) {
+	*out = *in
+	if in.EstablishedSince != nil {
+		in, out := &in.EstablishedSince, &out.EstablishedSince
+		*out = (*in).DeepCopy()
+	}
+	if in.LastResetTime != nil {
+		in, out := &in.LastResetTime, &out.LastResetTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAttachmentBGPStatus.
+func (in *ExternalAttachmentBGPStatus) DeepCopy() *ExternalAttachmentBGPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAttachmentBGPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalAttachmentList) DeepCopyInto(out *ExternalAttachmentList) {
 	*out = *in
@@ -167,6 +191,11 @@ func (in *ExternalAttachmentList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalAttachmentNeighbor) DeepCopyInto(out *ExternalAttachmentNeighbor) {
 	*out = *in
+	if in.MaxPrefix != nil {
+		in, out := &in.MaxPrefix, &out.MaxPrefix
+		*out = new(ExternalAttachmentNeighborMaxPrefix)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAttachmentNeighbor.
@@ -179,11 +208,26 @@ func (in *ExternalAttachmentNeighbor) DeepCopy() *ExternalAttachmentNeighbor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAttachmentNeighborMaxPrefix) DeepCopyInto(out *ExternalAttachmentNeighborMaxPrefix) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAttachmentNeighborMaxPrefix.
+func (in *ExternalAttachmentNeighborMaxPrefix) DeepCopy() *ExternalAttachmentNeighborMaxPrefix {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAttachmentNeighborMaxPrefix)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalAttachmentSpec) DeepCopyInto(out *ExternalAttachmentSpec) {
 	*out = *in
 	out.Switch = in.Switch
-	out.Neighbor = in.Neighbor
+	in.Neighbor.DeepCopyInto(&out.Neighbor)
 	if in.Static != nil {
 		in, out := &in.Static, &out.Static
 		*out = new(ExternalAttachmentStatic)
@@ -194,6 +238,11 @@ func (in *ExternalAttachmentSpec) DeepCopyInto(out *ExternalAttachmentSpec) {
 		*out = new(ACLSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OutboundACL != nil {
+		in, out := &in.OutboundACL, &out.OutboundACL
+		*out = new(ACLSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAttachmentSpec.
@@ -224,6 +273,18 @@ func (in *ExternalAttachmentStatic) DeepCopy() *ExternalAttachmentStatic {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalAttachmentStatus) DeepCopyInto(out *ExternalAttachmentStatus) {
 	*out = *in
+	if in.BGP != nil {
+		in, out := &in.BGP, &out.BGP
+		*out = new(ExternalAttachmentBGPStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAttachmentStatus.
@@ -289,7 +350,7 @@ func (in *ExternalPeering) DeepCopyInto(out *ExternalPeering) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalPeering.
@@ -366,6 +427,11 @@ func (in *ExternalPeeringSpecExternal) DeepCopyInto(out *ExternalPeeringSpecExte
 		*out = make([]ExternalPeeringSpecPrefix, len(*in))
 		copy(*out, *in)
 	}
+	if in.RoutePolicy != nil {
+		in, out := &in.RoutePolicy, &out.RoutePolicy
+		*out = new(ExternalPeeringSpecRoutePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalPeeringSpecExternal.
@@ -410,6 +476,31 @@ func (in *ExternalPeeringSpecPrefix) DeepCopy() *ExternalPeeringSpecPrefix {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalPeeringSpecRoutePolicy) DeepCopyInto(out *ExternalPeeringSpecRoutePolicy) {
+	*out = *in
+	if in.ASPaths != nil {
+		in, out := &in.ASPaths, &out.ASPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalPeeringSpecRoutePolicy.
+func (in *ExternalPeeringSpecRoutePolicy) DeepCopy() *ExternalPeeringSpecRoutePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalPeeringSpecRoutePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalPeeringSpecVPC) DeepCopyInto(out *ExternalPeeringSpecVPC) {
 	*out = *in
@@ -433,6 +524,18 @@ func (in *ExternalPeeringSpecVPC) DeepCopy() *ExternalPeeringSpecVPC {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalPeeringStatus) DeepCopyInto(out *ExternalPeeringStatus) {
 	*out = *in
+	if in.Switches != nil {
+		in, out := &in.Switches, &out.Switches
+		*out = make([]SwitchApplyStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalPeeringStatus.
@@ -595,7 +698,7 @@ func (in *IPv4NamespaceStatus) DeepCopy() *IPv4NamespaceStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPC) DeepCopyInto(out *VPC) {
+func (in *IPv6Namespace) DeepCopyInto(out *IPv6Namespace) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -603,6 +706,216 @@ func (in *VPC) DeepCopyInto(out *VPC) {
 	out.Status = in.Status
 }
 
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6Namespace.
+func (in *IPv6Namespace) DeepCopy() *IPv6Namespace {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6Namespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPv6Namespace) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6NamespaceList) DeepCopyInto(out *IPv6NamespaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPv6Namespace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6NamespaceList.
+func (in *IPv6NamespaceList) DeepCopy() *IPv6NamespaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6NamespaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPv6NamespaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6NamespaceSpec) DeepCopyInto(out *IPv6NamespaceSpec) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6NamespaceSpec.
+func (in *IPv6NamespaceSpec) DeepCopy() *IPv6NamespaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6NamespaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6NamespaceStatus) DeepCopyInto(out *IPv6NamespaceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6NamespaceStatus.
+func (in *IPv6NamespaceStatus) DeepCopy() *IPv6NamespaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6NamespaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityPolicy) DeepCopyInto(out *SecurityPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicy.
+func (in *SecurityPolicy) DeepCopy() *SecurityPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityPolicyList) DeepCopyInto(out *SecurityPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecurityPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyList.
+func (in *SecurityPolicyList) DeepCopy() *SecurityPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityPolicySpec) DeepCopyInto(out *SecurityPolicySpec) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Statements != nil {
+		in, out := &in.Statements, &out.Statements
+		*out = make([]ACLStatement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicySpec.
+func (in *SecurityPolicySpec) DeepCopy() *SecurityPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityPolicyStatus) DeepCopyInto(out *SecurityPolicyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityPolicyStatus.
+func (in *SecurityPolicyStatus) DeepCopy() *SecurityPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchApplyStatus) DeepCopyInto(out *SwitchApplyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchApplyStatus.
+func (in *SwitchApplyStatus) DeepCopy() *SwitchApplyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchApplyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPC) DeepCopyInto(out *VPC) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPC.
 func (in *VPC) DeepCopy() *VPC {
 	if in == nil {
@@ -627,7 +940,7 @@ func (in *VPCAttachment) DeepCopyInto(out *VPCAttachment) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCAttachment.
@@ -698,6 +1011,18 @@ func (in *VPCAttachmentSpec) DeepCopy() *VPCAttachmentSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCAttachmentStatus) DeepCopyInto(out *VPCAttachmentStatus) {
 	*out = *in
+	if in.Switches != nil {
+		in, out := &in.Switches, &out.Switches
+		*out = make([]SwitchApplyStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCAttachmentStatus.
@@ -875,7 +1200,7 @@ func (in *VPCPeering) DeepCopyInto(out *VPCPeering) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeering.
@@ -959,6 +1284,18 @@ func (in *VPCPeeringSpec) DeepCopy() *VPCPeeringSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCPeeringStatus) DeepCopyInto(out *VPCPeeringStatus) {
 	*out = *in
+	if in.Switches != nil {
+		in, out := &in.Switches, &out.Switches
+		*out = make([]SwitchApplyStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringStatus.
@@ -1043,6 +1380,32 @@ func (in *VPCStaticRoute) DeepCopy() *VPCStaticRoute {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCStatus) DeepCopyInto(out *VPCStatus) {
 	*out = *in
+	if in.SubnetVNIs != nil {
+		in, out := &in.SubnetVNIs, &out.SubnetVNIs
+		*out = make(map[string]uint32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Switches != nil {
+		in, out := &in.Switches, &out.Switches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make(map[string]VPCSubnetStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCStatus.
@@ -1080,3 +1443,18 @@ func (in *VPCSubnet) DeepCopy() *VPCSubnet {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCSubnetStatus) DeepCopyInto(out *VPCSubnetStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCSubnetStatus.
+func (in *VPCSubnetStatus) DeepCopy() *VPCSubnetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCSubnetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
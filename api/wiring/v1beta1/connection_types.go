@@ -20,6 +20,7 @@ import (
 	"maps"
 	"net"
 	"net/netip"
+	"slices"
 	"strings"
 
 	"github.com/fatih/color"
@@ -79,6 +80,73 @@ type ServerToSwitchLink struct {
 type ServerFacingConnectionConfig struct {
 	// MTU is the MTU to be configured on the switch port or port channel
 	MTU uint16 `json:"mtu,omitempty"`
+	// StormControl is the storm control configuration for the switch ports of the connection, overrides the switch
+	// default storm control configuration
+	StormControl *StormControl `json:"stormControl,omitempty"`
+}
+
+type StormControlUnit string
+
+const (
+	StormControlUnitPPS  StormControlUnit = "pps"
+	StormControlUnitKbps StormControlUnit = "kbps"
+)
+
+var StormControlUnits = []StormControlUnit{
+	StormControlUnitPPS,
+	StormControlUnitKbps,
+}
+
+// StormControl defines the storm control thresholds for the broadcast, unknown-unicast and multicast traffic on the
+// server-facing switch ports, traffic above the threshold is dropped
+type StormControl struct {
+	// +kubebuilder:validation:Enum=pps;kbps
+	// +kubebuilder:default=kbps
+	// Unit is the unit of the thresholds, pps (packets per second) or kbps (kilobits per second)
+	Unit StormControlUnit `json:"unit,omitempty"`
+	// Broadcast is the broadcast traffic threshold, not limited if not set
+	Broadcast uint64 `json:"broadcast,omitempty"`
+	// UnknownUnicast is the unknown-unicast traffic threshold, not limited if not set
+	UnknownUnicast uint64 `json:"unknownUnicast,omitempty"`
+	// Multicast is the unknown-multicast traffic threshold, not limited if not set
+	Multicast uint64 `json:"multicast,omitempty"`
+}
+
+func (sc *StormControl) Default() {
+	if sc != nil && sc.Unit == "" {
+		sc.Unit = StormControlUnitKbps
+	}
+}
+
+func (sc *StormControl) Validate() error {
+	if sc == nil {
+		return nil
+	}
+
+	if !slices.Contains(StormControlUnits, sc.Unit) {
+		return errors.Errorf("invalid storm control unit %q", sc.Unit)
+	}
+
+	if sc.Broadcast == 0 && sc.UnknownUnicast == 0 && sc.Multicast == 0 {
+		return errors.Errorf("storm control requires at least one of broadcast, unknown-unicast or multicast thresholds")
+	}
+
+	return nil
+}
+
+// ServerFacingConfig returns the server-facing configuration of the connection or nil if it's not a server-facing one
+func (connSpec *ConnectionSpec) ServerFacingConfig() *ServerFacingConnectionConfig {
+	if connSpec.Unbundled != nil { //nolint:gocritic
+		return &connSpec.Unbundled.ServerFacingConnectionConfig
+	} else if connSpec.Bundled != nil {
+		return &connSpec.Bundled.ServerFacingConnectionConfig
+	} else if connSpec.MCLAG != nil {
+		return &connSpec.MCLAG.ServerFacingConnectionConfig
+	} else if connSpec.ESLAG != nil {
+		return &connSpec.ESLAG.ServerFacingConnectionConfig
+	}
+
+	return nil
 }
 
 // ConnUnbundled defines the unbundled connection (no port channel, single server to a single switch with a single link)
@@ -97,6 +165,8 @@ type ConnBundled struct {
 	ServerFacingConnectionConfig `json:",inline"`
 	// Fallback is the optional flag that used to indicate one of the links in LACP port channel to be used as a fallback link
 	Fallback bool `json:"fallback,omitempty"`
+	// LACP is the optional LACP configuration of the port channel
+	LACP *ConnLACP `json:"lacp,omitempty"`
 }
 
 // Deprecated: ConnMCLAG defines the MCLAG connection (port channel, single server to pair of switches with multiple links)
@@ -119,6 +189,32 @@ type ConnESLAG struct {
 	ServerFacingConnectionConfig `json:",inline"`
 	// Fallback is the optional flag that used to indicate one of the links in LACP port channel to be used as a fallback link
 	Fallback bool `json:"fallback,omitempty"`
+	// LACP is the optional LACP configuration of the port channel
+	LACP *ConnLACP `json:"lacp,omitempty"`
+}
+
+type ConnLACPRate string
+
+const (
+	ConnLACPRateFast ConnLACPRate = "fast"
+	ConnLACPRateSlow ConnLACPRate = "slow"
+)
+
+var ConnLACPRates = []ConnLACPRate{
+	ConnLACPRateFast,
+	ConnLACPRateSlow,
+}
+
+// ConnLACP defines the LACP configuration of the port channel based connections (bundled, eslag)
+type ConnLACP struct {
+	// +kubebuilder:validation:Enum=fast;slow
+	// Rate is the LACP PDU rate, fast (every second) or slow (every 30 seconds), NOS default is used if not set
+	Rate ConnLACPRate `json:"rate,omitempty"`
+	// +kubebuilder:validation:Maximum=32
+	// MinLinks is the minimum number of member links on each switch that should be up for the port channel to be up
+	MinLinks uint16 `json:"minLinks,omitempty"`
+	// SystemPriority is the LACP system priority of the port channel, lower value means higher priority
+	SystemPriority uint16 `json:"systemPriority,omitempty"`
 }
 
 // SwitchToSwitchLink defines the switch-to-switch link
@@ -217,10 +313,37 @@ type ConnExternalLink struct {
 	Switch BasePortName `json:"switch,omitempty"`
 }
 
-// ConnExternal defines the external connection (single switch to a single external device with a single link)
+// ConnExternal defines the external connection (single switch to a single external device with a single link or
+// multiple links bundled into a port channel)
 type ConnExternal struct {
-	// Link is the external connection link
+	// Link is the external connection link, mutually exclusive with Links
 	Link ConnExternalLink `json:"link,omitempty"`
+	// Links is the list of external connection links bundled into a port channel, mutually exclusive with Link
+	Links []ConnExternalLink `json:"links,omitempty"`
+}
+
+// IsBundled returns true if the external connection links are bundled into a port channel
+func (c *ConnExternal) IsBundled() bool {
+	return len(c.Links) > 0
+}
+
+// SwitchPorts returns the switch side of all external connection links
+func (c *ConnExternal) SwitchPorts() []BasePortName {
+	if !c.IsBundled() {
+		return []BasePortName{c.Link.Switch}
+	}
+
+	ports := make([]BasePortName, 0, len(c.Links))
+	for _, link := range c.Links {
+		ports = append(ports, link.Switch)
+	}
+
+	return ports
+}
+
+// SwitchName returns the name of the switch the external connection is attached to
+func (c *ConnExternal) SwitchName() string {
+	return c.SwitchPorts()[0].DeviceName()
 }
 
 // ConnStaticExternalLinkSwitch defines the switch side of the static external connection link
@@ -273,14 +396,45 @@ type ConnectionSpec struct {
 	Gateway *ConnGateway `json:"gateway,omitempty"`
 	// VPCLoopback defines the VPC loopback connection (multiple port pairs on a single switch) for automated workaround
 	VPCLoopback *ConnVPCLoopback `json:"vpcLoopback,omitempty"`
-	// External defines the external connection (single switch to a single external device with a single link)
+	// External defines the external connection (single switch to a single external device with a single link or
+	// multiple links bundled into a port channel)
 	External *ConnExternal `json:"external,omitempty"`
 	// StaticExternal defines the static external connection (single switch to a single external device with a single link)
 	StaticExternal *ConnStaticExternal `json:"staticExternal,omitempty"`
 }
 
+// ConnectionLinkState is the cabling verification state of a single connection link
+type ConnectionLinkState string
+
+const (
+	// ConnectionLinkStateVerified means that the LLDP neighbor observed on the switch port matches the declared remote
+	// port, for the links without declared remote port (external connections) any neighbor is considered as matching
+	ConnectionLinkStateVerified ConnectionLinkState = "Verified"
+	// ConnectionLinkStateMiscabled means that the LLDP neighbor observed on the switch port doesn't match the declared
+	// remote port
+	ConnectionLinkStateMiscabled ConnectionLinkState = "Miscabled"
+	// ConnectionLinkStateNoNeighbor means that there is no LLDP neighbor observed on the switch port
+	ConnectionLinkStateNoNeighbor ConnectionLinkState = "NoNeighbor"
+)
+
+// ConnectionLinkStatus defines the cabling verification status of a single connection link as seen from the switch port
+type ConnectionLinkStatus struct {
+	// Port is the full name of the switch port the link is observed from, e.g. leaf-01/E1/1
+	Port string `json:"port,omitempty"`
+	// State is the cabling verification state of the link
+	State ConnectionLinkState `json:"state,omitempty"`
+	// Expected is the full name of the remote port declared in the connection, e.g. server-01/enp2s1
+	Expected string `json:"expected,omitempty"`
+	// Observed is the full name of the remote port reported by the LLDP neighbor, e.g. server-01/enp2s1
+	Observed string `json:"observed,omitempty"`
+}
+
 // ConnectionStatus defines the observed state of Connection
-type ConnectionStatus struct{}
+type ConnectionStatus struct {
+	// Links is the cabling verification status of the connection links based on the LLDP neighbors observed on the
+	// switch ports, links between switches are reported from both ends
+	Links []ConnectionLinkStatus `json:"links,omitempty"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
@@ -415,7 +569,7 @@ func (connSpec *ConnectionSpec) GenerateName() string {
 			left = connSpec.VPCLoopback.Links[0].Switch1.DeviceName()
 		} else if connSpec.External != nil {
 			role = "external"
-			left = connSpec.External.Link.Switch.DeviceName()
+			left = connSpec.External.SwitchName()
 		} else if connSpec.StaticExternal != nil {
 			role = "static-external"
 			left = connSpec.StaticExternal.Link.Switch.DeviceName()
@@ -627,9 +781,23 @@ func (connSpec *ConnectionSpec) Endpoints() ([]string, []string, []string, map[s
 	} else if connSpec.External != nil {
 		nonNills++
 
-		switches[connSpec.External.Link.Switch.DeviceName()] = struct{}{}
-		ports[connSpec.External.Link.Switch.PortName()] = struct{}{}
-		links[connSpec.External.Link.Switch.PortName()] = "/"
+		if connSpec.External.IsBundled() && connSpec.External.Link.Switch.Port != "" {
+			return nil, nil, nil, nil, errors.Errorf("link and links are mutually exclusive for external connection")
+		}
+
+		externalPorts := connSpec.External.SwitchPorts()
+		for _, port := range externalPorts {
+			switches[port.DeviceName()] = struct{}{}
+			ports[port.PortName()] = struct{}{}
+			links[port.PortName()] = "/"
+		}
+
+		if len(switches) != 1 {
+			return nil, nil, nil, nil, errors.Errorf("one switch must be used for external connection")
+		}
+		if len(ports) != len(externalPorts) {
+			return nil, nil, nil, nil, errors.Errorf("unique ports must be used for external connection")
+		}
 	} else if connSpec.StaticExternal != nil {
 		nonNills++
 
@@ -683,7 +851,9 @@ func (connSpec *ConnectionSpec) LinkSummary(noColor bool) []string {
 			out = append(out, fmt.Sprintf("%s%s%s", link.Switch1.PortName(), sep, link.Switch2.PortName()))
 		}
 	} else if connSpec.External != nil {
-		out = append(out, connSpec.External.Link.Switch.PortName())
+		for _, port := range connSpec.External.SwitchPorts() {
+			out = append(out, port.PortName())
+		}
 	} else if connSpec.StaticExternal != nil {
 		vpc := ""
 
@@ -720,6 +890,10 @@ func (conn *Connection) Default() {
 	}
 
 	maps.Copy(conn.Labels, conn.Spec.ConnectionLabels())
+
+	if cfg := conn.Spec.ServerFacingConfig(); cfg != nil {
+		cfg.StormControl.Default()
+	}
 }
 
 func (connSpec *ConnectionSpec) ValidateServerFacingMTU(fabricMTU uint16, serverFacingMTUOffset uint16) error {
@@ -733,6 +907,47 @@ func (connSpec *ConnectionSpec) ValidateServerFacingMTU(fabricMTU uint16, server
 	return nil
 }
 
+// ValidateLACP validates the LACP configuration of the port channel based connections against the NOS limitations
+func (connSpec *ConnectionSpec) ValidateLACP() error {
+	var lacp *ConnLACP
+	var fallback bool
+	var links []ServerToSwitchLink
+
+	switch {
+	case connSpec.Bundled != nil:
+		lacp, fallback, links = connSpec.Bundled.LACP, connSpec.Bundled.Fallback, connSpec.Bundled.Links
+	case connSpec.ESLAG != nil:
+		lacp, fallback, links = connSpec.ESLAG.LACP, connSpec.ESLAG.Fallback, connSpec.ESLAG.Links
+	}
+
+	if lacp == nil {
+		return nil
+	}
+
+	if lacp.Rate != "" && !slices.Contains(ConnLACPRates, lacp.Rate) {
+		return errors.Errorf("invalid lacp rate %s", lacp.Rate)
+	}
+
+	if lacp.MinLinks > 0 {
+		if fallback && lacp.MinLinks > 1 {
+			return errors.Errorf("lacp min links %d can't be used with fallback as only a single link is up in fallback mode", lacp.MinLinks)
+		}
+
+		switchLinks := map[string]uint16{}
+		for _, link := range links {
+			switchLinks[link.Switch.DeviceName()]++
+		}
+
+		for switchName, count := range switchLinks {
+			if lacp.MinLinks > count {
+				return errors.Errorf("lacp min links %d is greater than number of links %d on switch %s", lacp.MinLinks, count, switchName)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (conn *Connection) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *meta.FabricConfig) (admission.Warnings, error) {
 	if err := meta.ValidateObjectMetadata(conn); err != nil {
 		return nil, errors.Wrapf(err, "failed to validate metadata")
@@ -753,6 +968,16 @@ func (conn *Connection) Validate(ctx context.Context, kube kclient.Reader, fabri
 		return nil, err
 	}
 
+	if err := conn.Spec.ValidateLACP(); err != nil {
+		return nil, err
+	}
+
+	if cfg := conn.Spec.ServerFacingConfig(); cfg != nil {
+		if err := cfg.StormControl.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	if conn.Spec.StaticExternal != nil {
 		se := conn.Spec.StaticExternal.Link.Switch
 
@@ -851,6 +1076,10 @@ func (conn *Connection) Validate(ctx context.Context, kube kclient.Reader, fabri
 				return nil, errors.Wrapf(err, "failed to get switch profile %s", sw.Spec.Profile) // TODO replace with some internal error to not expose to the user
 			}
 
+			if conn.Spec.External != nil && conn.Spec.External.IsBundled() && !sp.Spec.Features.PortChannelSubinterfaces {
+				return nil, errors.Errorf("switch %s with profile %s doesn't support subinterfaces on port channels required by bundled external connection", switchName, sw.Spec.Profile)
+			}
+
 			allowedPorts, err := sp.Spec.GetAPI2NOSPortsFor(&sw.Spec)
 			if err != nil {
 				return nil, errors.Wrapf(err, "failed to get NOS port mapping for switch %s", switchName)
@@ -130,6 +130,25 @@ func gwConnGen(name string, f ...func(conn *wiringapi.Connection)) *wiringapi.Co
 	return conn
 }
 
+func extConnGen(name string, f ...func(conn *wiringapi.Connection)) *wiringapi.Connection {
+	conn := withName(name, &wiringapi.Connection{
+		Spec: wiringapi.ConnectionSpec{
+			External: &wiringapi.ConnExternal{
+				Links: []wiringapi.ConnExternalLink{
+					{Switch: wiringapi.NewBasePortName("leaf-01/E1/5")},
+					{Switch: wiringapi.NewBasePortName("leaf-01/E1/6")},
+				},
+			},
+		},
+	})
+
+	for _, fn := range f {
+		fn(conn)
+	}
+
+	return conn
+}
+
 func TestConnectionValidation(t *testing.T) {
 	base := []kclient.Object{
 		withName("spine-01",
@@ -651,6 +670,59 @@ func TestConnectionValidation(t *testing.T) {
 				},
 			}),
 		},
+		{
+			name:       "external-single-link",
+			withClient: true,
+			objects:    base,
+			conn: extConnGen("ext-1", func(conn *wiringapi.Connection) {
+				conn.Spec.External.Links = nil
+				conn.Spec.External.Link.Switch = wiringapi.NewBasePortName("leaf-01/E1/5")
+			}),
+		},
+		{
+			name:       "external-bundled",
+			withClient: true,
+			objects:    base,
+			conn:       extConnGen("ext-1"),
+		},
+		{
+			name: "external-link-and-links",
+			conn: extConnGen("ext-1", func(conn *wiringapi.Connection) {
+				conn.Spec.External.Link.Switch = wiringapi.NewBasePortName("leaf-01/E1/7")
+			}),
+			err: true,
+		},
+		{
+			name: "external-bundled-multiple-switches",
+			conn: extConnGen("ext-1", func(conn *wiringapi.Connection) {
+				conn.Spec.External.Links[1].Switch = wiringapi.NewBasePortName("leaf-02/E1/6")
+			}),
+			err: true,
+		},
+		{
+			name: "external-bundled-duplicate-ports",
+			conn: extConnGen("ext-1", func(conn *wiringapi.Connection) {
+				conn.Spec.External.Links[1].Switch = wiringapi.NewBasePortName("leaf-01/E1/5")
+			}),
+			err: true,
+		},
+		{
+			name:       "external-bundled-no-port-channel-subinterfaces",
+			withClient: true,
+			objects: withObjs(base, withName("leaf-03",
+				&wiringapi.Switch{
+					Spec: wiringapi.SwitchSpec{
+						Role:    wiringapi.SwitchRoleServerLeaf,
+						ASN:     65103,
+						Profile: switchprofile.DellZ9332FON.Name,
+					},
+				})),
+			conn: extConnGen("ext-1", func(conn *wiringapi.Connection) {
+				conn.Spec.External.Links[0].Switch = wiringapi.NewBasePortName("leaf-03/E1/5")
+				conn.Spec.External.Links[1].Switch = wiringapi.NewBasePortName("leaf-03/E1/6")
+			}),
+			err: true,
+		},
 		{
 			name: "mclag-is-deprecated",
 			conn: withName("mclag-1", &wiringapi.Connection{
@@ -706,3 +778,140 @@ func TestConnectionValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectionValidateLACP(t *testing.T) {
+	link := func(server, sw string) wiringapi.ServerToSwitchLink {
+		return wiringapi.ServerToSwitchLink{
+			Server: wiringapi.BasePortName{Port: server},
+			Switch: wiringapi.BasePortName{Port: sw},
+		}
+	}
+	bundledLinks := []wiringapi.ServerToSwitchLink{
+		link("server-01/enp2s1", "leaf-01/E1/1"),
+		link("server-01/enp2s2", "leaf-01/E1/2"),
+	}
+	eslagLinks := []wiringapi.ServerToSwitchLink{
+		link("server-01/enp2s1", "leaf-01/E1/1"),
+		link("server-01/enp2s2", "leaf-01/E1/2"),
+		link("server-01/enp2s3", "leaf-02/E1/1"),
+	}
+
+	for _, tt := range []struct {
+		name string
+		spec wiringapi.ConnectionSpec
+		err  string
+	}{
+		{
+			name: "no-lacp",
+			spec: wiringapi.ConnectionSpec{Bundled: &wiringapi.ConnBundled{Links: bundledLinks}},
+		},
+		{
+			name: "bundled-all",
+			spec: wiringapi.ConnectionSpec{Bundled: &wiringapi.ConnBundled{
+				Links: bundledLinks,
+				LACP: &wiringapi.ConnLACP{
+					Rate:           wiringapi.ConnLACPRateSlow,
+					MinLinks:       2,
+					SystemPriority: 100,
+				},
+			}},
+		},
+		{
+			name: "invalid-rate",
+			spec: wiringapi.ConnectionSpec{Bundled: &wiringapi.ConnBundled{
+				Links: bundledLinks,
+				LACP:  &wiringapi.ConnLACP{Rate: "medium"},
+			}},
+			err: "invalid lacp rate medium",
+		},
+		{
+			name: "min-links-too-many",
+			spec: wiringapi.ConnectionSpec{Bundled: &wiringapi.ConnBundled{
+				Links: bundledLinks,
+				LACP:  &wiringapi.ConnLACP{MinLinks: 3},
+			}},
+			err: "lacp min links 3 is greater than number of links 2 on switch leaf-01",
+		},
+		{
+			name: "min-links-fallback",
+			spec: wiringapi.ConnectionSpec{Bundled: &wiringapi.ConnBundled{
+				Links:    bundledLinks,
+				Fallback: true,
+				LACP:     &wiringapi.ConnLACP{MinLinks: 2},
+			}},
+			err: "can't be used with fallback",
+		},
+		{
+			name: "min-links-one-fallback",
+			spec: wiringapi.ConnectionSpec{Bundled: &wiringapi.ConnBundled{
+				Links:    bundledLinks,
+				Fallback: true,
+				LACP:     &wiringapi.ConnLACP{MinLinks: 1},
+			}},
+		},
+		{
+			name: "eslag-min-links-per-switch",
+			spec: wiringapi.ConnectionSpec{ESLAG: &wiringapi.ConnESLAG{
+				Links: eslagLinks,
+				LACP:  &wiringapi.ConnLACP{MinLinks: 2},
+			}},
+			err: "lacp min links 2 is greater than number of links 1 on switch leaf-02",
+		},
+		{
+			name: "eslag-rate",
+			spec: wiringapi.ConnectionSpec{ESLAG: &wiringapi.ConnESLAG{
+				Links: eslagLinks,
+				LACP:  &wiringapi.ConnLACP{Rate: wiringapi.ConnLACPRateFast, MinLinks: 1},
+			}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.ValidateLACP()
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStormControlValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		sc   *wiringapi.StormControl
+		err  bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "defaulted",
+			sc:   &wiringapi.StormControl{Broadcast: 1000},
+		},
+		{
+			name: "pps",
+			sc:   &wiringapi.StormControl{Unit: wiringapi.StormControlUnitPPS, UnknownUnicast: 100, Multicast: 200},
+		},
+		{
+			name: "invalid-unit",
+			sc:   &wiringapi.StormControl{Unit: "mbps", Broadcast: 1000},
+			err:  true,
+		},
+		{
+			name: "no-thresholds",
+			sc:   &wiringapi.StormControl{Unit: wiringapi.StormControlUnitKbps},
+			err:  true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.sc.Default()
+			err := tt.sc.Validate()
+			if tt.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
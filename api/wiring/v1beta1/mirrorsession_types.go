@@ -0,0 +1,329 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	"context"
+	"maps"
+	"net/netip"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.githedgehog.com/fabric/api/meta"
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+type MirrorDirection string
+
+const (
+	MirrorDirectionRX   MirrorDirection = "rx"
+	MirrorDirectionTX   MirrorDirection = "tx"
+	MirrorDirectionBoth MirrorDirection = "both"
+)
+
+var MirrorDirections = []MirrorDirection{
+	MirrorDirectionRX,
+	MirrorDirectionTX,
+	MirrorDirectionBoth,
+}
+
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// MirrorSessionSpec defines the desired state of MirrorSession. Exactly one of SPAN or ERSPAN destinations should be
+// set.
+type MirrorSessionSpec struct {
+	// Switch is the name of the switch the mirror session is configured on
+	Switch string `json:"switch,omitempty"`
+	//+kubebuilder:validation:MinItems=1
+	// Sources is the list of the switch ports to mirror traffic from, such as "E1/1"
+	Sources []string `json:"sources,omitempty"`
+	//+kubebuilder:validation:Enum=rx;tx;both
+	//+kubebuilder:default=both
+	// Direction is the direction of the mirrored traffic on the source ports, rx, tx or both
+	Direction MirrorDirection `json:"direction,omitempty"`
+	// SPAN is the local destination port configuration
+	SPAN *MirrorSPAN `json:"span,omitempty"`
+	// ERSPAN is the remote GRE encapsulated destination configuration
+	ERSPAN *MirrorERSPAN `json:"erspan,omitempty"`
+	// ExpiresAt is the optional time after which the mirror session is removed from the switch
+	ExpiresAt *kmetav1.Time `json:"expiresAt,omitempty"`
+}
+
+// MirrorSPAN defines the local (SPAN) mirror session destination
+type MirrorSPAN struct {
+	// Port is the switch port to send the mirrored traffic to, such as "E1/10", shouldn't be used by any connection or
+	// other mirror session of the switch
+	Port string `json:"port,omitempty"`
+}
+
+// MirrorERSPAN defines the remote (ERSPAN) mirror session destination
+type MirrorERSPAN struct {
+	// DestinationIP is the IP address of the GRE tunnel destination the mirrored traffic is sent to
+	DestinationIP string `json:"destinationIP,omitempty"`
+	// VPC is the optional name of the VPC which VRF is used to reach the destination IP, default VRF is used if not set
+	VPC string `json:"vpc,omitempty"`
+	//+kubebuilder:validation:Maximum=63
+	// DSCP is the DSCP value of the GRE packets
+	DSCP uint8 `json:"dscp,omitempty"`
+	//+kubebuilder:default=64
+	// TTL is the TTL value of the GRE packets
+	TTL uint8 `json:"ttl,omitempty"`
+}
+
+// MirrorSessionStatus defines the observed state of MirrorSession
+type MirrorSessionStatus struct{}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=hedgehog;wiring;fabric,shortName=mirror
+// +kubebuilder:printcolumn:name="Switch",type=string,JSONPath=`.spec.switch`,priority=0
+// +kubebuilder:printcolumn:name="Sources",type=string,JSONPath=`.spec.sources`,priority=0
+// +kubebuilder:printcolumn:name="SPAN",type=string,JSONPath=`.spec.span.port`,priority=0
+// +kubebuilder:printcolumn:name="ERSPAN",type=string,JSONPath=`.spec.erspan.destinationIP`,priority=0
+// +kubebuilder:printcolumn:name="Expires",type=date,JSONPath=`.spec.expiresAt`,priority=0
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,priority=0
+// MirrorSession is the port mirroring session on a switch used for troubleshooting. It copies traffic from the
+// source ports either to a local port (SPAN) or to a remote destination over GRE (ERSPAN). Sessions could be time-boxed
+// using the expiry time so they don't linger after troubleshooting is done.
+type MirrorSession struct {
+	kmetav1.TypeMeta   `json:",inline"`
+	kmetav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the MirrorSession
+	Spec MirrorSessionSpec `json:"spec,omitempty"`
+	// Status is the observed state of the MirrorSession
+	Status MirrorSessionStatus `json:"status,omitempty"`
+}
+
+const KindMirrorSession = "MirrorSession"
+
+//+kubebuilder:object:root=true
+
+// MirrorSessionList contains a list of MirrorSession
+type MirrorSessionList struct {
+	kmetav1.TypeMeta `json:",inline"`
+	kmetav1.ListMeta `json:"metadata,omitempty"`
+	Items            []MirrorSession `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(func(s *runtime.Scheme) error {
+		s.AddKnownTypes(GroupVersion, &MirrorSession{}, &MirrorSessionList{})
+
+		return nil
+	})
+}
+
+var (
+	_ meta.Object     = (*MirrorSession)(nil)
+	_ meta.ObjectList = (*MirrorSessionList)(nil)
+)
+
+func (msList *MirrorSessionList) GetItems() []meta.Object {
+	items := make([]meta.Object, len(msList.Items))
+	for i := range msList.Items {
+		items[i] = &msList.Items[i]
+	}
+
+	return items
+}
+
+// IsExpired returns true if the mirror session has the expiry time set and it's already passed
+func (msSpec *MirrorSessionSpec) IsExpired(now time.Time) bool {
+	return msSpec.ExpiresAt != nil && !now.Before(msSpec.ExpiresAt.Time)
+}
+
+func (msSpec *MirrorSessionSpec) Labels() map[string]string {
+	return map[string]string{
+		LabelSwitch: msSpec.Switch,
+	}
+}
+
+func (ms *MirrorSession) Default() {
+	meta.DefaultObjectMetadata(ms)
+
+	if ms.Labels == nil {
+		ms.Labels = map[string]string{}
+	}
+
+	CleanupFabricLabels(ms.Labels)
+
+	maps.Copy(ms.Labels, ms.Spec.Labels())
+
+	if ms.Spec.Direction == "" {
+		ms.Spec.Direction = MirrorDirectionBoth
+	}
+
+	if ms.Spec.ERSPAN != nil && ms.Spec.ERSPAN.TTL == 0 {
+		ms.Spec.ERSPAN.TTL = 64
+	}
+}
+
+func (ms *MirrorSession) Validate(ctx context.Context, kube kclient.Reader, _ *meta.FabricConfig) (admission.Warnings, error) {
+	if err := meta.ValidateObjectMetadata(ms); err != nil {
+		return nil, errors.Wrapf(err, "failed to validate metadata")
+	}
+
+	if ms.Spec.Switch == "" {
+		return nil, errors.Errorf("switch is required")
+	}
+
+	if len(ms.Spec.Sources) == 0 {
+		return nil, errors.Errorf("at least one source port is required")
+	}
+
+	for idx, source := range ms.Spec.Sources {
+		if source == "" {
+			return nil, errors.Errorf("source port %d is empty", idx)
+		}
+		if slices.Contains(ms.Spec.Sources[:idx], source) {
+			return nil, errors.Errorf("source port %s is duplicated", source)
+		}
+	}
+
+	if !slices.Contains(MirrorDirections, ms.Spec.Direction) {
+		return nil, errors.Errorf("invalid direction %q", ms.Spec.Direction)
+	}
+
+	if ms.Spec.SPAN == nil && ms.Spec.ERSPAN == nil || ms.Spec.SPAN != nil && ms.Spec.ERSPAN != nil {
+		return nil, errors.Errorf("exactly one of span or erspan destination should be set")
+	}
+
+	if ms.Spec.SPAN != nil {
+		if ms.Spec.SPAN.Port == "" {
+			return nil, errors.Errorf("span destination port is required")
+		}
+		if slices.Contains(ms.Spec.Sources, ms.Spec.SPAN.Port) {
+			return nil, errors.Errorf("span destination port %s can't be a source port", ms.Spec.SPAN.Port)
+		}
+	}
+
+	if ms.Spec.ERSPAN != nil {
+		ip, err := netip.ParseAddr(ms.Spec.ERSPAN.DestinationIP)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid erspan destination IP %q", ms.Spec.ERSPAN.DestinationIP)
+		}
+		if !ip.Is4() {
+			return nil, errors.Errorf("erspan destination IP %s should be IPv4", ip)
+		}
+		if ms.Spec.ERSPAN.DSCP > 63 {
+			return nil, errors.Errorf("erspan dscp should be in [0, 63] range")
+		}
+		if ms.Spec.ERSPAN.TTL == 0 {
+			return nil, errors.Errorf("erspan ttl should be greater than 0")
+		}
+	}
+
+	warns := admission.Warnings{}
+	if ms.Spec.IsExpired(time.Now()) {
+		warns = append(warns, "mirror session is already expired and will not be configured on the switch")
+	}
+
+	if kube != nil {
+		sw := &Switch{}
+		if err := kube.Get(ctx, ktypes.NamespacedName{Name: ms.Spec.Switch, Namespace: ms.Namespace}, sw); err != nil {
+			if kapierrors.IsNotFound(err) {
+				return warns, errors.Errorf("switch %s not found", ms.Spec.Switch)
+			}
+
+			return warns, errors.Wrapf(err, "failed to get switch %s", ms.Spec.Switch) // TODO replace with some internal error to not expose to the user
+		}
+
+		sp := &SwitchProfile{}
+		if err := kube.Get(ctx, ktypes.NamespacedName{Name: sw.Spec.Profile, Namespace: ms.Namespace}, sp); err != nil {
+			if kapierrors.IsNotFound(err) {
+				return warns, errors.Errorf("switch profile %s not found", sw.Spec.Profile)
+			}
+
+			return warns, errors.Wrapf(err, "failed to get switch profile %s", sw.Spec.Profile) // TODO replace with some internal error to not expose to the user
+		}
+
+		if err := ms.validatePorts(&sp.Spec, &sw.Spec); err != nil {
+			return warns, err
+		}
+
+		others := &MirrorSessionList{}
+		if err := kube.List(ctx, others, kclient.InNamespace(ms.Namespace), kclient.MatchingLabels{
+			LabelSwitch: ms.Spec.Switch,
+		}); err != nil {
+			return warns, errors.Wrapf(err, "failed to list mirror sessions for switch %s", ms.Spec.Switch) // TODO replace with some internal error to not expose to the user
+		}
+
+		now := time.Now()
+		for _, other := range others.Items {
+			if other.Name == ms.Name || other.Spec.IsExpired(now) {
+				continue
+			}
+
+			if ms.Spec.SPAN != nil {
+				if slices.Contains(other.Spec.Sources, ms.Spec.SPAN.Port) || other.Spec.SPAN != nil && other.Spec.SPAN.Port == ms.Spec.SPAN.Port {
+					return warns, errors.Errorf("span destination port %s is used by mirror session %s", ms.Spec.SPAN.Port, other.Name)
+				}
+			}
+			if other.Spec.SPAN != nil && slices.Contains(ms.Spec.Sources, other.Spec.SPAN.Port) {
+				return warns, errors.Errorf("source port %s is used as span destination by mirror session %s", other.Spec.SPAN.Port, other.Name)
+			}
+		}
+
+		if ms.Spec.SPAN != nil {
+			conns := &ConnectionList{}
+			if err := kube.List(ctx, conns, kclient.InNamespace(ms.Namespace), kclient.MatchingLabels{
+				ListLabelSwitch(ms.Spec.Switch): ListLabelValue,
+			}); err != nil {
+				return warns, errors.Wrapf(err, "failed to list connections for switch %s", ms.Spec.Switch) // TODO replace with some internal error to not expose to the user
+			}
+
+			dstPort := ms.Spec.Switch + PortNameSeparator + ms.Spec.SPAN.Port
+			for _, conn := range conns.Items {
+				_, _, ports, _, err := conn.Spec.Endpoints()
+				if err != nil {
+					return warns, errors.Wrapf(err, "failed to get endpoints for connection %s", conn.Name)
+				}
+				if slices.Contains(ports, dstPort) {
+					return warns, errors.Errorf("span destination port %s is used by connection %s", ms.Spec.SPAN.Port, conn.Name)
+				}
+			}
+		}
+	}
+
+	return warns, nil
+}
+
+// validatePorts checks that the source and span destination ports are available on the switch, ports should be
+// specified using the same names as in connections (e.g. "E1/55/1" for the broken out port)
+func (ms *MirrorSession) validatePorts(sp *SwitchProfileSpec, sw *SwitchSpec) error {
+	available, err := sp.GetAvailableAPIPorts(sw)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get available ports for switch %s", ms.Spec.Switch)
+	}
+
+	ports := slices.Clone(ms.Spec.Sources)
+	if ms.Spec.SPAN != nil {
+		ports = append(ports, ms.Spec.SPAN.Port)
+	}
+
+	for _, port := range ports {
+		if available[port] {
+			continue
+		}
+
+		normalized, err := sp.NormalizePortName(port)
+		if err != nil {
+			return errors.Wrapf(err, "invalid port %s for switch %s", port, ms.Spec.Switch)
+		}
+		if normalized != port && available[normalized] {
+			return errors.Errorf("port %s should be specified as %s for switch %s", port, normalized, ms.Spec.Switch)
+		}
+
+		return errors.Errorf("port %s is not available for mirroring on switch %s", port, ms.Spec.Switch)
+	}
+
+	return nil
+}
@@ -0,0 +1,268 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func mirrorSessionGen(f ...func(session *wiringapi.MirrorSession)) *wiringapi.MirrorSession {
+	session := withName("mirror-01", &wiringapi.MirrorSession{
+		Spec: wiringapi.MirrorSessionSpec{
+			Switch:  "leaf-01",
+			Sources: []string{"E1/1", "E1/2"},
+			SPAN:    &wiringapi.MirrorSPAN{Port: "E1/10"},
+		},
+	})
+
+	for _, fn := range f {
+		fn(session)
+	}
+
+	session.Default()
+
+	return session
+}
+
+func TestMirrorSessionValidate(t *testing.T) {
+	sw := withName("leaf-01", &wiringapi.Switch{
+		Spec: wiringapi.SwitchSpec{Profile: "test-profile"},
+	})
+	sp := withName("test-profile", &wiringapi.SwitchProfile{
+		Spec: wiringapi.SwitchProfileSpec{
+			Ports: map[string]wiringapi.SwitchProfilePort{
+				"M1":    {NOSName: "Management0", Management: true},
+				"E1/1":  {NOSName: "Ethernet0", Group: "1"},
+				"E1/2":  {NOSName: "Ethernet1", Group: "1"},
+				"E1/3":  {NOSName: "Ethernet2", Group: "1"},
+				"E1/10": {NOSName: "Ethernet9", Group: "1"},
+				"E1/11": {NOSName: "Ethernet10", Group: "1"},
+				"E1/55": {NOSName: "1/55", BaseNOSName: "Ethernet54", Profile: "breakout"},
+			},
+			PortProfiles: map[string]wiringapi.SwitchProfilePortProfile{
+				"breakout": {
+					Breakout: &wiringapi.SwitchProfilePortProfileBreakout{
+						Default: "4x25G",
+						Supported: map[string]wiringapi.SwitchProfilePortProfileBreakoutMode{
+							"4x25G": {Offsets: []string{"0", "1", "2", "3"}},
+						},
+					},
+				},
+			},
+		},
+	})
+	other := mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+		session.Name = "mirror-02"
+		session.Spec.Sources = []string{"E1/3"}
+		session.Spec.SPAN.Port = "E1/11"
+	})
+	conn := withName("server-01--unbundled--leaf-01", &wiringapi.Connection{
+		Spec: wiringapi.ConnectionSpec{
+			Unbundled: &wiringapi.ConnUnbundled{
+				Link: wiringapi.ServerToSwitchLink{
+					Server: wiringapi.BasePortName{Port: "server-01/enp2s1"},
+					Switch: wiringapi.BasePortName{Port: "leaf-01/E1/10"},
+				},
+			},
+		},
+	})
+	conn.Default()
+
+	for _, tt := range []struct {
+		name    string
+		session *wiringapi.MirrorSession
+		objects []kclient.Object
+		warns   bool
+		err     bool
+	}{
+		{
+			name:    "span",
+			session: mirrorSessionGen(),
+		},
+		{
+			name: "erspan",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.SPAN = nil
+				session.Spec.ERSPAN = &wiringapi.MirrorERSPAN{DestinationIP: "10.0.0.1", VPC: "vpc-01"}
+			}),
+		},
+		{
+			name: "no-switch",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.Switch = ""
+			}),
+			err: true,
+		},
+		{
+			name: "no-sources",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.Sources = nil
+			}),
+			err: true,
+		},
+		{
+			name: "duplicate-source",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.Sources = []string{"E1/1", "E1/1"}
+			}),
+			err: true,
+		},
+		{
+			name: "invalid-direction",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.Direction = "in"
+			}),
+			err: true,
+		},
+		{
+			name: "no-destination",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.SPAN = nil
+			}),
+			err: true,
+		},
+		{
+			name: "both-destinations",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.ERSPAN = &wiringapi.MirrorERSPAN{DestinationIP: "10.0.0.1"}
+			}),
+			err: true,
+		},
+		{
+			name: "span-destination-is-source",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.SPAN.Port = "E1/1"
+			}),
+			err: true,
+		},
+		{
+			name: "erspan-invalid-ip",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.SPAN = nil
+				session.Spec.ERSPAN = &wiringapi.MirrorERSPAN{DestinationIP: "fd00::1"}
+			}),
+			err: true,
+		},
+		{
+			name: "expired",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.ExpiresAt = &kmetav1.Time{Time: time.Now().Add(-time.Hour)}
+			}),
+			warns: true,
+		},
+		{
+			name:    "switch-exists",
+			session: mirrorSessionGen(),
+			objects: []kclient.Object{sw, sp},
+		},
+		{
+			name: "breakout-port",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.Sources = []string{"E1/55/2"}
+			}),
+			objects: []kclient.Object{sw, sp},
+		},
+		{
+			name: "breakout-port-not-normalized",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.Sources = []string{"E1/55"}
+			}),
+			objects: []kclient.Object{sw, sp},
+			err:     true,
+		},
+		{
+			name: "source-port-not-in-profile",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.Sources = []string{"E1/42"}
+			}),
+			objects: []kclient.Object{sw, sp},
+			err:     true,
+		},
+		{
+			name: "span-destination-management-port",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.SPAN.Port = "M1"
+			}),
+			objects: []kclient.Object{sw, sp},
+			err:     true,
+		},
+		{
+			name:    "switch-profile-not-found",
+			session: mirrorSessionGen(),
+			objects: []kclient.Object{sw},
+			err:     true,
+		},
+		{
+			name:    "other-session-on-other-ports",
+			session: mirrorSessionGen(),
+			objects: []kclient.Object{sw, sp, other},
+		},
+		{
+			name: "span-destination-is-other-session-source",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.SPAN.Port = "E1/3"
+			}),
+			objects: []kclient.Object{sw, sp, other},
+			err:     true,
+		},
+		{
+			name: "span-destination-is-other-session-destination",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.SPAN.Port = "E1/11"
+			}),
+			objects: []kclient.Object{sw, sp, other},
+			err:     true,
+		},
+		{
+			name: "source-is-other-session-span-destination",
+			session: mirrorSessionGen(func(session *wiringapi.MirrorSession) {
+				session.Spec.Sources = []string{"E1/1", "E1/11"}
+			}),
+			objects: []kclient.Object{sw, sp, other},
+			err:     true,
+		},
+		{
+			name:    "switch-not-found",
+			session: mirrorSessionGen(),
+			objects: []kclient.Object{},
+			err:     true,
+		},
+		{
+			name:    "span-destination-used-by-connection",
+			session: mirrorSessionGen(),
+			objects: []kclient.Object{sw, sp, conn},
+			err:     true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, wiringapi.AddToScheme(scheme))
+
+			var kube kclient.Reader
+			if tt.objects != nil {
+				kube = fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(tt.objects...).
+					Build()
+			}
+
+			warns, err := tt.session.Validate(t.Context(), kube, nil)
+			if tt.err {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.warns, len(warns) > 0)
+		})
+	}
+}
@@ -35,14 +35,53 @@ type ServerSpec struct {
 	Profile string `json:"profile,omitempty"`
 }
 
-// ServerStatus defines the observed state of Server
-type ServerStatus struct{}
+// ServerStatus defines the observed state of Server, it's discovered from the LLDP neighbors reported by the switches
+// on the ports the server is connected to and from the DHCP leases allocated to the observed MAC addresses
+type ServerStatus struct {
+	// SystemName is the LLDP system name advertised by the server
+	SystemName string `json:"systemName,omitempty"`
+	// MACs is the list of the server MAC addresses observed via LLDP
+	MACs []string `json:"macs,omitempty"`
+	// IPs is the list of the IP addresses allocated by the fabric DHCP server to the observed MAC addresses
+	IPs []ServerStatusIP `json:"ips,omitempty"`
+	// Ports is the list of the LLDP neighbors observed on the switch ports the server is connected to
+	Ports []ServerStatusPort `json:"ports,omitempty"`
+}
+
+// ServerStatusPort is the LLDP neighbor observed on the switch port the server is connected to
+type ServerStatusPort struct {
+	// Switch is the full name of the switch port the neighbor is observed on, e.g. leaf-01/E1/1
+	Switch string `json:"switch,omitempty"`
+	// Connection is the name of the connection the switch port belongs to
+	Connection string `json:"connection,omitempty"`
+	// SystemName is the LLDP system name advertised by the neighbor
+	SystemName string `json:"systemName,omitempty"`
+	// Port is the neighbor port name, it's the LLDP port description if available or the port ID otherwise
+	Port string `json:"port,omitempty"`
+	// MAC is the neighbor port MAC address if the LLDP port ID or chassis ID is a MAC address
+	MAC string `json:"mac,omitempty"`
+}
+
+// ServerStatusIP is the IP address allocated by the fabric DHCP server to the server MAC address
+type ServerStatusIP struct {
+	// IP is the allocated IP address
+	IP string `json:"ip,omitempty"`
+	// MAC is the MAC address the IP address is allocated to
+	MAC string `json:"mac,omitempty"`
+	// Subnet is the full VPC subnet name the IP address is allocated from, such as "vpc-0/default"
+	Subnet string `json:"subnet,omitempty"`
+	// Hostname is the hostname from the DHCP request
+	Hostname string `json:"hostname,omitempty"`
+}
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:categories=hedgehog;wiring;fabric,shortName=srv
 // +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`,priority=0
 // +kubebuilder:printcolumn:name="Descr",type=string,JSONPath=`.spec.description`,priority=0
+// +kubebuilder:printcolumn:name="SysName",type=string,JSONPath=`.status.systemName`,priority=0
+// +kubebuilder:printcolumn:name="IPs",type=string,JSONPath=`.status.ips[*].ip`,priority=0
+// +kubebuilder:printcolumn:name="MACs",type=string,JSONPath=`.status.macs`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,priority=0
 // Server is the Schema for the servers API
 type Server struct {
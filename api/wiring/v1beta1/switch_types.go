@@ -135,22 +135,53 @@ type SwitchSpec struct {
 	PortFECs map[string]PortFECMode `json:"portFECs,omitempty"`
 	// Boot is the boot/provisioning information of the switch
 	Boot SwitchBoot `json:"boot,omitempty"`
-	// EnableAllPorts is a flag to enable all ports on the switch regardless of them being used or not
-	EnableAllPorts bool `json:"enableAllPorts,omitempty"`
-	// RoCE is a flag to enable RoCEv2 support on the switch which includes lossless queues and QoS configuration
-	RoCE bool `json:"roce,omitempty"`
+
+	// NOTE: breaking change for the Go API consumers, EnableAllPorts, RoCE and ECMP.RoCEQPN used to be bool and are
+	// now *bool so the switch could override the switch group default with false, use IsAllPortsEnabled, IsRoCEEnabled
+	// and ECMP.IsRoCEQPNEnabled to read them
+
+	// EnableAllPorts is a flag to enable all ports on the switch regardless of them being used or not, switch group
+	// default is used if unset
+	EnableAllPorts *bool `json:"enableAllPorts,omitempty"`
+	// RoCE is a flag to enable RoCEv2 support on the switch which includes lossless queues and QoS configuration,
+	// switch group default is used if unset
+	RoCE *bool `json:"roce,omitempty"`
 	// ECMP is the ECMP configuration for the switch
 	ECMP SwitchECMP `json:"ecmp,omitempty"`
 	// LinkFlapErrDisable, if set, enables link-flap errdisable protection on all fabric-facing ports.
 	// When a port exceeds FlapThreshold link-down events within SamplingInterval seconds it is
 	// disabled; RecoveryInterval controls how long before it is automatically re-enabled (0 = never).
 	LinkFlapErrDisable *SwitchLinkFlapErrDisable `json:"linkFlapErrDisable,omitempty"`
+	// StormControl is the default storm control configuration for the server-facing ports of the switch, could be
+	// overridden per connection
+	StormControl *StormControl `json:"stormControl,omitempty"`
+	// SFlow is the sFlow configuration for the switch, overrides the fabric-wide sFlow configuration
+	SFlow *SwitchSFlow `json:"sflow,omitempty"`
+	// System is the NTP, DNS and timezone configuration for the switch, fields that are set override the fabric-wide
+	// system configuration
+	System *meta.SystemConfig `json:"system,omitempty"`
 }
 
 // SwitchECMP is a struct that defines the ECMP configuration for the switch
 type SwitchECMP struct {
-	// RoCEQPN is a flag to enable RoCE QPN hashing
-	RoCEQPN bool `json:"roceQPN,omitempty"`
+	// RoCEQPN is a flag to enable RoCE QPN hashing, switch group default is used if unset
+	RoCEQPN *bool `json:"roceQPN,omitempty"`
+}
+
+// IsRoCEQPNEnabled returns true if RoCE QPN hashing is explicitly enabled
+func (ecmp *SwitchECMP) IsRoCEQPNEnabled() bool {
+	return ecmp.RoCEQPN != nil && *ecmp.RoCEQPN
+}
+
+// SwitchSFlow defines the per-switch sFlow configuration, fields that are set override the fabric-wide sFlow
+// configuration
+type SwitchSFlow struct {
+	// Disabled disables sFlow on the switch even if it's enabled fabric-wide
+	Disabled bool `json:"disabled,omitempty"`
+	// Collector, VRF, SamplingRate and PollingInterval override the fabric-wide values if set
+	meta.ObservabilitySFlow `json:",inline"`
+	// Ports is the list of the switch ports to enable sFlow sampling on, such as "E1/1", all ports are sampled if empty
+	Ports []string `json:"ports,omitempty"`
 }
 
 // SwitchLinkFlapErrDisable configures link-flap errdisable on fabric-facing ports.
@@ -177,10 +208,33 @@ type SwitchLinkFlapErrDisable struct {
 	RecoveryInterval *uint32 `json:"recoveryInterval,omitempty"`
 }
 
-// SwitchStatus defines the observed state of Switch
+// SwitchStatus defines the observed state of Switch, it's mirrored from the status reported by the switch agent
 type SwitchStatus struct {
-	// Applied ApplyStatus `json:"applied,omitempty"`
-	// TODO: add port status fields
+	// LastHeartbeat is the time of the last heartbeat from the switch agent
+	LastHeartbeat kmetav1.Time `json:"lastHeartbeat,omitempty"`
+	// LastAppliedTime is the time of the last successful configuration application
+	LastAppliedTime kmetav1.Time `json:"lastAppliedTime,omitempty"`
+	// AppliedGen is the generation of the last successfully applied switch configuration
+	AppliedGen int64 `json:"appliedGen,omitempty"`
+	// DesiredGen is the generation of the switch configuration that should be applied, the switch is up to date if
+	// it's equal to the AppliedGen
+	DesiredGen int64 `json:"desiredGen,omitempty"`
+	// NOSVersion is the software version of the NOS running on the switch
+	NOSVersion string `json:"nosVersion,omitempty"`
+	// RebootRequired indicates whether the switch needs to be rebooted to apply the configuration
+	RebootRequired bool `json:"rebootRequired,omitempty"`
+	// Ports is the summary of the switch data ports operational state
+	Ports SwitchStatusPorts `json:"ports,omitempty"`
+}
+
+// SwitchStatusPorts is the summary of the switch data ports operational state, admin disabled ports aren't counted
+type SwitchStatusPorts struct {
+	// Up is the number of ports that are operationally up
+	Up int `json:"up,omitempty"`
+	// Down is the number of admin enabled ports that are operationally down
+	Down int `json:"down,omitempty"`
+	// ErrDisabled is the number of ports disabled due to an error (e.g. link flaps)
+	ErrDisabled int `json:"errDisabled,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -192,6 +246,15 @@ type SwitchStatus struct {
 // +kubebuilder:printcolumn:name="Groups",type=string,JSONPath=`.spec.groups`,priority=0
 // +kubebuilder:printcolumn:name="Redundancy",type=string,JSONPath=`.spec.redundancy`,priority=1
 // +kubebuilder:printcolumn:name="Boot",type=string,JSONPath=`.spec.boot`,priority=1
+// +kubebuilder:printcolumn:name="Heartbeat",type=date,JSONPath=`.status.lastHeartbeat`,priority=0
+// +kubebuilder:printcolumn:name="AppliedG",type=integer,JSONPath=`.status.appliedGen`,priority=0
+// +kubebuilder:printcolumn:name="DesiredG",type=integer,JSONPath=`.status.desiredGen`,priority=0
+// +kubebuilder:printcolumn:name="RebootReq",type=string,JSONPath=`.status.rebootRequired`,priority=0
+// +kubebuilder:printcolumn:name="Up",type=integer,JSONPath=`.status.ports.up`,priority=0
+// +kubebuilder:printcolumn:name="Down",type=integer,JSONPath=`.status.ports.down`,priority=0
+// +kubebuilder:printcolumn:name="ErrDis",type=integer,JSONPath=`.status.ports.errDisabled`,priority=0
+// +kubebuilder:printcolumn:name="Applied",type=date,JSONPath=`.status.lastAppliedTime`,priority=1
+// +kubebuilder:printcolumn:name="NOS",type=string,JSONPath=`.status.nosVersion`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`,priority=0
 // Switch is the Schema for the switches API
 type Switch struct {
@@ -274,6 +337,8 @@ func (sw *Switch) Default() {
 		}
 	}
 
+	sw.Spec.StormControl.Default()
+
 	for _, group := range sw.Spec.Groups {
 		sw.Labels[ListLabelSwitchGroup(group)] = ListLabelValue
 	}
@@ -444,6 +509,111 @@ func (sw *Switch) HydrationValidation(ctx context.Context, kube kclient.Reader,
 	return nil
 }
 
+// IsAllPortsEnabled returns true if enabling all ports is explicitly requested
+func (swSpec *SwitchSpec) IsAllPortsEnabled() bool {
+	return swSpec.EnableAllPorts != nil && *swSpec.EnableAllPorts
+}
+
+// IsRoCEEnabled returns true if RoCEv2 is explicitly enabled
+func (swSpec *SwitchSpec) IsRoCEEnabled() bool {
+	return swSpec.RoCE != nil && *swSpec.RoCE
+}
+
+// ApplyGroupDefaults merges the defaults from the switch groups into the switch spec. Values explicitly set on the
+// switch (including flags set to false) take precedence, if multiple groups set the same value the first group in the
+// list wins.
+func (swSpec *SwitchSpec) ApplyGroupDefaults(groups []SwitchGroup) {
+	for _, group := range groups {
+		for name, speed := range group.Spec.PortSpeeds {
+			if _, exists := swSpec.PortSpeeds[name]; exists {
+				continue
+			}
+			if swSpec.PortSpeeds == nil {
+				swSpec.PortSpeeds = map[string]string{}
+			}
+			swSpec.PortSpeeds[name] = speed
+		}
+
+		for name, breakout := range group.Spec.PortBreakouts {
+			if _, exists := swSpec.PortBreakouts[name]; exists {
+				continue
+			}
+			if swSpec.PortBreakouts == nil {
+				swSpec.PortBreakouts = map[string]string{}
+			}
+			swSpec.PortBreakouts[name] = breakout
+		}
+
+		if swSpec.EnableAllPorts == nil && group.Spec.EnableAllPorts != nil {
+			swSpec.EnableAllPorts = new(*group.Spec.EnableAllPorts)
+		}
+		if swSpec.RoCE == nil && group.Spec.RoCE != nil {
+			swSpec.RoCE = new(*group.Spec.RoCE)
+		}
+		if swSpec.ECMP.RoCEQPN == nil && group.Spec.ECMP.RoCEQPN != nil {
+			swSpec.ECMP.RoCEQPN = new(*group.Spec.ECMP.RoCEQPN)
+		}
+
+		if swSpec.LinkFlapErrDisable == nil && group.Spec.LinkFlapErrDisable != nil {
+			swSpec.LinkFlapErrDisable = group.Spec.LinkFlapErrDisable.DeepCopy()
+		}
+	}
+}
+
+// EffectiveSystem returns the system configuration for the switch with the switch overrides applied on top of the
+// fabric-wide configuration, NTP servers, DNS servers and search domain are overridden as a whole if set
+func (swSpec *SwitchSpec) EffectiveSystem(fabric meta.SystemConfig) meta.SystemConfig {
+	cfg := *fabric.DeepCopy()
+	if swSpec.System != nil {
+		if len(swSpec.System.NTP.Servers) > 0 {
+			cfg.NTP.Servers = slices.Clone(swSpec.System.NTP.Servers)
+		}
+		if swSpec.System.NTP.VRF != "" {
+			cfg.NTP.VRF = swSpec.System.NTP.VRF
+		}
+		if len(swSpec.System.DNS.Servers) > 0 {
+			cfg.DNS.Servers = slices.Clone(swSpec.System.DNS.Servers)
+		}
+		if swSpec.System.DNS.SearchDomain != "" {
+			cfg.DNS.SearchDomain = swSpec.System.DNS.SearchDomain
+		}
+		if swSpec.System.Timezone != "" {
+			cfg.Timezone = swSpec.System.Timezone
+		}
+	}
+
+	return cfg
+}
+
+// EffectiveSFlow returns the sFlow configuration for the switch with the switch overrides applied on top of the
+// fabric-wide configuration, nil is returned if sFlow isn't enabled on the switch
+func (swSpec *SwitchSpec) EffectiveSFlow(fabric meta.ObservabilitySFlow) *meta.ObservabilitySFlow {
+	cfg := fabric
+	if swSpec.SFlow != nil {
+		if swSpec.SFlow.Disabled {
+			return nil
+		}
+		if swSpec.SFlow.Collector != "" {
+			cfg.Collector = swSpec.SFlow.Collector
+		}
+		if swSpec.SFlow.VRF != "" {
+			cfg.VRF = swSpec.SFlow.VRF
+		}
+		if swSpec.SFlow.SamplingRate != 0 {
+			cfg.SamplingRate = swSpec.SFlow.SamplingRate
+		}
+		if swSpec.SFlow.PollingInterval != 0 {
+			cfg.PollingInterval = swSpec.SFlow.PollingInterval
+		}
+	}
+
+	if !cfg.IsEnabled() {
+		return nil
+	}
+
+	return &cfg
+}
+
 func (sw *Switch) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *meta.FabricConfig) (admission.Warnings, error) {
 	var warnings admission.Warnings
 
@@ -496,6 +666,32 @@ func (sw *Switch) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *
 		}
 	}
 
+	if err := sw.Spec.StormControl.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := sw.Spec.System.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid system config")
+	}
+
+	if sw.Spec.SFlow != nil {
+		if err := sw.Spec.SFlow.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid sflow config")
+		}
+
+		for idx, port := range sw.Spec.SFlow.Ports {
+			if port == "" {
+				return nil, errors.Errorf("sflow port %d is empty", idx)
+			}
+			if strings.HasPrefix(port, ManagementPortPrefix) {
+				return nil, errors.Errorf("sflow can't be enabled on management port %s", port)
+			}
+			if slices.Contains(sw.Spec.SFlow.Ports[:idx], port) {
+				return nil, errors.Errorf("sflow port %s is duplicated", port)
+			}
+		}
+	}
+
 	if kube != nil {
 		namespaces := &VLANNamespaceList{}
 		err := kube.List(ctx, namespaces)
@@ -524,6 +720,7 @@ func (sw *Switch) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *
 			return nil, errors.Wrapf(err, "invalid VLANNamespaces")
 		}
 
+		groups := []SwitchGroup{}
 		for _, group := range sw.Spec.Groups {
 			if group == "" {
 				return nil, errors.Errorf("group name cannot be empty")
@@ -538,8 +735,14 @@ func (sw *Switch) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *
 
 				return nil, errors.Wrapf(err, "failed to get switch group %s", group) // TODO replace with some internal error to not expose to the user
 			}
+
+			groups = append(groups, *sg)
 		}
 
+		// validate the effective switch spec with the group defaults applied as it will be used by the agent
+		spec := sw.Spec.DeepCopy()
+		spec.ApplyGroupDefaults(groups)
+
 		sp := &SwitchProfile{}
 		err = kube.Get(ctx, ktypes.NamespacedName{Name: sw.Spec.Profile, Namespace: sw.Namespace}, sp)
 		if err != nil {
@@ -570,7 +773,7 @@ func (sw *Switch) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *
 			}
 		}
 
-		for name, speed := range sw.Spec.PortSpeeds {
+		for name, speed := range spec.PortSpeeds {
 			port, exists := sp.Spec.Ports[name]
 			if !exists {
 				return nil, errors.Errorf("port %s not found in switch profile", name)
@@ -590,7 +793,7 @@ func (sw *Switch) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *
 			}
 		}
 
-		for name, breakout := range sw.Spec.PortBreakouts {
+		for name, breakout := range spec.PortBreakouts {
 			port, exists := sp.Spec.Ports[name]
 			if !exists {
 				return nil, errors.Errorf("port %s not found in switch profile", name)
@@ -610,7 +813,7 @@ func (sw *Switch) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *
 			}
 		}
 
-		autoNegAllowed, _, err := sp.Spec.GetAutoNegsDefaultsFor(&sw.Spec)
+		autoNegAllowed, _, err := sp.Spec.GetAutoNegsDefaultsFor(spec)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to get auto negotiation defaults")
 		}
@@ -621,7 +824,7 @@ func (sw *Switch) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *
 			}
 		}
 
-		fecPorts, err := sp.Spec.GetFECConfigurablePorts(&sw.Spec)
+		fecPorts, err := sp.Spec.GetFECConfigurablePorts(spec)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to get FEC-configurable ports")
 		}
@@ -633,14 +836,33 @@ func (sw *Switch) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *
 			}
 		}
 
-		if sw.Spec.RoCE && !sp.Spec.Features.RoCE {
+		if spec.IsRoCEEnabled() && !sp.Spec.Features.RoCE {
 			return nil, errors.Errorf("RoCEv2 is not supported on switch profile %s", sw.Spec.Profile)
 		}
 
-		if sw.Spec.ECMP.RoCEQPN && !sp.Spec.Features.ECMPRoCEQPN {
+		if spec.ECMP.IsRoCEQPNEnabled() && !sp.Spec.Features.ECMPRoCEQPN {
 			return nil, errors.Errorf("ECMP RoCE QPN hashing is not supported on switch profile %s", sw.Spec.Profile)
 		}
 
+		if sw.Spec.SFlow != nil && !sw.Spec.SFlow.Disabled {
+			if !sp.Spec.Features.SFlow {
+				return nil, errors.Errorf("sFlow is not supported on switch profile %s", sw.Spec.Profile)
+			}
+
+			if len(sw.Spec.SFlow.Ports) > 0 {
+				ports, err := sp.Spec.GetAPI2NOSPortsFor(spec)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to get switch ports")
+				}
+
+				for _, port := range sw.Spec.SFlow.Ports {
+					if _, exists := ports[port]; !exists {
+						return nil, errors.Errorf("sflow port %s not found in switch profile", port)
+					}
+				}
+			}
+		}
+
 		switch sw.Spec.Redundancy.Type {
 		case meta.RedundancyTypeNone:
 			// No redundancy, nothing to check
@@ -672,8 +894,8 @@ func (sw *Switch) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *
 
 				if profile.Breakout != nil {
 					mode := profile.Breakout.Default
-					if sw.Spec.PortBreakouts != nil {
-						if override, ok := sw.Spec.PortBreakouts[name]; ok {
+					if spec.PortBreakouts != nil {
+						if override, ok := spec.PortBreakouts[name]; ok {
 							mode = override
 						}
 					}
@@ -348,3 +348,218 @@ func TestHydrationValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyGroupDefaults(t *testing.T) {
+	linkFlap := func(threshold uint8) *wiringapi.SwitchLinkFlapErrDisable {
+		return &wiringapi.SwitchLinkFlapErrDisable{
+			FlapThreshold:    &threshold,
+			SamplingInterval: new(uint32(30)),
+			RecoveryInterval: new(uint32(300)),
+		}
+	}
+
+	groups := []wiringapi.SwitchGroup{
+		{
+			ObjectMeta: kmetav1.ObjectMeta{Name: "group-1"},
+			Spec: wiringapi.SwitchGroupSpec{
+				PortSpeeds:         map[string]string{"E1/1": "10G", "E1/2": "10G"},
+				PortBreakouts:      map[string]string{"E1/55": "4x25G"},
+				RoCE:               new(true),
+				LinkFlapErrDisable: linkFlap(3),
+			},
+		},
+		{
+			ObjectMeta: kmetav1.ObjectMeta{Name: "group-2"},
+			Spec: wiringapi.SwitchGroupSpec{
+				PortSpeeds:         map[string]string{"E1/2": "25G", "E1/3": "25G"},
+				EnableAllPorts:     new(true),
+				ECMP:               wiringapi.SwitchECMP{RoCEQPN: new(true)},
+				LinkFlapErrDisable: linkFlap(5),
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		spec     wiringapi.SwitchSpec
+		groups   []wiringapi.SwitchGroup
+		expected wiringapi.SwitchSpec
+	}{
+		{
+			name: "no-groups",
+			spec: wiringapi.SwitchSpec{
+				PortSpeeds: map[string]string{"E1/1": "1G"},
+			},
+			expected: wiringapi.SwitchSpec{
+				PortSpeeds: map[string]string{"E1/1": "1G"},
+			},
+		},
+		{
+			name:   "empty-switch",
+			groups: groups,
+			expected: wiringapi.SwitchSpec{
+				PortSpeeds:         map[string]string{"E1/1": "10G", "E1/2": "10G", "E1/3": "25G"},
+				PortBreakouts:      map[string]string{"E1/55": "4x25G"},
+				EnableAllPorts:     new(true),
+				RoCE:               new(true),
+				ECMP:               wiringapi.SwitchECMP{RoCEQPN: new(true)},
+				LinkFlapErrDisable: linkFlap(3),
+			},
+		},
+		{
+			name: "switch-overrides",
+			spec: wiringapi.SwitchSpec{
+				PortSpeeds:         map[string]string{"E1/1": "1G"},
+				PortBreakouts:      map[string]string{"E1/55": "1x100G"},
+				LinkFlapErrDisable: linkFlap(10),
+			},
+			groups: groups[1:],
+			expected: wiringapi.SwitchSpec{
+				PortSpeeds:         map[string]string{"E1/1": "1G", "E1/2": "25G", "E1/3": "25G"},
+				PortBreakouts:      map[string]string{"E1/55": "1x100G"},
+				EnableAllPorts:     new(true),
+				ECMP:               wiringapi.SwitchECMP{RoCEQPN: new(true)},
+				LinkFlapErrDisable: linkFlap(10),
+			},
+		},
+		{
+			name: "switch-overrides-to-false",
+			spec: wiringapi.SwitchSpec{
+				EnableAllPorts: new(false),
+				RoCE:           new(false),
+				ECMP:           wiringapi.SwitchECMP{RoCEQPN: new(false)},
+			},
+			groups: groups,
+			expected: wiringapi.SwitchSpec{
+				PortSpeeds:         map[string]string{"E1/1": "10G", "E1/2": "10G", "E1/3": "25G"},
+				PortBreakouts:      map[string]string{"E1/55": "4x25G"},
+				EnableAllPorts:     new(false),
+				RoCE:               new(false),
+				ECMP:               wiringapi.SwitchECMP{RoCEQPN: new(false)},
+				LinkFlapErrDisable: linkFlap(3),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := tt.spec.DeepCopy()
+			spec.ApplyGroupDefaults(tt.groups)
+			require.Equal(t, tt.expected, *spec)
+		})
+	}
+}
+
+func TestEffectiveSFlow(t *testing.T) {
+	fabric := meta.ObservabilitySFlow{
+		Collector:    "10.0.0.1",
+		SamplingRate: 4096,
+	}
+
+	for _, tt := range []struct {
+		name     string
+		fabric   meta.ObservabilitySFlow
+		sflow    *wiringapi.SwitchSFlow
+		expected *meta.ObservabilitySFlow
+	}{
+		{
+			name: "not-configured",
+		},
+		{
+			name:     "fabric-wide",
+			fabric:   fabric,
+			expected: &fabric,
+		},
+		{
+			name:   "switch-disabled",
+			fabric: fabric,
+			sflow:  &wiringapi.SwitchSFlow{Disabled: true},
+		},
+		{
+			name:   "switch-overrides",
+			fabric: fabric,
+			sflow: &wiringapi.SwitchSFlow{
+				ObservabilitySFlow: meta.ObservabilitySFlow{
+					Collector:       "10.0.0.2:6000",
+					PollingInterval: 30,
+				},
+				Ports: []string{"E1/1"},
+			},
+			expected: &meta.ObservabilitySFlow{
+				Collector:       "10.0.0.2:6000",
+				SamplingRate:    4096,
+				PollingInterval: 30,
+			},
+		},
+		{
+			name: "switch-only",
+			sflow: &wiringapi.SwitchSFlow{
+				ObservabilitySFlow: meta.ObservabilitySFlow{Collector: "10.0.0.2", VRF: meta.ObservabilityVRFMgmt},
+			},
+			expected: &meta.ObservabilitySFlow{Collector: "10.0.0.2", VRF: meta.ObservabilityVRFMgmt},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := wiringapi.SwitchSpec{SFlow: tt.sflow}
+			require.Equal(t, tt.expected, spec.EffectiveSFlow(tt.fabric))
+		})
+	}
+}
+
+func TestEffectiveSystem(t *testing.T) {
+	fabric := meta.SystemConfig{
+		NTP: meta.NTPConfig{
+			Servers: []meta.NTPServer{{Address: "10.0.0.1", Prefer: true}, {Address: "10.0.0.2"}},
+			VRF:     meta.ObservabilityVRFMgmt,
+		},
+		DNS: meta.DNSConfig{
+			Servers:      []string{"10.0.0.53"},
+			SearchDomain: "example.com",
+		},
+		Timezone: "Europe/Berlin",
+	}
+
+	for _, tt := range []struct {
+		name     string
+		fabric   meta.SystemConfig
+		system   *meta.SystemConfig
+		expected meta.SystemConfig
+	}{
+		{
+			name: "not-configured",
+		},
+		{
+			name:     "fabric-wide",
+			fabric:   fabric,
+			expected: fabric,
+		},
+		{
+			name:   "switch-overrides",
+			fabric: fabric,
+			system: &meta.SystemConfig{
+				NTP:      meta.NTPConfig{Servers: []meta.NTPServer{{Address: "10.1.0.1"}}},
+				DNS:      meta.DNSConfig{SearchDomain: "site1.example.com"},
+				Timezone: "America/Los_Angeles",
+			},
+			expected: meta.SystemConfig{
+				NTP: meta.NTPConfig{
+					Servers: []meta.NTPServer{{Address: "10.1.0.1"}},
+					VRF:     meta.ObservabilityVRFMgmt,
+				},
+				DNS: meta.DNSConfig{
+					Servers:      []string{"10.0.0.53"},
+					SearchDomain: "site1.example.com",
+				},
+				Timezone: "America/Los_Angeles",
+			},
+		},
+		{
+			name:     "switch-only",
+			system:   &meta.SystemConfig{DNS: meta.DNSConfig{Servers: []string{"10.1.0.53"}}},
+			expected: meta.SystemConfig{DNS: meta.DNSConfig{Servers: []string{"10.1.0.53"}}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := wiringapi.SwitchSpec{System: tt.system}
+			require.Equal(t, tt.expected, spec.EffectiveSystem(tt.fabric))
+		})
+	}
+}
@@ -16,6 +16,7 @@ package v1beta1
 
 import (
 	"context"
+	"strings"
 
 	"github.com/pkg/errors"
 	"go.githedgehog.com/fabric/api/meta"
@@ -27,8 +28,23 @@ import (
 
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
-// SwitchGroupSpec defines the desired state of SwitchGroup
-type SwitchGroupSpec struct{}
+// SwitchGroupSpec defines the desired state of SwitchGroup. All fields are defaults applied to the member switches,
+// values explicitly set in the SwitchSpec take precedence over them.
+type SwitchGroupSpec struct {
+	// PortSpeeds is a map of default port speeds, key is the port name, value is the speed
+	PortSpeeds map[string]string `json:"portSpeeds,omitempty"`
+	// PortBreakouts is a map of default port breakouts, key is the port name, value is the breakout configuration, such as "1/55: 4x25G"
+	PortBreakouts map[string]string `json:"portBreakouts,omitempty"`
+	// EnableAllPorts is a flag to enable all ports on the member switches regardless of them being used or not
+	EnableAllPorts *bool `json:"enableAllPorts,omitempty"`
+	// RoCE is a flag to enable RoCEv2 support on the member switches
+	RoCE *bool `json:"roce,omitempty"`
+	// ECMP is the default ECMP configuration for the member switches
+	ECMP SwitchECMP `json:"ecmp,omitempty"`
+	// LinkFlapErrDisable is the default link-flap errdisable configuration for the member switches, used if the switch
+	// doesn't have its own
+	LinkFlapErrDisable *SwitchLinkFlapErrDisable `json:"linkFlapErrDisable,omitempty"`
+}
 
 // SwitchGroupStatus defines the observed state of SwitchGroup
 type SwitchGroupStatus struct{}
@@ -83,12 +99,85 @@ func (sgList *SwitchGroupList) GetItems() []meta.Object {
 
 func (sg *SwitchGroup) Default() {
 	meta.DefaultObjectMetadata(sg)
+
+	for name, value := range sg.Spec.PortSpeeds {
+		sg.Spec.PortSpeeds[name], _ = strings.CutPrefix(value, "SPEED_")
+	}
+
+	if sg.Spec.LinkFlapErrDisable != nil {
+		if sg.Spec.LinkFlapErrDisable.FlapThreshold == nil {
+			sg.Spec.LinkFlapErrDisable.FlapThreshold = new(uint8(DefaultLinkFlapThreshold))
+		}
+		if sg.Spec.LinkFlapErrDisable.SamplingInterval == nil {
+			sg.Spec.LinkFlapErrDisable.SamplingInterval = new(uint32(DefaultLinkFlapSamplingInterval))
+		}
+		if sg.Spec.LinkFlapErrDisable.RecoveryInterval == nil {
+			sg.Spec.LinkFlapErrDisable.RecoveryInterval = new(uint32(DefaultLinkFlapRecoveryInterval))
+		}
+	}
 }
 
-func (sg *SwitchGroup) Validate(_ context.Context, _ kclient.Reader, _ *meta.FabricConfig) (admission.Warnings, error) {
+func (sg *SwitchGroup) Validate(ctx context.Context, kube kclient.Reader, fabricCfg *meta.FabricConfig) (admission.Warnings, error) {
 	if err := meta.ValidateObjectMetadata(sg); err != nil {
 		return nil, errors.Wrapf(err, "failed to validate metadata")
 	}
 
+	for name, speed := range sg.Spec.PortSpeeds {
+		if speed == "" {
+			return nil, errors.Errorf("port %s speed is empty", name)
+		}
+	}
+
+	for name, breakout := range sg.Spec.PortBreakouts {
+		if breakout == "" {
+			return nil, errors.Errorf("port %s breakout is empty", name)
+		}
+	}
+
+	if sg.Spec.LinkFlapErrDisable != nil {
+		if sg.Spec.LinkFlapErrDisable.FlapThreshold == nil {
+			return nil, errors.Errorf("link-flap error-disable is enabled but no flap threshold provided")
+		}
+		if sg.Spec.LinkFlapErrDisable.SamplingInterval == nil {
+			return nil, errors.Errorf("link-flap error-disable is enabled but no sampling interval provided")
+		}
+		if sg.Spec.LinkFlapErrDisable.RecoveryInterval == nil {
+			return nil, errors.Errorf("link-flap error-disable is enabled but no recovery interval provided")
+		}
+	}
+
+	if kube != nil {
+		switches := &SwitchList{}
+		if err := kube.List(ctx, switches, kclient.InNamespace(sg.Namespace), kclient.MatchingLabels{
+			ListLabelSwitchGroup(sg.Name): ListLabelValue,
+		}); err != nil {
+			return nil, errors.Wrapf(err, "failed to list switches") // TODO replace with some internal error to not expose to the user
+		}
+
+		// validate the member switches with the updated group defaults applied as they will be used by the agent
+		groupKube := &switchGroupOverrideReader{Reader: kube, group: sg}
+		for _, sw := range switches.Items {
+			if _, err := sw.Validate(ctx, groupKube, fabricCfg); err != nil {
+				return nil, errors.Wrapf(err, "invalid defaults for member switch %s", sw.Name)
+			}
+		}
+	}
+
 	return nil, nil
 }
+
+// switchGroupOverrideReader returns the group being validated instead of the stored one
+type switchGroupOverrideReader struct {
+	kclient.Reader
+	group *SwitchGroup
+}
+
+func (r *switchGroupOverrideReader) Get(ctx context.Context, key kclient.ObjectKey, obj kclient.Object, opts ...kclient.GetOption) error {
+	if sg, ok := obj.(*SwitchGroup); ok && key.Name == r.group.Name && key.Namespace == r.group.Namespace {
+		r.group.DeepCopyInto(sg)
+
+		return nil
+	}
+
+	return r.Reader.Get(ctx, key, obj, opts...) //nolint:wrapcheck
+}
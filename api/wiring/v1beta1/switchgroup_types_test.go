@@ -0,0 +1,116 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.githedgehog.com/fabric/api/meta"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	"go.githedgehog.com/fabric/pkg/ctrl/switchprofile"
+	"k8s.io/apimachinery/pkg/runtime"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSwitchGroupValidateMembers(t *testing.T) {
+	swGen := func(name string, groups ...string) *wiringapi.Switch {
+		sw := withName(name, &wiringapi.Switch{
+			Spec: wiringapi.SwitchSpec{
+				Role:           wiringapi.SwitchRoleServerLeaf,
+				ASN:            65101,
+				IP:             "172.30.0.10/21",
+				ProtocolIP:     "172.30.8.10/32",
+				VTEPIP:         "172.30.12.10/32",
+				Profile:        switchprofile.DellS5232FON.Name,
+				VLANNamespaces: []string{"default"},
+				Groups:         groups,
+			},
+		})
+		sw.Default()
+
+		return sw
+	}
+
+	for _, tt := range []struct {
+		name    string
+		spec    wiringapi.SwitchGroupSpec
+		objects []kclient.Object
+		err     bool
+	}{
+		{
+			name:    "no-members",
+			spec:    wiringapi.SwitchGroupSpec{PortSpeeds: map[string]string{"E1/33": "25G"}},
+			objects: []kclient.Object{swGen("leaf-01")},
+		},
+		{
+			name:    "supported-speed",
+			spec:    wiringapi.SwitchGroupSpec{PortSpeeds: map[string]string{"E1/33": "1G"}},
+			objects: []kclient.Object{swGen("leaf-01", "group-1")},
+		},
+		{
+			name:    "unsupported-speed",
+			spec:    wiringapi.SwitchGroupSpec{PortSpeeds: map[string]string{"E1/33": "25G"}},
+			objects: []kclient.Object{swGen("leaf-01", "group-1")},
+			err:     true,
+		},
+		{
+			name:    "unknown-port",
+			spec:    wiringapi.SwitchGroupSpec{PortBreakouts: map[string]string{"E1/99": "4x25G"}},
+			objects: []kclient.Object{swGen("leaf-01", "group-1")},
+			err:     true,
+		},
+		{
+			name:    "unsupported-roce-qpn",
+			spec:    wiringapi.SwitchGroupSpec{ECMP: wiringapi.SwitchECMP{RoCEQPN: new(true)}},
+			objects: []kclient.Object{swGen("leaf-01", "group-1")},
+			err:     true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			cfg := &meta.FabricConfig{
+				FabricMode:          meta.FabricModeSpineLeaf,
+				ControlVIP:          "172.30.0.1/32",
+				ProtocolSubnet:      "172.30.8.0/22",
+				VTEPSubnet:          "172.30.12.0/22",
+				SpineASN:            65100,
+				LeafASNStart:        65101,
+				LeafASNEnd:          65200,
+				ManagementSubnet:    "172.30.0.0/21",
+				ManagementDHCPStart: "172.30.4.0",
+				ManagementDHCPEnd:   "172.30.7.254",
+			}
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, wiringapi.AddToScheme(scheme))
+			kube := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(tt.objects,
+					withName("default", &wiringapi.VLANNamespace{
+						Spec: wiringapi.VLANNamespaceSpec{Ranges: []meta.VLANRange{{From: 1000, To: 2999}}},
+					}),
+					// stored group is valid, the one being validated is used for the members
+					withName("group-1", &wiringapi.SwitchGroup{}),
+				)...).
+				Build()
+			profiles := switchprofile.NewDefaultSwitchProfiles()
+			require.NoError(t, profiles.RegisterAll(ctx, kube, cfg))
+			require.NoError(t, profiles.Enforce(ctx, kube, cfg, false))
+
+			sg := withName("group-1", &wiringapi.SwitchGroup{Spec: tt.spec})
+			sg.Default()
+
+			_, err := sg.Validate(ctx, kube, cfg)
+			if tt.err {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -49,6 +49,8 @@ const (
 type SwitchProfileFeatures struct {
 	// Subinterfaces defines if switch supports subinterfaces
 	Subinterfaces bool `json:"subinterfaces,omitempty"`
+	// PortChannelSubinterfaces defines if switch supports subinterfaces on port channels
+	PortChannelSubinterfaces bool `json:"portChannelSubinterfaces,omitempty"`
 	// ACLs defines if switch supports ACLs
 	ACLs bool `json:"acls,omitempty"`
 	// L2VNI defines if switch supports L2 VNIs
@@ -63,6 +65,8 @@ type SwitchProfileFeatures struct {
 	ESLAG bool `json:"eslag,omitempty"`
 	// ECMPRoCEQPN defines if switch supports ECMP QPN hashing
 	ECMPRoCEQPN bool `json:"ecmpRoCEQPN,omitempty"`
+	// SFlow defines if switch supports sFlow traffic sampling and export
+	SFlow bool `json:"sflow,omitempty"`
 }
 
 // Defines switch-specific configuration options
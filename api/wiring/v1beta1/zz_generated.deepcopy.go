@@ -58,7 +58,12 @@ func (in *ConnBundled) DeepCopyInto(out *ConnBundled) {
 		*out = make([]ServerToSwitchLink, len(*in))
 		copy(*out, *in)
 	}
-	out.ServerFacingConnectionConfig = in.ServerFacingConnectionConfig
+	in.ServerFacingConnectionConfig.DeepCopyInto(&out.ServerFacingConnectionConfig)
+	if in.LACP != nil {
+		in, out := &in.LACP, &out.LACP
+		*out = new(ConnLACP)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnBundled.
@@ -79,7 +84,12 @@ func (in *ConnESLAG) DeepCopyInto(out *ConnESLAG) {
 		*out = make([]ServerToSwitchLink, len(*in))
 		copy(*out, *in)
 	}
-	out.ServerFacingConnectionConfig = in.ServerFacingConnectionConfig
+	in.ServerFacingConnectionConfig.DeepCopyInto(&out.ServerFacingConnectionConfig)
+	if in.LACP != nil {
+		in, out := &in.LACP, &out.LACP
+		*out = new(ConnLACP)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnESLAG.
@@ -96,6 +106,11 @@ func (in *ConnESLAG) DeepCopy() *ConnESLAG {
 func (in *ConnExternal) DeepCopyInto(out *ConnExternal) {
 	*out = *in
 	out.Link = in.Link
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]ConnExternalLink, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnExternal.
@@ -196,6 +211,21 @@ func (in *ConnGatewayLinkGateway) DeepCopy() *ConnGatewayLinkGateway {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnLACP) DeepCopyInto(out *ConnLACP) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnLACP.
+func (in *ConnLACP) DeepCopy() *ConnLACP {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnLACP)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnMCLAG) DeepCopyInto(out *ConnMCLAG) {
 	*out = *in
@@ -204,7 +234,7 @@ func (in *ConnMCLAG) DeepCopyInto(out *ConnMCLAG) {
 		*out = make([]ServerToSwitchLink, len(*in))
 		copy(*out, *in)
 	}
-	out.ServerFacingConnectionConfig = in.ServerFacingConnectionConfig
+	in.ServerFacingConnectionConfig.DeepCopyInto(&out.ServerFacingConnectionConfig)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnMCLAG.
@@ -319,7 +349,7 @@ func (in *ConnStaticExternalLinkSwitch) DeepCopy() *ConnStaticExternalLinkSwitch
 func (in *ConnUnbundled) DeepCopyInto(out *ConnUnbundled) {
 	*out = *in
 	out.Link = in.Link
-	out.ServerFacingConnectionConfig = in.ServerFacingConnectionConfig
+	in.ServerFacingConnectionConfig.DeepCopyInto(&out.ServerFacingConnectionConfig)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnUnbundled.
@@ -358,7 +388,7 @@ func (in *Connection) DeepCopyInto(out *Connection) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Connection.
@@ -379,6 +409,21 @@ func (in *Connection) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionLinkStatus) DeepCopyInto(out *ConnectionLinkStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionLinkStatus.
+func (in *ConnectionLinkStatus) DeepCopy() *ConnectionLinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionLinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionList) DeepCopyInto(out *ConnectionList) {
 	*out = *in
@@ -417,7 +462,7 @@ func (in *ConnectionSpec) DeepCopyInto(out *ConnectionSpec) {
 	if in.Unbundled != nil {
 		in, out := &in.Unbundled, &out.Unbundled
 		*out = new(ConnUnbundled)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Bundled != nil {
 		in, out := &in.Bundled, &out.Bundled
@@ -462,7 +507,7 @@ func (in *ConnectionSpec) DeepCopyInto(out *ConnectionSpec) {
 	if in.External != nil {
 		in, out := &in.External, &out.External
 		*out = new(ConnExternal)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.StaticExternal != nil {
 		in, out := &in.StaticExternal, &out.StaticExternal
@@ -484,6 +529,11 @@ func (in *ConnectionSpec) DeepCopy() *ConnectionSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionStatus) DeepCopyInto(out *ConnectionStatus) {
 	*out = *in
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]ConnectionLinkStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionStatus.
@@ -547,13 +597,151 @@ func (in *MeshLink) DeepCopy() *MeshLink {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorERSPAN) DeepCopyInto(out *MirrorERSPAN) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorERSPAN.
+func (in *MirrorERSPAN) DeepCopy() *MirrorERSPAN {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorERSPAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorSPAN) DeepCopyInto(out *MirrorSPAN) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorSPAN.
+func (in *MirrorSPAN) DeepCopy() *MirrorSPAN {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorSPAN)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorSession) DeepCopyInto(out *MirrorSession) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorSession.
+func (in *MirrorSession) DeepCopy() *MirrorSession {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MirrorSession) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorSessionList) DeepCopyInto(out *MirrorSessionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MirrorSession, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorSessionList.
+func (in *MirrorSessionList) DeepCopy() *MirrorSessionList {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorSessionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MirrorSessionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorSessionSpec) DeepCopyInto(out *MirrorSessionSpec) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SPAN != nil {
+		in, out := &in.SPAN, &out.SPAN
+		*out = new(MirrorSPAN)
+		**out = **in
+	}
+	if in.ERSPAN != nil {
+		in, out := &in.ERSPAN, &out.ERSPAN
+		*out = new(MirrorERSPAN)
+		**out = **in
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorSessionSpec.
+func (in *MirrorSessionSpec) DeepCopy() *MirrorSessionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorSessionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorSessionStatus) DeepCopyInto(out *MirrorSessionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorSessionStatus.
+func (in *MirrorSessionStatus) DeepCopy() *MirrorSessionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorSessionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Server.
@@ -577,6 +765,11 @@ func (in *Server) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerFacingConnectionConfig) DeepCopyInto(out *ServerFacingConnectionConfig) {
 	*out = *in
+	if in.StormControl != nil {
+		in, out := &in.StormControl, &out.StormControl
+		*out = new(StormControl)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerFacingConnectionConfig.
@@ -770,6 +963,21 @@ func (in *ServerSpec) DeepCopy() *ServerSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerStatus) DeepCopyInto(out *ServerStatus) {
 	*out = *in
+	if in.MACs != nil {
+		in, out := &in.MACs, &out.MACs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPs != nil {
+		in, out := &in.IPs, &out.IPs
+		*out = make([]ServerStatusIP, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]ServerStatusPort, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerStatus.
@@ -782,6 +990,36 @@ func (in *ServerStatus) DeepCopy() *ServerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerStatusIP) DeepCopyInto(out *ServerStatusIP) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerStatusIP.
+func (in *ServerStatusIP) DeepCopy() *ServerStatusIP {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerStatusIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerStatusPort) DeepCopyInto(out *ServerStatusPort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerStatusPort.
+func (in *ServerStatusPort) DeepCopy() *ServerStatusPort {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerStatusPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerToSwitchLink) DeepCopyInto(out *ServerToSwitchLink) {
 	*out = *in
@@ -799,13 +1037,28 @@ func (in *ServerToSwitchLink) DeepCopy() *ServerToSwitchLink {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StormControl) DeepCopyInto(out *StormControl) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StormControl.
+func (in *StormControl) DeepCopy() *StormControl {
+	if in == nil {
+		return nil
+	}
+	out := new(StormControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Switch) DeepCopyInto(out *Switch) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Switch.
@@ -844,6 +1097,11 @@ func (in *SwitchBoot) DeepCopy() *SwitchBoot {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SwitchECMP) DeepCopyInto(out *SwitchECMP) {
 	*out = *in
+	if in.RoCEQPN != nil {
+		in, out := &in.RoCEQPN, &out.RoCEQPN
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchECMP.
@@ -861,7 +1119,7 @@ func (in *SwitchGroup) DeepCopyInto(out *SwitchGroup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	out.Status = in.Status
 }
 
@@ -918,6 +1176,36 @@ func (in *SwitchGroupList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SwitchGroupSpec) DeepCopyInto(out *SwitchGroupSpec) {
 	*out = *in
+	if in.PortSpeeds != nil {
+		in, out := &in.PortSpeeds, &out.PortSpeeds
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PortBreakouts != nil {
+		in, out := &in.PortBreakouts, &out.PortBreakouts
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EnableAllPorts != nil {
+		in, out := &in.EnableAllPorts, &out.EnableAllPorts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RoCE != nil {
+		in, out := &in.RoCE, &out.RoCE
+		*out = new(bool)
+		**out = **in
+	}
+	in.ECMP.DeepCopyInto(&out.ECMP)
+	if in.LinkFlapErrDisable != nil {
+		in, out := &in.LinkFlapErrDisable, &out.LinkFlapErrDisable
+		*out = new(SwitchLinkFlapErrDisable)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchGroupSpec.
@@ -1323,6 +1611,27 @@ func (in *SwitchRedundancy) DeepCopy() *SwitchRedundancy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchSFlow) DeepCopyInto(out *SwitchSFlow) {
+	*out = *in
+	out.ObservabilitySFlow = in.ObservabilitySFlow
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchSFlow.
+func (in *SwitchSFlow) DeepCopy() *SwitchSFlow {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchSFlow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SwitchSpec) DeepCopyInto(out *SwitchSpec) {
 	*out = *in
@@ -1373,12 +1682,37 @@ func (in *SwitchSpec) DeepCopyInto(out *SwitchSpec) {
 		}
 	}
 	out.Boot = in.Boot
-	out.ECMP = in.ECMP
+	if in.EnableAllPorts != nil {
+		in, out := &in.EnableAllPorts, &out.EnableAllPorts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RoCE != nil {
+		in, out := &in.RoCE, &out.RoCE
+		*out = new(bool)
+		**out = **in
+	}
+	in.ECMP.DeepCopyInto(&out.ECMP)
 	if in.LinkFlapErrDisable != nil {
 		in, out := &in.LinkFlapErrDisable, &out.LinkFlapErrDisable
 		*out = new(SwitchLinkFlapErrDisable)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StormControl != nil {
+		in, out := &in.StormControl, &out.StormControl
+		*out = new(StormControl)
+		**out = **in
+	}
+	if in.SFlow != nil {
+		in, out := &in.SFlow, &out.SFlow
+		*out = new(SwitchSFlow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.System != nil {
+		in, out := &in.System, &out.System
+		*out = new(meta.SystemConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchSpec.
@@ -1394,6 +1728,9 @@ func (in *SwitchSpec) DeepCopy() *SwitchSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SwitchStatus) DeepCopyInto(out *SwitchStatus) {
 	*out = *in
+	in.LastHeartbeat.DeepCopyInto(&out.LastHeartbeat)
+	in.LastAppliedTime.DeepCopyInto(&out.LastAppliedTime)
+	out.Ports = in.Ports
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchStatus.
@@ -1406,6 +1743,21 @@ func (in *SwitchStatus) DeepCopy() *SwitchStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchStatusPorts) DeepCopyInto(out *SwitchStatusPorts) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchStatusPorts.
+func (in *SwitchStatusPorts) DeepCopy() *SwitchStatusPorts {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchStatusPorts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SwitchToSwitchLink) DeepCopyInto(out *SwitchToSwitchLink) {
 	*out = *in
@@ -121,6 +121,11 @@ func main() {
 		outputTypes = append(outputTypes, string(t))
 	}
 
+	diagramFormats := []string{}
+	for _, f := range hhfctl.WiringDiagramFormats {
+		diagramFormats = append(diagramFormats, string(f))
+	}
+
 	var output string
 	outputFlag := &cli.StringFlag{
 		Name:        "output",
@@ -1027,6 +1032,67 @@ func main() {
 							}), "failed to export wiring")
 						},
 					},
+					{
+						Name:  "diagram",
+						Usage: "render wiring diagram (switches, servers, redundancy groups and connections) from the cluster or wiring file",
+						Flags: []cli.Flag{
+							verboseFlag,
+							&cli.StringFlag{
+								Name:  "format",
+								Usage: "diagram format, one of " + strings.Join(diagramFormats, ", "),
+								Value: string(hhfctl.WiringDiagramFormatDOT),
+							},
+							&cli.StringFlag{
+								Name:    "file",
+								Aliases: []string{"f"},
+								Usage:   "wiring YAML file to render the diagram from instead of the cluster",
+							},
+							&cli.StringFlag{
+								Name:  "out",
+								Usage: "file to write the diagram to (stdout if not specified)",
+							},
+							&cli.BoolFlag{
+								Name:  "live",
+								Usage: "color links by the live LLDP and port oper status reported by the agents",
+							},
+						},
+						Before: func(_ *cli.Context) error {
+							return setupLogger(verbose)
+						},
+						Action: func(cCtx *cli.Context) error {
+							return errors.Wrapf(hhfctl.WiringDiagram(ctx, hhfctl.WiringDiagramOptions{
+								Format: hhfctl.WiringDiagramFormat(cCtx.String("format")),
+								Input:  cCtx.String("file"),
+								Output: cCtx.String("out"),
+								Live:   cCtx.Bool("live"),
+							}), "failed to render wiring diagram")
+						},
+					},
+					{
+						Name:  "import",
+						Usage: "generate validated servers and connections from the cabling CSV, rows: switch, port, remote device, remote port, type (" + strings.Join(hhfctl.WiringImportTypes, ", ") + "), bundle group (optional); switches should be defined separately",
+						Flags: []cli.Flag{
+							verboseFlag,
+							&cli.StringFlag{
+								Name:     "csv",
+								Usage:    "cabling CSV file to import",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "out",
+								Usage: "file to write the wiring YAML to (stdout if not specified)",
+							},
+						},
+						Before: func(_ *cli.Context) error {
+							return setupLogger(verbose)
+						},
+						Action: func(cCtx *cli.Context) error {
+							return errors.Wrapf(hhfctl.WiringImport(ctx, hhfctl.WiringImportOptions{
+								CSV:    cCtx.String("csv"),
+								Output: cCtx.String("out"),
+							}), "failed to import wiring")
+						},
+					},
 				},
 			},
 			{
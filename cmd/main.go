@@ -201,6 +201,21 @@ func run(ctx context.Context) error {
 	if err = ctrl.SetupVPCReconcilerWith(mgr, cfg, libMngr); err != nil {
 		return fmt.Errorf("setting up vpc controller: %w", err)
 	}
+	if err = ctrl.SetupVPCStatusReconcilerWith(mgr, libMngr); err != nil {
+		return fmt.Errorf("setting up vpc status controller: %w", err)
+	}
+	if err = ctrl.SetupApplyStatusReconcilerWith(mgr); err != nil {
+		return fmt.Errorf("setting up apply status controller: %w", err)
+	}
+	if err = ctrl.SetupExternalAttachmentReconcilerWith(mgr); err != nil {
+		return fmt.Errorf("setting up external attachment controller: %w", err)
+	}
+	if err = ctrl.SetupCablingReconcilerWith(mgr); err != nil {
+		return fmt.Errorf("setting up cabling controller: %w", err)
+	}
+	if err = ctrl.SetupServerReconcilerWith(mgr); err != nil {
+		return fmt.Errorf("setting up server controller: %w", err)
+	}
 	if err = ctrl.SetupConnectionReconcilerWith(mgr, libMngr); err != nil {
 		return fmt.Errorf("setting up connection controller: %w", err)
 	}
@@ -243,12 +258,21 @@ func run(ctx context.Context) error {
 	if err = ctrl.SetupVPCPeeringWebhookWith(mgr, cfg); err != nil {
 		return fmt.Errorf("setting up vpc peering webhook: %w", err)
 	}
+	if err = ctrl.SetupSecurityPolicyWebhookWith(mgr, cfg); err != nil {
+		return fmt.Errorf("setting up security policy webhook: %w", err)
+	}
 	if err = ctrl.SetupIPv4NamespaceWebhookWith(mgr, cfg); err != nil {
 		return fmt.Errorf("setting up ipv4 namespace webhook: %w", err)
 	}
+	if err = ctrl.SetupIPv6NamespaceWebhookWith(mgr, cfg); err != nil {
+		return fmt.Errorf("setting up ipv6 namespace webhook: %w", err)
+	}
 	if err = ctrl.SetupVLANNamespaceWebhookWith(mgr, cfg); err != nil {
 		return fmt.Errorf("setting up vlan namespace webhook: %w", err)
 	}
+	if err = ctrl.SetupMirrorSessionWebhookWith(mgr, cfg); err != nil {
+		return fmt.Errorf("setting up mirror session webhook: %w", err)
+	}
 	if err = ctrl.SetupExternalWebhookWith(mgr, cfg); err != nil {
 		return fmt.Errorf("setting up external webhook: %w", err)
 	}
@@ -261,6 +285,9 @@ func run(ctx context.Context) error {
 	if err = ctrl.SetupSwitchProfileWebhookWith(mgr, cfg, profiles); err != nil {
 		return fmt.Errorf("setting up switch profile webhook: %w", err)
 	}
+	if err = ctrl.SetupSwitchGroupWebhookWith(mgr, cfg); err != nil {
+		return fmt.Errorf("setting up switch group webhook: %w", err)
+	}
 	if err = ctrl.SetupGatewayWebhookWith(mgr, cfg, gwValid); err != nil {
 		return fmt.Errorf("setting up gateway webhook: %w", err)
 	}
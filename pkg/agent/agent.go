@@ -484,6 +484,12 @@ func enforceBroadcomState(ctx context.Context, processor dozer.Processor, agent
 	}
 	slog.Debug("Actions calculated", "count", len(actions))
 
+	// snapshot before cleaning up sensitive data so it could be used to roll back if applying fails
+	previous, err := actual.Clone()
+	if err != nil {
+		return errors.Wrapf(err, "failed to snapshot actual state")
+	}
+
 	actual.CleanupSensetive()
 	desired.CleanupSensetive()
 
@@ -531,7 +537,7 @@ func enforceBroadcomState(ctx context.Context, processor dozer.Processor, agent
 
 	slog.Info("Applying actions", "count", len(actions))
 
-	warnings, err := processor.ApplyActions(ctx, actions)
+	warnings, err := processor.ApplyActions(ctx, agent, previous, actions)
 	if err != nil {
 		return errors.Wrapf(err, "failed to apply actions")
 	}
@@ -567,6 +573,18 @@ func enforceCelesticaState(_ context.Context, _ /* processor */ dozer.Processor,
 	return nil
 }
 
+// applyFailureReason returns the Applied condition reason if the actions were rolled back after failure
+func applyFailureReason(err error) string {
+	switch {
+	case errors.Is(err, dozer.ErrRolledBack):
+		return "ApplyRolledBack"
+	case errors.Is(err, dozer.ErrRollbackFailed):
+		return "ApplyRollbackFailed"
+	default:
+		return ""
+	}
+}
+
 func (svc *Service) processAgent(ctx context.Context, agent *agentapi.Agent, readyCheck bool) error {
 	start := time.Now()
 	slog.Info("Processing agent config", "name", agent.Name, "gen", agent.Generation, "res", agent.ResourceVersion)
@@ -646,6 +664,20 @@ func (svc *Service) processAgentFromKube(ctx context.Context, kube kclient.Clien
 	}
 
 	if err := svc.processAgent(ctx, agent, false); err != nil {
+		if reason := applyFailureReason(err); reason != "" {
+			kmeta.SetStatusCondition(&agent.Status.Conditions, kmetav1.Condition{
+				Type:               "Applied",
+				Status:             kmetav1.ConditionFalse,
+				Reason:             reason,
+				LastTransitionTime: kmetav1.Time{Time: time.Now()},
+				Message:            fmt.Sprintf("Config failed to apply, gen=%d: %s", agent.Generation, err.Error()),
+			})
+
+			if statusErr := svc.updateStatus(ctx, kube, agent); statusErr != nil {
+				slog.Warn("Failed to report apply rollback in agent status", "err", statusErr)
+			}
+		}
+
 		return errors.Wrap(err, "failed to process agent config loaded from k8s")
 	}
 
@@ -659,12 +691,13 @@ func (svc *Service) processAgentFromKube(ctx context.Context, kube kclient.Clien
 		if err != nil {
 			return errors.Wrap(err, "failed to get RoCE state")
 		}
-		if roce != agent.Spec.Switch.RoCE {
-			slog.Info("Requesting RoCE mode change, switch will reboot automatically...", "roce", agent.Spec.Switch.RoCE)
+		desiredRoCE := agent.Spec.Switch.IsRoCEEnabled()
+		if roce != desiredRoCE {
+			slog.Info("Requesting RoCE mode change, switch will reboot automatically...", "roce", desiredRoCE)
 
 			for attempt := 0; attempt < 5; attempt++ {
-				if err := svc.processor.SetRoCE(ctx, agent.Spec.Switch.RoCE); err != nil {
-					slog.Warn("Failed to set RoCE state, retrying", "error", err, "desired", agent.Spec.Switch.RoCE)
+				if err := svc.processor.SetRoCE(ctx, desiredRoCE); err != nil {
+					slog.Warn("Failed to set RoCE state, retrying", "error", err, "desired", desiredRoCE)
 					time.Sleep(5 * time.Second)
 
 					continue
@@ -676,12 +709,13 @@ func (svc *Service) processAgentFromKube(ctx context.Context, kube kclient.Clien
 			slog.Info("Waiting for switch to reboot after RoCE change, it may take a while...")
 			time.Sleep(5 * time.Minute)
 
-			return fmt.Errorf("switch didn't reboot after switching roce to %t", agent.Spec.Switch.RoCE) //nolint:goerr113
+			return fmt.Errorf("switch didn't reboot after switching roce to %t", desiredRoCE) //nolint:goerr113
 		}
 	}
 
 	// report that we've been able to apply config
 	agent.Status.LastAppliedGen = agent.Generation
+	agent.Status.StatusUpdates = agent.Spec.StatusUpdates
 	agent.Status.LastAppliedTime = kmetav1.Time{Time: time.Now()}
 	svc.lastApplied = agent.Status.LastAppliedTime.Time
 
@@ -102,7 +102,7 @@ func (c *CelesticaPlusProcessor) PlanDesiredState(ctx context.Context, agent *ag
 	return nil, fmt.Errorf("unsupported operation") //nolint:err113
 }
 
-func (c *CelesticaPlusProcessor) ApplyActions(ctx context.Context, actions []dozer.Action) ([]string, error) {
+func (c *CelesticaPlusProcessor) ApplyActions(ctx context.Context, agent *agentapi.Agent, previous *dozer.Spec, actions []dozer.Action) ([]string, error) {
 	return nil, fmt.Errorf("unsupported operation") //nolint:err113
 }
 
@@ -21,6 +21,7 @@ import (
 	_ "embed"
 
 	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	"go.githedgehog.com/fabric/api/meta"
 	vpcapi "go.githedgehog.com/fabric/api/vpc/v1beta1"
 	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
 	"go.githedgehog.com/fabric/pkg/agent/dozer"
@@ -40,21 +41,28 @@ var ztpCfgTmpl string
 var fullCfgTmpl string
 
 type ConfigIn struct {
-	Hostname     string
-	Users        []User
-	NTPServer    string
-	ManagementIP string
-	ProtocolIP   string
-	VTEPIP       string
-	ASN          uint32
-	HostSubnet   string
-	RouterID     string
-	VXLANSource  string
-	VPCs         []VPC
-	BGPNeighbors []BGPNeighbor
-	PortConfigs  []PortConfig
-	IsSpine      bool
-	IsLeaf       bool
+	Hostname        string
+	Users           []User
+	NTPServers      []NTPServer
+	NTPVRF          string
+	DNS             *DNS
+	Timezone        string
+	SyslogServers   []SyslogServer
+	SyslogSelectors []SyslogSelector
+	AAA             *AAA
+	SNMP            *SNMP
+	ManagementIP    string
+	ProtocolIP      string
+	VTEPIP          string
+	ASN             uint32
+	HostSubnet      string
+	RouterID        string
+	VXLANSource     string
+	VPCs            []VPC
+	BGPNeighbors    []BGPNeighbor
+	PortConfigs     []PortConfig
+	IsSpine         bool
+	IsLeaf          bool
 }
 
 type User struct {
@@ -69,6 +77,71 @@ type SSHKey struct {
 	Type string
 }
 
+type AAA struct {
+	LoginOrder     []AAALoginMethod
+	VRF            string
+	AdminPrivLevel uint8
+	TACACSServers  []AAAServer
+	RADIUSServers  []AAAServer
+}
+
+type AAALoginMethod struct {
+	Priority int
+	Method   string
+}
+
+type AAAServer struct {
+	Host     string
+	Port     uint16
+	Priority int
+	Secret   string
+}
+
+type NTPServer struct {
+	Address string
+	Prefer  bool
+}
+
+type DNS struct {
+	Servers      []string
+	SearchDomain string
+}
+
+type SNMP struct {
+	ListenAddress string
+	Location      string
+	Contact       string
+	Users         []SNMPUser
+	Managers      []SNMPManager
+}
+
+type SNMPManager struct {
+	Seq    int
+	Prefix string
+}
+
+type SNMPUser struct {
+	Name         string
+	AuthProtocol string
+	AuthKey      string
+	PrivProtocol string
+	PrivKey      string
+}
+
+type SyslogServer struct {
+	Host          string
+	Port          uint16
+	Protocol      string
+	VRF           string
+	Selector      string
+	SourceAddress string
+}
+
+type SyslogSelector struct {
+	ID       string
+	Severity string
+}
+
 type BGPNeighbor struct {
 	IP          string
 	PeerGroup   string
@@ -293,6 +366,49 @@ func buildConfigFor(tmpl string, agent *agentapi.Agent) (*bytes.Buffer, error) {
 		})
 	}
 
+	syslogServers, syslogSelectors, err := buildSyslogServers(agent, protocolIP)
+	if err != nil {
+		return nil, fmt.Errorf("building syslog servers: %w", err)
+	}
+
+	aaa, err := buildAAA(agent)
+	if err != nil {
+		return nil, fmt.Errorf("building aaa: %w", err)
+	}
+
+	snmp, err := buildSNMP(agent)
+	if err != nil {
+		return nil, fmt.Errorf("building snmp: %w", err)
+	}
+
+	system := agent.Spec.Switch.EffectiveSystem(agent.Spec.Config.System)
+
+	ntpServers := []NTPServer{}
+	for _, srv := range system.NTP.Servers {
+		ntpServers = append(ntpServers, NTPServer{
+			Address: srv.Address,
+			Prefer:  srv.Prefer,
+		})
+	}
+	if len(ntpServers) == 0 {
+		ntpServers = append(ntpServers, NTPServer{
+			Address: controlVIP.Addr().String(),
+		})
+	}
+
+	ntpVRF := system.NTP.VRF
+	if ntpVRF == "" {
+		ntpVRF = meta.ObservabilityVRFMgmt
+	}
+
+	var dns *DNS
+	if len(system.DNS.Servers) > 0 || system.DNS.SearchDomain != "" {
+		dns = &DNS{
+			Servers:      system.DNS.Servers,
+			SearchDomain: system.DNS.SearchDomain,
+		}
+	}
+
 	slices.SortFunc(neighs, func(a, b BGPNeighbor) int {
 		// not ideal, but gives stable ordering
 		return strings.Compare(a.IP, b.IP)
@@ -306,20 +422,27 @@ func buildConfigFor(tmpl string, agent *agentapi.Agent) (*bytes.Buffer, error) {
 	})
 
 	cfgIn := ConfigIn{
-		Hostname:     agent.Name,
-		Users:        users,
-		NTPServer:    controlVIP.Addr().String(),
-		ManagementIP: agent.Spec.Switch.IP,
-		ProtocolIP:   agent.Spec.Switch.ProtocolIP,
-		VTEPIP:       agent.Spec.Switch.VTEPIP,
-		ASN:          agent.Spec.Switch.ASN,
-		RouterID:     protocolIP.Addr().String(),
-		VXLANSource:  vtepIP.Addr().String(),
-		VPCs:         vpcs,
-		BGPNeighbors: neighs,
-		PortConfigs:  portConfigs,
-		IsSpine:      isSpine,
-		IsLeaf:       isLeaf,
+		Hostname:        agent.Name,
+		Users:           users,
+		NTPServers:      ntpServers,
+		NTPVRF:          ntpVRF,
+		DNS:             dns,
+		Timezone:        system.Timezone,
+		SyslogServers:   syslogServers,
+		SyslogSelectors: syslogSelectors,
+		AAA:             aaa,
+		SNMP:            snmp,
+		ManagementIP:    agent.Spec.Switch.IP,
+		ProtocolIP:      agent.Spec.Switch.ProtocolIP,
+		VTEPIP:          agent.Spec.Switch.VTEPIP,
+		ASN:             agent.Spec.Switch.ASN,
+		RouterID:        protocolIP.Addr().String(),
+		VXLANSource:     vtepIP.Addr().String(),
+		VPCs:            vpcs,
+		BGPNeighbors:    neighs,
+		PortConfigs:     portConfigs,
+		IsSpine:         isSpine,
+		IsLeaf:          isLeaf,
 
 		// TODO remove hard-coded value and properly handle
 		HostSubnet: "10.0.0.0/8",
@@ -492,3 +615,167 @@ func (c *CumulusProcessor) UpdateSwitchState(ctx context.Context, agent *agentap
 
 	return nil
 }
+
+var syslogSeverities = map[meta.SyslogSeverity]string{
+	meta.SyslogSeverityEmergency: "emerg",
+	meta.SyslogSeverityAlert:     "alert",
+	meta.SyslogSeverityCritical:  "crit",
+	meta.SyslogSeverityError:     "err",
+	meta.SyslogSeverityWarning:   "warning",
+	meta.SyslogSeverityNotice:    "notice",
+	meta.SyslogSeverityInfo:      "info",
+	meta.SyslogSeverityDebug:     "debug",
+}
+
+// NVUE keys syslog selectors by id and servers reference them by priority, so there is a selector per used severity
+func buildSyslogServers(agent *agentapi.Agent, protocolIP netip.Prefix) ([]SyslogServer, []SyslogSelector, error) {
+	servers := []SyslogServer{}
+	selectors := []SyslogSelector{}
+	for _, srv := range agent.Spec.Config.SyslogServers {
+		server := SyslogServer{
+			Host:     srv.Host,
+			Port:     srv.Port,
+			Protocol: string(srv.Protocol),
+			VRF:      srv.VRF,
+		}
+
+		if server.Port == 0 {
+			server.Port = meta.SyslogDefaultPort
+		}
+		if server.Protocol == "" {
+			server.Protocol = string(meta.SyslogProtocolUDP)
+		}
+		if server.VRF == "" {
+			server.VRF = meta.ObservabilityVRFDefault
+		}
+
+		if srv.Severity != "" {
+			severity, ok := syslogSeverities[srv.Severity]
+			if !ok {
+				return nil, nil, fmt.Errorf("invalid syslog severity %q for server %s", srv.Severity, srv.Host) //nolint:err113
+			}
+
+			server.Selector = "severity-" + severity
+			if !slices.ContainsFunc(selectors, func(sel SyslogSelector) bool { return sel.ID == server.Selector }) {
+				selectors = append(selectors, SyslogSelector{
+					ID:       server.Selector,
+					Severity: severity,
+				})
+			}
+		}
+
+		switch srv.SourceInterface {
+		case "":
+		case meta.SyslogSourceInterfaceManagement:
+			mgmtIP, err := netip.ParsePrefix(agent.Spec.Switch.IP)
+			if err != nil {
+				return nil, nil, fmt.Errorf("parsing management IP: %w", err)
+			}
+			server.SourceAddress = mgmtIP.Addr().String()
+		case meta.SyslogSourceInterfaceLoopback:
+			server.SourceAddress = protocolIP.Addr().String()
+		default:
+			return nil, nil, fmt.Errorf("invalid syslog source interface %q for server %s", srv.SourceInterface, srv.Host) //nolint:err113
+		}
+
+		servers = append(servers, server)
+	}
+
+	slices.SortFunc(servers, func(a, b SyslogServer) int {
+		return strings.Compare(a.Host, b.Host)
+	})
+	slices.SortFunc(selectors, func(a, b SyslogSelector) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return servers, selectors, nil
+}
+
+func buildAAA(agent *agentapi.Agent) (*AAA, error) {
+	cfg := agent.Spec.Config.AAA
+	if cfg == nil || !cfg.IsEnabled() {
+		return nil, nil //nolint:nilnil
+	}
+
+	if cfg.Secret == "" {
+		return nil, fmt.Errorf("aaa secret is required") //nolint:err113
+	}
+
+	aaa := &AAA{
+		VRF:            cfg.EffectiveVRF(),
+		AdminPrivLevel: cfg.EffectiveAdminPrivLevel(),
+		TACACSServers:  []AAAServer{},
+		RADIUSServers:  []AAAServer{},
+	}
+
+	for idx, method := range cfg.EffectiveLoginOrder() {
+		aaa.LoginOrder = append(aaa.LoginOrder, AAALoginMethod{
+			Priority: idx + 1,
+			Method:   string(method),
+		})
+	}
+
+	for idx, srv := range cfg.ServersFor(meta.AAAProtocolTACACS) {
+		aaa.TACACSServers = append(aaa.TACACSServers, AAAServer{
+			Host:     srv.Host,
+			Port:     srv.EffectivePort(),
+			Priority: idx + 1,
+			Secret:   cfg.Secret,
+		})
+	}
+
+	for idx, srv := range cfg.ServersFor(meta.AAAProtocolRADIUS) {
+		aaa.RADIUSServers = append(aaa.RADIUSServers, AAAServer{
+			Host:     srv.Host,
+			Port:     srv.EffectivePort(),
+			Priority: idx + 1,
+			Secret:   cfg.Secret,
+		})
+	}
+
+	return aaa, nil
+}
+
+func buildSNMP(agent *agentapi.Agent) (*SNMP, error) {
+	cfg := agent.Spec.Config.SNMP
+	if cfg == nil || !cfg.IsEnabled() {
+		return nil, nil //nolint:nilnil
+	}
+
+	mgmtIP, err := netip.ParsePrefix(agent.Spec.Switch.IP)
+	if err != nil {
+		return nil, fmt.Errorf("parsing management IP: %w", err)
+	}
+
+	snmp := &SNMP{
+		ListenAddress: mgmtIP.Addr().String(),
+		Location:      cfg.Location,
+		Contact:       cfg.Contact,
+		Users:         []SNMPUser{},
+		Managers:      []SNMPManager{},
+	}
+
+	for idx, prefix := range cfg.ManagerPrefixes {
+		snmp.Managers = append(snmp.Managers, SNMPManager{
+			Seq:    10 * (idx + 1),
+			Prefix: prefix,
+		})
+	}
+
+	for _, user := range cfg.Users {
+		keys, ok := cfg.UserKeys[user.Name]
+		if !ok || keys.Auth == "" || keys.Priv == "" {
+			return nil, fmt.Errorf("snmp user %s keys are required", user.Name) //nolint:err113
+		}
+
+		snmp.Users = append(snmp.Users, SNMPUser{
+			Name:         user.Name,
+			AuthProtocol: string(user.EffectiveAuthProtocol()),
+			AuthKey:      keys.Auth,
+			PrivProtocol: string(user.EffectivePrivProtocol()),
+			PrivKey:      keys.Priv,
+		})
+	}
+
+	return snmp, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package cmls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	"go.githedgehog.com/fabric/api/meta"
+	kyaml "sigs.k8s.io/yaml"
+)
+
+func TestBuildConfigSyslog(t *testing.T) {
+	agent := &agentapi.Agent{}
+	agent.Name = "leaf-01"
+	agent.Spec.Config.ControlVIP = "172.30.0.1/32"
+	agent.Spec.Switch.IP = "172.30.10.5/21"
+	agent.Spec.Switch.ProtocolIP = "172.30.8.5/32"
+	agent.Spec.Config.SyslogServers = []meta.ObservabilitySyslogServer{
+		{Host: "10.0.0.3", Severity: meta.SyslogSeverityWarning},
+		{Host: "10.0.0.1", Severity: meta.SyslogSeverityError, SourceInterface: meta.SyslogSourceInterfaceManagement},
+		{Host: "10.0.0.2", Port: 1514, Protocol: meta.SyslogProtocolTCP, VRF: meta.ObservabilityVRFMgmt},
+		{Host: "10.0.0.4", Severity: meta.SyslogSeverityWarning, SourceInterface: meta.SyslogSourceInterfaceLoopback},
+	}
+
+	buf, err := buildConfigFor(fullCfgTmpl, agent)
+	require.NoError(t, err)
+
+	cfg := []struct {
+		Set struct {
+			System struct {
+				Syslog map[string]any `json:"syslog"`
+			} `json:"system"`
+		} `json:"set"`
+	}{}
+	require.NoError(t, kyaml.Unmarshal(buf.Bytes(), &cfg))
+	require.Len(t, cfg, 1)
+
+	require.Equal(t, map[string]any{
+		"selector": map[string]any{
+			"severity-err":     map[string]any{"severity": "err"},
+			"severity-warning": map[string]any{"severity": "warning"},
+		},
+		"server": map[string]any{
+			"10.0.0.1": map[string]any{
+				"port":      float64(514),
+				"protocol":  "udp",
+				"selector":  map[string]any{"1": map[string]any{"selector-id": "severity-err"}},
+				"source-ip": "172.30.10.5",
+				"vrf":       "default",
+			},
+			"10.0.0.2": map[string]any{
+				"port":     float64(1514),
+				"protocol": "tcp",
+				"vrf":      "mgmt",
+			},
+			"10.0.0.3": map[string]any{
+				"port":     float64(514),
+				"protocol": "udp",
+				"selector": map[string]any{"1": map[string]any{"selector-id": "severity-warning"}},
+				"vrf":      "default",
+			},
+			"10.0.0.4": map[string]any{
+				"port":      float64(514),
+				"protocol":  "udp",
+				"selector":  map[string]any{"1": map[string]any{"selector-id": "severity-warning"}},
+				"source-ip": "172.30.8.5",
+				"vrf":       "default",
+			},
+		},
+	}, cfg[0].Set.System.Syslog)
+}
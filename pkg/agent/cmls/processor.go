@@ -65,7 +65,7 @@ func (c *CumulusProcessor) PlanDesiredState(ctx context.Context, agent *v1beta1.
 	return nil, fmt.Errorf("unsupported operation") //nolint:err113
 }
 
-func (c *CumulusProcessor) ApplyActions(ctx context.Context, actions []dozer.Action) ([]string, error) {
+func (c *CumulusProcessor) ApplyActions(ctx context.Context, agent *v1beta1.Agent, previous *dozer.Spec, actions []dozer.Action) ([]string, error) {
 	return nil, fmt.Errorf("unsupported operation") //nolint:err113
 }
 
@@ -66,6 +66,10 @@ const (
 	ActionWeightLLDP
 	ActionWeightUser
 	ActionWeightUserAuthorizedKeys
+	ActionWeightAAAServerUpdate
+	ActionWeightAAA
+	ActionWeightSNMPUpdate
+	ActionWeightSNMPUserUpdate
 	ActionWeightPortGroup
 	ActionWeightPortBreakout
 
@@ -97,6 +101,9 @@ const (
 	ActionWeightInterfaceNATZoneUpdate
 	ActionWeightPortChannelConfigMACUpdate
 	ActionWeightPortChannelConfigFallbackUpdate
+	ActionWeightPortChannelConfigFastRateUpdate
+	ActionWeightPortChannelConfigMinLinksUpdate
+	ActionWeightPortChannelConfigSystemPriorityUpdate
 
 	ActionWeightInterfaceSubinterfaceIPsDelete
 	ActionWeightInterfaceVLANStaticARPDelete
@@ -130,6 +137,10 @@ const (
 	ActionWeightLLDPInterfaceUpdate
 	ActionWeightNTP
 	ActionWeightNTPServerUpdate
+	ActionWeightDNSUpdate
+	ActionWeightDNSServerUpdate
+	ActionWeightTimezone
+	ActionWeightSyslogServerUpdate
 
 	ActionWeightNATBaseUpdate
 	ActionWeightNATPoolUpdate
@@ -167,6 +178,13 @@ const (
 	ActionWeightBFDProfileUpdate
 	ActionWeightErrDisableGlobalUpdate
 	ActionWeightErrDisablePortUpdate
+	ActionWeightStormControlDelete
+	ActionWeightStormControlUpdate
+	ActionWeightMirrorSessionDelete
+	ActionWeightMirrorSessionUpdate
+	ActionWeightSFlowCollectorUpdate
+	ActionWeightSFlowInterfaceUpdate
+	ActionWeightSFlowUpdate
 	ActionWeightNeighborGlobalUpdate
 	ActionWeightVRFBGPNeighborUpdate
 	ActionWeightVRFBGPNetworkUpdate
@@ -195,6 +213,9 @@ const (
 	ActionWeightNeighborGlobalDelete
 	ActionWeightErrDisablePortDelete
 	ActionWeightErrDisableGlobalDelete
+	ActionWeightSFlowDelete
+	ActionWeightSFlowInterfaceDelete
+	ActionWeightSFlowCollectorDelete
 	ActionWeightVRFSAGDelete
 	ActionWeightVRFBGPL2VPNDelete
 	ActionWeightVRFBGPBaseDelete
@@ -209,9 +230,18 @@ const (
 
 	ActionWeightLLDPInterfaceDelete
 	ActionWeightNTPServerDelete
+	ActionWeightDNSServerDelete
+	ActionWeightDNSDelete
+	ActionWeightSyslogServerDelete
+	ActionWeightAAAServerDelete
+	ActionWeightSNMPUserDelete
+	ActionWeightSNMPDelete
 
 	ActionWeightPortChannelConfigMACDelete
 	ActionWeightPortChannelConfigFallbackDelete
+	ActionWeightPortChannelConfigFastRateDelete
+	ActionWeightPortChannelConfigMinLinksDelete
+	ActionWeightPortChannelConfigSystemPriorityDelete
 	ActionWeightInterfaceEthernetBaseDelete
 	ActionWeightInterfacePortChannelSwitchedAccessDelete
 	ActionWeightInterfacePortChannelSwitchedTrunkDelete
@@ -63,6 +63,11 @@ const (
 	BGPCommListAllExternals      = "all-externals"
 	BGPCommListAllGwPrios        = "all-gw-prios"
 	MgmtIface                    = "Management0"
+	TimezoneUTC                  = "UTC"
+	SFlowCollectorName           = "fabric"
+	CtrlPlaneIface               = "CtrlPlane"
+	SNMPManagersACL              = "snmp-managers"
+	SNMPPort                     = 161
 	FabricBFDProfile             = "fabric"
 	MaxGWPrioLevels              = 100
 	GwPrioPreferenceBase         = 200
@@ -73,11 +78,12 @@ func (p *BroadcomProcessor) PlanDesiredState(_ context.Context, agent *agentapi.
 	spec := &dozer.Spec{
 		ZTP:            pointer.To(false),
 		Hostname:       pointer.To(agent.Name),
-		ECMPRoCEQPN:    pointer.To(agent.Spec.Switch.ECMP.RoCEQPN),
+		ECMPRoCEQPN:    pointer.To(agent.Spec.Switch.ECMP.IsRoCEQPNEnabled()),
 		LLDP:           &dozer.SpecLLDP{},
 		LLDPInterfaces: map[string]*dozer.SpecLLDPInterface{},
 		NTP:            &dozer.SpecNTP{},
 		NTPServers:     map[string]*dozer.SpecNTPServer{},
+		SyslogServers:  map[string]*dozer.SpecSyslogServer{},
 		PortGroups:     map[string]*dozer.SpecPortGroup{},
 		PortBreakouts:  map[string]*dozer.SpecPortBreakout{},
 		Interfaces:     map[string]*dozer.SpecInterface{},
@@ -109,6 +115,8 @@ func (p *BroadcomProcessor) PlanDesiredState(_ context.Context, agent *agentapi.
 		LSTInterfaces:        map[string]*dozer.SpecLSTInterface{},
 		BFDProfiles:          map[string]*dozer.SpecBFDProfile{},
 		ErrDisableInterfaces: map[string]*dozer.SpecErrDisable{},
+		StormControls:        map[string]*dozer.SpecStormControl{},
+		MirrorSessions:       map[string]*dozer.SpecMirrorSession{},
 	}
 
 	for name, speed := range agent.Spec.Switch.PortGroupSpeeds {
@@ -132,6 +140,11 @@ func (p *BroadcomProcessor) PlanDesiredState(_ context.Context, agent *agentapi.
 		return nil, errors.Wrap(err, "failed to plan users")
 	}
 
+	err = planAAA(agent, spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to plan AAA")
+	}
+
 	err = planLoopbacks(agent, spec)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to plan switch IP loopbacks")
@@ -142,6 +155,26 @@ func (p *BroadcomProcessor) PlanDesiredState(_ context.Context, agent *agentapi.
 		return nil, errors.Wrap(err, "failed to plan NTP")
 	}
 
+	err = planDNS(agent, spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to plan DNS")
+	}
+
+	err = planTimezone(agent, spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to plan timezone")
+	}
+
+	err = planSyslog(agent, spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to plan syslog")
+	}
+
+	err = planSNMP(agent, spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to plan SNMP")
+	}
+
 	if err := planBreakouts(agent, spec); err != nil {
 		return nil, errors.Wrap(err, "failed to plan breakouts")
 	}
@@ -191,6 +224,16 @@ func (p *BroadcomProcessor) PlanDesiredState(_ context.Context, agent *agentapi.
 		return nil, errors.Wrap(err, "failed to plan server connections")
 	}
 
+	err = planStormControl(agent, spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to plan storm control")
+	}
+
+	err = planMirrorSessions(agent, spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to plan mirror sessions")
+	}
+
 	if agent.Spec.Role.IsLeaf() {
 		err = planVXLAN(agent, spec)
 		if err != nil {
@@ -235,6 +278,11 @@ func (p *BroadcomProcessor) PlanDesiredState(_ context.Context, agent *agentapi.
 		return nil, errors.Wrap(err, "failed to plan port auto negs")
 	}
 
+	err = planSFlow(agent, spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to plan sflow")
+	}
+
 	err = translatePortNames(agent, spec)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to translate port names")
@@ -294,6 +342,26 @@ func planLLDP(agent *agentapi.Agent, spec *dozer.Spec) error { //nolint:unparam
 func planNTP(agent *agentapi.Agent, spec *dozer.Spec) error {
 	spec.NTP.SourceInterface = []string{MgmtIface}
 
+	system := agent.Spec.Switch.EffectiveSystem(agent.Spec.Config.System)
+
+	// default VRF is the NOS default, so it's only set if other VRF is requested
+	if system.NTP.VRF != "" && system.NTP.VRF != meta.ObservabilityVRFDefault {
+		spec.NTP.VRF = pointer.To(system.NTP.VRF)
+	}
+
+	if len(system.NTP.Servers) > 0 {
+		for _, srv := range system.NTP.Servers {
+			server := &dozer.SpecNTPServer{}
+			if srv.Prefer {
+				server.Prefer = pointer.To(true)
+			}
+
+			spec.NTPServers[srv.Address] = server
+		}
+
+		return nil
+	}
+
 	if !strings.HasSuffix(agent.Spec.Config.ControlVIP, "/32") {
 		return errors.Errorf("invalid control VIP %s", agent.Spec.Config.ControlVIP)
 	}
@@ -306,6 +374,183 @@ func planNTP(agent *agentapi.Agent, spec *dozer.Spec) error {
 	return nil
 }
 
+func planDNS(agent *agentapi.Agent, spec *dozer.Spec) error { //nolint:unparam
+	dns := agent.Spec.Switch.EffectiveSystem(agent.Spec.Config.System).DNS
+	if len(dns.Servers) == 0 && dns.SearchDomain == "" {
+		return nil
+	}
+
+	spec.DNS = &dozer.SpecDNS{
+		Servers: map[string]*dozer.SpecDNSServer{},
+	}
+	if dns.SearchDomain != "" {
+		spec.DNS.SearchDomain = pointer.To(dns.SearchDomain)
+	}
+	for _, server := range dns.Servers {
+		spec.DNS.Servers[server] = &dozer.SpecDNSServer{}
+	}
+
+	return nil
+}
+
+func planTimezone(agent *agentapi.Agent, spec *dozer.Spec) error { //nolint:unparam
+	// UTC is the NOS default, so nothing is configured for it
+	timezone := agent.Spec.Switch.EffectiveSystem(agent.Spec.Config.System).Timezone
+	if timezone == "" || timezone == TimezoneUTC {
+		return nil
+	}
+
+	spec.Timezone = &dozer.SpecTimezone{
+		Name: pointer.To(timezone),
+	}
+
+	return nil
+}
+
+func planSyslog(agent *agentapi.Agent, spec *dozer.Spec) error {
+	for _, srv := range agent.Spec.Config.SyslogServers {
+		server := &dozer.SpecSyslogServer{
+			Port:     pointer.To(uint16(meta.SyslogDefaultPort)),
+			Protocol: pointer.To(SyslogProtocolUDP),
+			VRF:      pointer.To(VRFDefault),
+		}
+
+		if srv.Port != 0 {
+			server.Port = pointer.To(srv.Port)
+		}
+
+		switch srv.Protocol {
+		case "", meta.SyslogProtocolUDP:
+		case meta.SyslogProtocolTCP:
+			server.Protocol = pointer.To(SyslogProtocolTCP)
+		default:
+			return errors.Errorf("unsupported syslog protocol %q for server %s", srv.Protocol, srv.Host)
+		}
+
+		if srv.VRF != "" {
+			server.VRF = pointer.To(srv.VRF)
+		}
+
+		if srv.Severity != "" {
+			severity, ok := syslogSeverityNames[srv.Severity]
+			if !ok {
+				return errors.Errorf("unsupported syslog severity %q for server %s", srv.Severity, srv.Host)
+			}
+			server.Severity = pointer.To(severity)
+		}
+
+		switch srv.SourceInterface {
+		case "":
+		case meta.SyslogSourceInterfaceManagement:
+			server.SourceInterface = pointer.To(MgmtIface)
+		case meta.SyslogSourceInterfaceLoopback:
+			server.SourceInterface = pointer.To(LoopbackProto)
+		default:
+			return errors.Errorf("unsupported syslog source interface %q for server %s", srv.SourceInterface, srv.Host)
+		}
+
+		spec.SyslogServers[srv.Host] = server
+	}
+
+	return nil
+}
+
+var syslogSeverityNames = map[meta.SyslogSeverity]string{
+	meta.SyslogSeverityEmergency: SyslogSeverityEmergency,
+	meta.SyslogSeverityAlert:     SyslogSeverityAlert,
+	meta.SyslogSeverityCritical:  SyslogSeverityCritical,
+	meta.SyslogSeverityError:     SyslogSeverityError,
+	meta.SyslogSeverityWarning:   SyslogSeverityWarning,
+	meta.SyslogSeverityNotice:    SyslogSeverityNotice,
+	meta.SyslogSeverityInfo:      SyslogSeverityInformational,
+	meta.SyslogSeverityDebug:     SyslogSeverityDebug,
+}
+
+func planSNMP(agent *agentapi.Agent, spec *dozer.Spec) error {
+	// SNMP agent isn't configured at all if there are no users
+	snmp := agent.Spec.Config.SNMP
+	if snmp == nil || !snmp.IsEnabled() {
+		return nil
+	}
+
+	spec.SNMP = &dozer.SpecSNMP{
+		Users: map[string]*dozer.SpecSNMPUser{},
+	}
+	if snmp.Location != "" {
+		spec.SNMP.Location = pointer.To(snmp.Location)
+	}
+	if snmp.Contact != "" {
+		spec.SNMP.Contact = pointer.To(snmp.Contact)
+	}
+
+	for _, user := range snmp.Users {
+		keys, ok := snmp.UserKeys[user.Name]
+		if !ok || keys.Auth == "" || keys.Priv == "" {
+			return errors.Errorf("snmp user %s keys are required", user.Name)
+		}
+
+		authType := ""
+		switch user.EffectiveAuthProtocol() {
+		case meta.SNMPAuthProtocolMD5:
+			authType = SNMPAuthTypeMD5
+		case meta.SNMPAuthProtocolSHA:
+			authType = SNMPAuthTypeSHA
+		default:
+			return errors.Errorf("unsupported snmp auth protocol %q for user %s", user.AuthProtocol, user.Name)
+		}
+
+		privType := ""
+		switch user.EffectivePrivProtocol() {
+		case meta.SNMPPrivProtocolDES:
+			privType = SNMPPrivTypeDES
+		case meta.SNMPPrivProtocolAES:
+			privType = SNMPPrivTypeAES
+		default:
+			return errors.Errorf("unsupported snmp priv protocol %q for user %s", user.PrivProtocol, user.Name)
+		}
+
+		spec.SNMP.Users[user.Name] = &dozer.SpecSNMPUser{
+			AuthType: pointer.To(authType),
+			AuthKey:  pointer.To(keys.Auth),
+			PrivType: pointer.To(privType),
+			PrivKey:  pointer.To(keys.Priv),
+		}
+	}
+
+	if len(snmp.ManagerPrefixes) == 0 {
+		return nil
+	}
+
+	// only allow SNMP from the managers, everything else to the control plane is still allowed
+	entries := map[uint32]*dozer.SpecACLEntry{}
+	for idx, prefix := range snmp.ManagerPrefixes {
+		entries[uint32(10*(idx+1))] = &dozer.SpecACLEntry{ //nolint:gosec // number of prefixes is small
+			Protocol:        dozer.SpecACLEntryProtocolUDP,
+			SourceAddress:   pointer.To(prefix),
+			DestinationPort: pointer.To(uint16(SNMPPort)),
+			Action:          dozer.SpecACLEntryActionAccept,
+		}
+	}
+	entries[65000] = &dozer.SpecACLEntry{
+		Protocol:        dozer.SpecACLEntryProtocolUDP,
+		DestinationPort: pointer.To(uint16(SNMPPort)),
+		Action:          dozer.SpecACLEntryActionDrop,
+	}
+	entries[65535] = &dozer.SpecACLEntry{
+		Action: dozer.SpecACLEntryActionAccept,
+	}
+
+	spec.ACLs[SNMPManagersACL] = &dozer.SpecACL{
+		Description: pointer.To("Allow SNMP only from the managers"),
+		Entries:     entries,
+	}
+	spec.ACLInterfaces[CtrlPlaneIface] = &dozer.SpecACLInterface{
+		Ingress: pointer.To(SNMPManagersACL),
+	}
+
+	return nil
+}
+
 func planBreakouts(agent *agentapi.Agent, spec *dozer.Spec) error { //nolint:unparam
 	// it depends on the actual switch status, not on the intended state
 	if agent.Status.State.RoCE {
@@ -321,6 +566,63 @@ func planBreakouts(agent *agentapi.Agent, spec *dozer.Spec) error { //nolint:unp
 	return nil
 }
 
+func planAAA(agent *agentapi.Agent, spec *dozer.Spec) error {
+	// local only authentication is the NOS default, so nothing is configured if AAA is disabled
+	aaa := agent.Spec.Config.AAA
+	if aaa == nil || !aaa.IsEnabled() {
+		return nil
+	}
+
+	if aaa.Secret == "" {
+		return errors.Errorf("aaa secret is required")
+	}
+
+	spec.AAA = &dozer.SpecAAA{
+		TACACSServers: map[string]*dozer.SpecAAAServer{},
+		RADIUSServers: map[string]*dozer.SpecAAAServer{},
+	}
+
+	vrf := aaa.EffectiveVRF()
+	for _, protocol := range meta.AAAProtocols {
+		servers := aaa.ServersFor(protocol)
+		for idx, srv := range servers {
+			server := &dozer.SpecAAAServer{
+				Port:     pointer.To(srv.EffectivePort()),
+				Secret:   pointer.To(aaa.Secret),
+				Priority: pointer.To(uint8(len(servers) - idx)), //nolint:gosec // higher is preferred, no more than 8 servers
+				VRF:      pointer.To(vrf),
+			}
+
+			switch protocol {
+			case meta.AAAProtocolTACACS:
+				spec.AAA.TACACSServers[srv.Host] = server
+			case meta.AAAProtocolRADIUS:
+				spec.AAA.RADIUSServers[srv.Host] = server
+			}
+		}
+	}
+
+	methods := []string{}
+	for _, method := range aaa.EffectiveLoginOrder() {
+		switch method {
+		case meta.AAALoginMethodTACACS:
+			methods = append(methods, AAAMethodTACACS)
+		case meta.AAALoginMethodRADIUS:
+			methods = append(methods, AAAMethodRADIUS)
+		case meta.AAALoginMethodLocal:
+			methods = append(methods, AAAMethodLocal)
+		default:
+			return errors.Errorf("unsupported aaa login method %q", method)
+		}
+	}
+
+	spec.AAA.LoginMethods = methods
+	spec.AAA.Failthrough = pointer.To(len(methods) > 1)
+	spec.AAA.AdminPrivLevel = pointer.To(aaa.EffectiveAdminPrivLevel())
+
+	return nil
+}
+
 func planLoopbacks(agent *agentapi.Agent, spec *dozer.Spec) error {
 	// ip, ipNet, err := net.ParseCIDR(agent.Spec.Switch.IP)
 	// if err != nil {
@@ -919,6 +1221,187 @@ func planLinkErrorDisable(agent *agentapi.Agent, spec *dozer.Spec) error { //nol
 	return nil
 }
 
+func planStormControl(agent *agentapi.Agent, spec *dozer.Spec) error {
+	for connName, conn := range agent.Spec.Connections {
+		cfg := conn.ServerFacingConfig()
+		if cfg == nil {
+			continue
+		}
+
+		sc := agent.Spec.Switch.StormControl
+		if cfg.StormControl != nil {
+			sc = cfg.StormControl
+		}
+		if sc == nil {
+			continue
+		}
+
+		portSpec, err := planStormControlPort(sc)
+		if err != nil {
+			return errors.Wrapf(err, "failed to plan storm control for connection %s", connName)
+		}
+		if portSpec == nil {
+			continue
+		}
+
+		// switch port to server port links, deprecated MCLAG connections have none and are skipped
+		_, _, _, links, err := conn.Endpoints()
+		if err != nil {
+			return errors.Wrapf(err, "failed to get endpoints for connection %s", connName)
+		}
+
+		for swPortName := range links {
+			swPort := wiringapi.NewBasePortName(swPortName)
+			if swPort.DeviceName() != agent.Name {
+				continue
+			}
+
+			spec.StormControls[swPort.LocalPortName()] = portSpec
+		}
+	}
+
+	return nil
+}
+
+func planStormControlPort(sc *wiringapi.StormControl) (*dozer.SpecStormControl, error) {
+	threshold := func(value uint64) (*dozer.SpecStormControlThreshold, error) {
+		if value == 0 {
+			return nil, nil //nolint:nilnil
+		}
+
+		switch sc.Unit {
+		case wiringapi.StormControlUnitKbps, "":
+			return &dozer.SpecStormControlThreshold{KBPS: pointer.To(value)}, nil
+		case wiringapi.StormControlUnitPPS:
+			return &dozer.SpecStormControlThreshold{PPS: pointer.To(value)}, nil
+		default:
+			return nil, errors.Errorf("unknown storm control unit %q", sc.Unit)
+		}
+	}
+
+	res := &dozer.SpecStormControl{}
+	var err error
+
+	if res.Broadcast, err = threshold(sc.Broadcast); err != nil {
+		return nil, err
+	}
+	if res.UnknownUnicast, err = threshold(sc.UnknownUnicast); err != nil {
+		return nil, err
+	}
+	if res.UnknownMulticast, err = threshold(sc.Multicast); err != nil {
+		return nil, err
+	}
+
+	if res.Broadcast == nil && res.UnknownUnicast == nil && res.UnknownMulticast == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	return res, nil
+}
+
+func planMirrorSessions(agent *agentapi.Agent, spec *dozer.Spec) error {
+	for name, session := range agent.Spec.MirrorSessions {
+		var direction string
+		switch session.Direction {
+		case wiringapi.MirrorDirectionRX:
+			direction = MirrorDirectionRX
+		case wiringapi.MirrorDirectionTX:
+			direction = MirrorDirectionTX
+		case wiringapi.MirrorDirectionBoth, "":
+			direction = MirrorDirectionBoth
+		default:
+			return errors.Errorf("unknown direction %q for mirror session %s", session.Direction, name)
+		}
+
+		mirror := &dozer.SpecMirrorSession{
+			SourcePorts: slices.Clone(session.Sources),
+			Direction:   pointer.To(direction),
+		}
+
+		if session.SPAN != nil { //nolint:gocritic
+			mirror.DestinationPort = pointer.To(session.SPAN.Port)
+		} else if session.ERSPAN != nil {
+			srcIP, _, err := net.ParseCIDR(agent.Spec.Switch.ProtocolIP)
+			if err != nil {
+				return errors.Wrapf(err, "failed to parse protocol ip %s for mirror session %s", agent.Spec.Switch.ProtocolIP, name)
+			}
+
+			mirror.SourceIP = pointer.To(srcIP.String())
+			mirror.DestinationIP = pointer.To(session.ERSPAN.DestinationIP)
+			if session.ERSPAN.VPC != "" {
+				mirror.VRF = pointer.To(vpcVrfName(session.ERSPAN.VPC))
+			}
+			mirror.DSCP = pointer.To(session.ERSPAN.DSCP)
+			mirror.TTL = pointer.To(session.ERSPAN.TTL)
+		} else {
+			return errors.Errorf("mirror session %s has no destination", name)
+		}
+
+		spec.MirrorSessions[name] = mirror
+	}
+
+	return nil
+}
+
+// planSFlow must run after all interfaces are planned as it enables sFlow sampling on them
+func planSFlow(agent *agentapi.Agent, spec *dozer.Spec) error {
+	if agent.Spec.SwitchProfile == nil || !agent.Spec.SwitchProfile.Features.SFlow {
+		return nil
+	}
+
+	cfg := agent.Spec.Switch.EffectiveSFlow(agent.Spec.Config.SFlow)
+	if cfg == nil {
+		return nil
+	}
+
+	collector, err := cfg.CollectorAddrPort()
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse sflow collector")
+	}
+
+	vrf := cfg.VRF
+	if vrf == "" {
+		vrf = meta.ObservabilityVRFDefault
+	}
+
+	spec.SFlow = &dozer.SpecSFlow{
+		Enabled: pointer.To(true),
+		Collectors: map[string]*dozer.SpecSFlowCollector{
+			SFlowCollectorName: {
+				Address: pointer.To(collector.Addr().String()),
+				Port:    pointer.To(collector.Port()),
+				VRF:     pointer.To(vrf),
+			},
+		},
+		Interfaces: map[string]*dozer.SpecSFlowInterface{},
+	}
+	if cfg.PollingInterval != 0 {
+		spec.SFlow.PollingInterval = pointer.To(cfg.PollingInterval)
+	}
+
+	var ports []string
+	if agent.Spec.Switch.SFlow != nil {
+		ports = agent.Spec.Switch.SFlow.Ports
+	}
+
+	for name := range spec.Interfaces {
+		if !isHedgehogPortName(name) || strings.HasPrefix(name, wiringapi.ManagementPortPrefix) {
+			continue
+		}
+
+		iface := &dozer.SpecSFlowInterface{
+			Enabled: pointer.To(len(ports) == 0 || slices.Contains(ports, name)),
+		}
+		if *iface.Enabled && cfg.SamplingRate != 0 {
+			iface.SampleRate = pointer.To(cfg.SamplingRate)
+		}
+
+		spec.SFlow.Interfaces[name] = iface
+	}
+
+	return nil
+}
+
 func planVPCLoopbacks(agent *agentapi.Agent, spec *dozer.Spec) error { //nolint:unparam
 	for connName, conn := range agent.Spec.Connections {
 		if conn.VPCLoopback == nil {
@@ -991,14 +1474,38 @@ func planExternals(agent *agentapi.Agent, spec *dozer.Spec) error {
 			continue
 		}
 
-		port := conn.External.Link.Switch.LocalPortName()
+		if !conn.External.IsBundled() {
+			port := conn.External.Link.Switch.LocalPortName()
+
+			spec.Interfaces[port] = &dozer.SpecInterface{
+				Enabled:       pointer.To(true),
+				Description:   pointer.To(fmt.Sprintf("External %s", connName)),
+				Speed:         getPortSpeed(agent, port),
+				Subinterfaces: map[uint32]*dozer.SpecSubinterface{},
+			}
+
+			continue
+		}
+
+		portChan := agent.Spec.Catalog.PortChannelIDs[connName]
+		if portChan == 0 {
+			return errors.Errorf("no port channel found for external conn %s", connName)
+		}
+		connPortChannelName := portChannelName(portChan)
 
-		spec.Interfaces[port] = &dozer.SpecInterface{
+		spec.Interfaces[connPortChannelName] = &dozer.SpecInterface{
 			Enabled:       pointer.To(true),
 			Description:   pointer.To(fmt.Sprintf("External %s", connName)),
-			Speed:         getPortSpeed(agent, port),
 			Subinterfaces: map[uint32]*dozer.SpecSubinterface{},
 		}
+
+		for _, link := range conn.External.Links {
+			portName := link.Switch.LocalPortName()
+			descr := fmt.Sprintf("PC%d External %s", portChan, connName)
+			if err := setupPhysicalInterfaceWithPortChannel(spec, portName, descr, connPortChannelName, nil, agent); err != nil {
+				return errors.Wrapf(err, "failed to setup physical interface %s", portName)
+			}
+		}
 	}
 
 	for ipnsName, ipns := range agent.Spec.IPv4Namespaces {
@@ -1255,7 +1762,10 @@ func planExternals(agent *agentapi.Agent, spec *dozer.Spec) error {
 			return errors.Errorf("external %s not found for external attach %s", externalName, name)
 		}
 
-		port := conn.External.Link.Switch.LocalPortName()
+		port, err := externalConnInterface(agent, attach.Connection, conn.External)
+		if err != nil {
+			return err
+		}
 		var vlan *uint16
 		ipns := external.IPv4Namespace
 		extVrfName := extVrfName(externalName)
@@ -1291,7 +1801,7 @@ func planExternals(agent *agentapi.Agent, spec *dozer.Spec) error {
 
 			spec.VRFs[extVrfName].Interfaces[ifaceName] = &dozer.SpecVRFInterface{}
 
-			spec.VRFs[extVrfName].BGP.Neighbors[attach.Neighbor.IP] = &dozer.SpecVRFBGPNeighbor{
+			neigh := &dozer.SpecVRFBGPNeighbor{
 				Enabled:                   pointer.To(true),
 				Description:               pointer.To(fmt.Sprintf("External attach %s", name)),
 				RemoteAS:                  pointer.To(attach.Neighbor.ASN),
@@ -1299,6 +1809,21 @@ func planExternals(agent *agentapi.Agent, spec *dozer.Spec) error {
 				IPv4UnicastImportPolicies: []string{extInboundRouteMapName(attach.External)},
 				IPv4UnicastExportPolicies: []string{extOutboundRouteMapName(attach.External)},
 			}
+			if attach.Neighbor.PasswordSecret != "" {
+				if attach.NeighborPassword == "" {
+					return errors.Errorf("no password found for external attach %s with password secret %s", name, attach.Neighbor.PasswordSecret)
+				}
+				neigh.Password = pointer.To(attach.NeighborPassword)
+			}
+			if attach.Neighbor.MaxPrefix != nil {
+				neigh.IPv4UnicastMaxPrefixes = pointer.To(attach.Neighbor.MaxPrefix.Limit)
+				neigh.IPv4UnicastMaxPrefixesWarningOnly = pointer.To(attach.Neighbor.MaxPrefix.Action == vpcapi.ExternalAttachmentMaxPrefixActionWarning)
+			}
+			if attach.Neighbor.KeepaliveTime != 0 && attach.Neighbor.HoldTime != 0 {
+				neigh.KeepaliveInterval = pointer.To(attach.Neighbor.KeepaliveTime)
+				neigh.HoldTime = pointer.To(attach.Neighbor.HoldTime)
+			}
+			spec.VRFs[extVrfName].BGP.Neighbors[attach.Neighbor.IP] = neigh
 
 			if err := planHardenedInboundACL(spec, name, ip.String(), attach.InboundACL); err != nil {
 				return errors.Wrapf(err, "failed to plan inbound ACL for external attach %s", name)
@@ -1307,6 +1832,21 @@ func planExternals(agent *agentapi.Agent, spec *dozer.Spec) error {
 				Egress:  pointer.To(ipnsEgressAccessList(ipns)),
 				Ingress: pointer.To(extInboundACLName(name)),
 			}
+			if attach.InboundACL.HasIPv6() {
+				if err := planHardenedInboundIPv6ACL(spec, name, attach.InboundACL); err != nil {
+					return errors.Wrapf(err, "failed to plan inbound IPv6 ACL for external attach %s", name)
+				}
+				spec.ACLInterfaces[ifaceName].IngressIPv6 = pointer.To(extInboundIPv6ACLName(name))
+			}
+			if attach.OutboundACL != nil {
+				ipnsSpec, exists := agent.Spec.IPv4Namespaces[ipns]
+				if !exists {
+					return errors.Errorf("ipv4 namespace %s not found for external attach %s", ipns, name)
+				}
+				if err := planOutboundACLs(spec, name, ifaceName, attach.OutboundACL, ipnsSpec.Subnets); err != nil {
+					return errors.Wrapf(err, "failed to plan outbound ACL for external attach %s", name)
+				}
+			}
 		} else {
 			// static attachment
 			ifaceName := port
@@ -1394,6 +1934,12 @@ func planExternals(agent *agentapi.Agent, spec *dozer.Spec) error {
 				spec.ACLInterfaces[ifaceName] = &dozer.SpecACLInterface{
 					Ingress: pointer.To(extInboundACLName(name)),
 				}
+				if attach.InboundACL.HasIPv6() {
+					if err := planHardenedInboundIPv6ACL(spec, name, attach.InboundACL); err != nil {
+						return errors.Wrapf(err, "failed to plan inbound IPv6 ACL for external attach %s", name)
+					}
+					spec.ACLInterfaces[ifaceName].IngressIPv6 = pointer.To(extInboundIPv6ACLName(name))
+				}
 			} else if attach.InboundACL != nil {
 				if err := planInboundACL(spec, name, attach.InboundACL); err != nil {
 					return errors.Wrapf(err, "failed to plan inbound ACL for external attach %s", name)
@@ -1401,6 +1947,17 @@ func planExternals(agent *agentapi.Agent, spec *dozer.Spec) error {
 				spec.ACLInterfaces[ifaceName] = &dozer.SpecACLInterface{
 					Ingress: pointer.To(extInboundACLName(name)),
 				}
+				if attach.InboundACL.HasIPv6() {
+					if err := planInboundIPv6ACL(spec, name, attach.InboundACL); err != nil {
+						return errors.Wrapf(err, "failed to plan inbound IPv6 ACL for external attach %s", name)
+					}
+					spec.ACLInterfaces[ifaceName].IngressIPv6 = pointer.To(extInboundIPv6ACLName(name))
+				}
+			}
+			if attach.OutboundACL != nil {
+				if err := planOutboundACLs(spec, name, ifaceName, attach.OutboundACL, nil); err != nil {
+					return errors.Wrapf(err, "failed to plan outbound ACL for external attach %s", name)
+				}
 			}
 		}
 	}
@@ -1433,6 +1990,8 @@ func aclStatementToEntry(stmt vpcapi.ACLStatement) (*dozer.SpecACLEntry, error)
 		entry.Protocol = dozer.SpecACLEntryProtocolUDP
 	case vpcapi.ACLProtocolICMP:
 		entry.Protocol = dozer.SpecACLEntryProtocolICMP
+	case vpcapi.ACLProtocolICMPv6:
+		entry.Protocol = dozer.SpecACLEntryProtocolICMPv6
 	default:
 		return nil, errors.Errorf("unknown ACL protocol %q in statement %d", stmt.Protocol, stmt.Seq)
 	}
@@ -1497,6 +2056,9 @@ func planInboundACL(spec *dozer.Spec, attachName string, aclSpec *vpcapi.ACLSpec
 
 	entries := map[uint32]*dozer.SpecACLEntry{}
 	for _, stmt := range aclSpec.Statements {
+		if !stmt.IsIPv4() {
+			continue
+		}
 		entry, err := aclStatementToEntry(stmt)
 		if err != nil {
 			return err
@@ -1512,6 +2074,33 @@ func planInboundACL(spec *dozer.Spec, attachName string, aclSpec *vpcapi.ACLSpec
 	return nil
 }
 
+func planInboundIPv6ACL(spec *dozer.Spec, attachName string, aclSpec *vpcapi.ACLSpec) error {
+	dozerName := extInboundIPv6ACLName(attachName)
+	if _, exists := spec.ACLs[dozerName]; exists {
+		return nil
+	}
+
+	entries := map[uint32]*dozer.SpecACLEntry{}
+	for _, stmt := range aclSpec.Statements {
+		if !stmt.IsIPv6() {
+			continue
+		}
+		entry, err := aclStatementToEntry(stmt)
+		if err != nil {
+			return err
+		}
+		entries[uint32(stmt.Seq)] = entry
+	}
+
+	spec.ACLs[dozerName] = &dozer.SpecACL{
+		Description: pointer.To(fmt.Sprintf("Inbound IPv6 ACL %s", attachName)),
+		IPv6:        true,
+		Entries:     entries,
+	}
+
+	return nil
+}
+
 func planHardenedInboundACL(spec *dozer.Spec, attachName string, switchIP string, userACL *vpcapi.ACLSpec) error {
 	dozerName := extInboundACLName(attachName)
 	if _, exists := spec.ACLs[dozerName]; exists {
@@ -1564,22 +2153,178 @@ func planHardenedInboundACL(spec *dozer.Spec, attachName string, switchIP string
 			if stmt.Seq < 10 {
 				return fmt.Errorf("invalid user ACL statement with sequence number %d in the reserved range", stmt.Seq) //nolint:err113
 			}
+			if !stmt.IsIPv4() {
+				continue
+			}
+			entry, err := aclStatementToEntry(stmt)
+			if err != nil {
+				return err
+			}
+			entries[uint32(stmt.Seq)] = entry
+		}
+	} else {
+		entries[65535] = &dozer.SpecACLEntry{
+			Action: dozer.SpecACLEntryActionAccept,
+		}
+	}
+
+	spec.ACLs[dozerName] = &dozer.SpecACL{
+		Description: pointer.To(fmt.Sprintf("Inbound ACL %s", attachName)),
+		Entries:     entries,
+	}
+
+	return nil
+}
+
+// planHardenedInboundIPv6ACL is the IPv6 counterpart of planHardenedInboundACL, it's only planned if the user ACL has
+// IPv6 statements as IPv6 traffic isn't filtered otherwise. External attachments don't have IPv6 addresses on the
+// switch side yet, so the management services are protected on the link-local addresses only.
+func planHardenedInboundIPv6ACL(spec *dozer.Spec, attachName string, userACL *vpcapi.ACLSpec) error {
+	dozerName := extInboundIPv6ACLName(attachName)
+	if _, exists := spec.ACLs[dozerName]; exists {
+		return nil
+	}
+
+	dstAddr := "fe80::/10"
+
+	entries := map[uint32]*dozer.SpecACLEntry{
+		1: {
+			Protocol:           dozer.SpecACLEntryProtocolTCP,
+			DestinationAddress: pointer.To(dstAddr),
+			DestinationPort:    pointer.To(uint16(443)),
+			Action:             dozer.SpecACLEntryActionDiscard,
+		},
+		2: {
+			Protocol:           dozer.SpecACLEntryProtocolTCP,
+			DestinationAddress: pointer.To(dstAddr),
+			DestinationPort:    pointer.To(uint16(8080)),
+			Action:             dozer.SpecACLEntryActionDiscard,
+		},
+		3: {
+			Protocol:           dozer.SpecACLEntryProtocolUDP,
+			DestinationAddress: pointer.To(dstAddr),
+			DestinationPort:    pointer.To(uint16(547)),
+			Action:             dozer.SpecACLEntryActionDiscard,
+		},
+		4: {
+			Protocol:           dozer.SpecACLEntryProtocolUDP,
+			DestinationAddress: pointer.To(dstAddr),
+			DestinationPort:    pointer.To(uint16(161)),
+			Action:             dozer.SpecACLEntryActionDiscard,
+		},
+		5: {
+			Protocol:           dozer.SpecACLEntryProtocolUDP,
+			DestinationAddress: pointer.To(dstAddr),
+			DestinationPort:    pointer.To(uint16(4789)),
+			Action:             dozer.SpecACLEntryActionDiscard,
+		},
+		6: {
+			Protocol:           dozer.SpecACLEntryProtocolTCP,
+			DestinationAddress: pointer.To(dstAddr),
+			DestinationPort:    pointer.To(uint16(22)),
+			Action:             dozer.SpecACLEntryActionDiscard,
+		},
+	}
+
+	for _, stmt := range userACL.Statements {
+		if stmt.Seq < 10 {
+			return fmt.Errorf("invalid user ACL statement with sequence number %d in the reserved range", stmt.Seq) //nolint:err113
+		}
+		if !stmt.IsIPv6() {
+			continue
+		}
+		entry, err := aclStatementToEntry(stmt)
+		if err != nil {
+			return err
+		}
+		entries[uint32(stmt.Seq)] = entry
+	}
+
+	spec.ACLs[dozerName] = &dozer.SpecACL{
+		Description: pointer.To(fmt.Sprintf("Inbound IPv6 ACL %s", attachName)),
+		IPv6:        true,
+		Entries:     entries,
+	}
+
+	return nil
+}
+
+// planOutboundACLs plans the outbound ACLs for the external attachment and binds them as egress ACLs to the
+// interface. Traffic not matching any of the user statements is permitted. BGP attachments have the IPv4 namespace
+// egress ACL bound to the interface otherwise, so the namespace subnets are dropped after the user statements.
+func planOutboundACLs(spec *dozer.Spec, attachName string, ifaceName string, userACL *vpcapi.ACLSpec, ipnsSubnets []string) error {
+	if spec.ACLInterfaces[ifaceName] == nil {
+		spec.ACLInterfaces[ifaceName] = &dozer.SpecACLInterface{}
+	}
+
+	dozerName := extOutboundACLName(attachName)
+	if _, exists := spec.ACLs[dozerName]; !exists {
+		entries := map[uint32]*dozer.SpecACLEntry{
+			65535: {
+				Action: dozer.SpecACLEntryActionAccept,
+			},
+		}
+
+		for _, stmt := range userACL.Statements {
+			if stmt.Seq > vpcapi.ACLOutboundMaxSeq {
+				return fmt.Errorf("invalid user ACL statement with sequence number %d in the reserved range", stmt.Seq) //nolint:err113
+			}
+			if !stmt.IsIPv4() {
+				continue
+			}
+			entry, err := aclStatementToEntry(stmt)
+			if err != nil {
+				return err
+			}
+			entries[uint32(stmt.Seq)] = entry
+		}
+
+		seq := uint32(vpcapi.ACLOutboundMaxSeq + 1)
+		for _, subnet := range ipnsSubnets {
+			entries[seq] = &dozer.SpecACLEntry{
+				DestinationAddress: pointer.To(subnet),
+				Action:             dozer.SpecACLEntryActionDrop,
+			}
+			seq += 10
+		}
+
+		spec.ACLs[dozerName] = &dozer.SpecACL{
+			Description: pointer.To(fmt.Sprintf("Outbound ACL %s", attachName)),
+			Entries:     entries,
+		}
+	}
+	spec.ACLInterfaces[ifaceName].Egress = pointer.To(dozerName)
+
+	if !userACL.HasIPv6() {
+		return nil
+	}
+
+	dozerName = extOutboundIPv6ACLName(attachName)
+	if _, exists := spec.ACLs[dozerName]; !exists {
+		entries := map[uint32]*dozer.SpecACLEntry{
+			65535: {
+				Action: dozer.SpecACLEntryActionAccept,
+			},
+		}
+
+		for _, stmt := range userACL.Statements {
+			if !stmt.IsIPv6() {
+				continue
+			}
 			entry, err := aclStatementToEntry(stmt)
 			if err != nil {
 				return err
 			}
 			entries[uint32(stmt.Seq)] = entry
 		}
-	} else {
-		entries[65535] = &dozer.SpecACLEntry{
-			Action: dozer.SpecACLEntryActionAccept,
-		}
-	}
 
-	spec.ACLs[dozerName] = &dozer.SpecACL{
-		Description: pointer.To(fmt.Sprintf("Inbound ACL %s", attachName)),
-		Entries:     entries,
+		spec.ACLs[dozerName] = &dozer.SpecACL{
+			Description: pointer.To(fmt.Sprintf("Outbound IPv6 ACL %s", attachName)),
+			IPv6:        true,
+			Entries:     entries,
+		}
 	}
+	spec.ACLInterfaces[ifaceName].EgressIPv6 = pointer.To(dozerName)
 
 	return nil
 }
@@ -1701,6 +2446,23 @@ func planStaticExternals(agent *agentapi.Agent, spec *dozer.Spec) error {
 	return nil
 }
 
+func planPortChannelLACP(pcConfig *dozer.SpecPortChannelConfig, lacp *wiringapi.ConnLACP) {
+	if lacp == nil {
+		return
+	}
+
+	// NOS defaults (slow rate, single min link and default priority) are left unset the same way as they are loaded
+	if lacp.Rate == wiringapi.ConnLACPRateFast {
+		pcConfig.FastRate = pointer.To(true)
+	}
+	if lacp.MinLinks > DefaultLACPMinLinks {
+		pcConfig.MinLinks = pointer.To(lacp.MinLinks)
+	}
+	if lacp.SystemPriority > 0 && lacp.SystemPriority != DefaultLACPSystemPriority {
+		pcConfig.SystemPriority = pointer.To(lacp.SystemPriority)
+	}
+}
+
 func planServerConnections(agent *agentapi.Agent, spec *dozer.Spec) error {
 	// handle connections which should be configured as port channels
 	for connName, conn := range agent.Spec.Connections {
@@ -1708,6 +2470,7 @@ func planServerConnections(agent *agentapi.Agent, spec *dozer.Spec) error {
 		var mtu *uint16
 		var links []wiringapi.ServerToSwitchLink
 		var fallback bool
+		var lacp *wiringapi.ConnLACP
 
 		if conn.Bundled != nil { //nolint:gocritic
 			connType = "Bundled"
@@ -1715,6 +2478,7 @@ func planServerConnections(agent *agentapi.Agent, spec *dozer.Spec) error {
 				mtu = pointer.To(conn.Bundled.MTU) //nolint:ineffassign,staticcheck
 			}
 			fallback = conn.Bundled.Fallback
+			lacp = conn.Bundled.LACP
 			links = conn.Bundled.Links
 		} else if conn.ESLAG != nil {
 			connType = "ESLAG"
@@ -1723,6 +2487,7 @@ func planServerConnections(agent *agentapi.Agent, spec *dozer.Spec) error {
 			}
 			// per-leaf: ESLAG has no peer link
 			fallback = conn.ESLAG.Fallback
+			lacp = conn.ESLAG.LACP
 			links = conn.ESLAG.Links
 		} else {
 			continue
@@ -1737,6 +2502,10 @@ func planServerConnections(agent *agentapi.Agent, spec *dozer.Spec) error {
 			return errors.Wrapf(err, "failed to validate server facing MTU for conn %s", connName)
 		}
 
+		if err := conn.ValidateLACP(); err != nil {
+			return errors.Wrapf(err, "failed to validate LACP for conn %s", connName)
+		}
+
 		for _, link := range links {
 			if link.Switch.DeviceName() != agent.Name {
 				continue
@@ -1790,6 +2559,8 @@ func planServerConnections(agent *agentapi.Agent, spec *dozer.Spec) error {
 				}
 			}
 
+			planPortChannelLACP(spec.PortChannelConfigs[connPortChannelName], lacp)
+
 			descr := fmt.Sprintf("PC%d %s %s %s", portChan, connType, link.Server.DeviceName(), connName)
 			err := setupPhysicalInterfaceWithPortChannel(spec, portName, descr, connPortChannelName, mtu, agent)
 			if err != nil {
@@ -1890,7 +2661,7 @@ func planVXLAN(agent *agentapi.Agent, spec *dozer.Spec) error {
 		VTEPFabric: {
 			SourceIP:        pointer.To(ip.String()),
 			SourceInterface: pointer.To(LoopbackVTEP),
-			QoSUniform:      pointer.To(agent.Spec.Switch.RoCE),
+			QoSUniform:      pointer.To(agent.Spec.Switch.IsRoCEEnabled()),
 		},
 	}
 
@@ -2204,6 +2975,12 @@ func planVPCs(agent *agentapi.Agent, spec *dozer.Spec) error {
 		}
 	}
 
+	for policyName, policy := range agent.Spec.SecurityPolicies {
+		if err := planSecurityPolicy(agent, spec, policyName, policy); err != nil {
+			return errors.Wrapf(err, "failed to plan security policy %s", policyName)
+		}
+	}
+
 	for vpcName, vpc := range agent.Spec.VPCs {
 		switch vpc.Mode {
 		case vpcapi.VPCModeL2VNI, vpcapi.VPCModeL3VNI:
@@ -2229,7 +3006,7 @@ func planVPCs(agent *agentapi.Agent, spec *dozer.Spec) error {
 								if aclIface.Ingress != nil && *aclIface.Ingress == aclName {
 									aclIface.Ingress = nil
 
-									if aclIface.Egress == nil {
+									if aclIface.Egress == nil && aclIface.IngressIPv6 == nil {
 										delete(spec.ACLInterfaces, subnetIface)
 									}
 								}
@@ -2237,6 +3014,20 @@ func planVPCs(agent *agentapi.Agent, spec *dozer.Spec) error {
 						}
 					}
 				}
+
+				ipv6ACLName := vpcFilteringIPv6AccessListName(vpcName, subnetName)
+				if acl, ok := spec.ACLs[ipv6ACLName]; ok && len(acl.Entries) == 1 {
+					delete(spec.ACLs, ipv6ACLName)
+
+					subnetIface := vlanName(subnet.VLAN)
+					if aclIface, ok := spec.ACLInterfaces[subnetIface]; ok {
+						aclIface.IngressIPv6 = nil
+
+						if aclIface.Ingress == nil && aclIface.Egress == nil {
+							delete(spec.ACLInterfaces, subnetIface)
+						}
+					}
+				}
 			}
 		case vpcapi.VPCModeL3Flat:
 			continue
@@ -2338,6 +3129,80 @@ func planVNIVPC(agent *agentapi.Agent, spec *dozer.Spec, vpcName string, vpc vpc
 		}
 	}
 
+	if vpc.HasIPv6() {
+		spec.PrefixLists[vpcPeersIPv6PrefixListName(vpcName)] = &dozer.SpecPrefixList{
+			IPv6:     true,
+			Prefixes: map[uint32]*dozer.SpecPrefixListEntry{},
+		}
+
+		spec.PrefixLists[vpcSubnetsIPv6PrefixListName(vpcName)] = &dozer.SpecPrefixList{
+			IPv6:     true,
+			Prefixes: map[uint32]*dozer.SpecPrefixListEntry{},
+		}
+
+		spec.PrefixLists[vpcNotSubnetsIPv6PrefixListName(vpcName)] = &dozer.SpecPrefixList{
+			IPv6: true,
+			Prefixes: map[uint32]*dozer.SpecPrefixListEntry{
+				65535: {
+					Prefix: dozer.SpecPrefixListPrefix{
+						Prefix: "::/0",
+						Le:     128,
+					},
+					Action: dozer.SpecPrefixListActionPermit,
+				},
+			},
+		}
+
+		for subnetName, subnet := range vpc.Subnets {
+			if subnet.SubnetIPv6 == "" {
+				continue
+			}
+
+			vni, ok := agent.Spec.Catalog.GetVPCSubnetVNI(vpcName, subnetName)
+			if vni == 0 || !ok {
+				return errors.Errorf("VNI for VPC %s subnet %s not found", vpcName, subnetName)
+			}
+			vni %= 100
+
+			spec.PrefixLists[vpcSubnetsIPv6PrefixListName(vpcName)].Prefixes[vni] = &dozer.SpecPrefixListEntry{
+				Prefix: dozer.SpecPrefixListPrefix{
+					Prefix: subnet.SubnetIPv6,
+					Le:     128,
+				},
+				Action: dozer.SpecPrefixListActionPermit,
+			}
+
+			spec.PrefixLists[vpcNotSubnetsIPv6PrefixListName(vpcName)].Prefixes[vni] = &dozer.SpecPrefixListEntry{
+				Prefix: dozer.SpecPrefixListPrefix{
+					Prefix: subnet.SubnetIPv6,
+					Le:     128,
+				},
+				Action: dozer.SpecPrefixListActionDeny,
+			}
+		}
+
+		spec.RouteMaps[vpcImportVrfIPv6RouteMapName(vpcName)] = &dozer.SpecRouteMap{
+			Statements: map[string]*dozer.SpecRouteMapStatement{
+				"50000": {
+					Conditions: dozer.SpecRouteMapConditions{
+						MatchCommunityList: pointer.To(vpcPeersCommList),
+					},
+					Result: dozer.SpecRouteMapResultAccept,
+				},
+				"50001": {
+					Conditions: dozer.SpecRouteMapConditions{
+						MatchCommunityList: pointer.To(NoCommunity),
+						MatchPrefixList:    pointer.To(vpcPeersIPv6PrefixListName(vpcName)),
+					},
+					Result: dozer.SpecRouteMapResultAccept,
+				},
+				"65535": {
+					Result: dozer.SpecRouteMapResultReject,
+				},
+			},
+		}
+	}
+
 	importVrfRouteMap := vpcExtImportVrfRouteMapName(vpcName)
 	if _, exists := spec.RouteMaps[importVrfRouteMap]; !exists {
 		spec.RouteMaps[importVrfRouteMap] = &dozer.SpecRouteMap{
@@ -2400,6 +3265,15 @@ func planVNIVPC(agent *agentapi.Agent, spec *dozer.Spec, vpcName string, vpc vpc
 			},
 		},
 	}
+	if vpc.HasIPv6() {
+		spec.RouteMaps[vpcRedistributeConnectedRouteMap].Statements["7"] = &dozer.SpecRouteMapStatement{
+			Conditions: dozer.SpecRouteMapConditions{
+				MatchPrefixList: pointer.To(vpcSubnetsIPv6PrefixListName(vpcName)),
+			},
+			SetCommunities: []string{vpcComm},
+			Result:         dozer.SpecRouteMapResultAccept,
+		}
+	}
 
 	vpcRedistributeStaticRouteMap := vpcRedistributeStaticRouteMapName(vpcName)
 	spec.RouteMaps[vpcRedistributeStaticRouteMap] = &dozer.SpecRouteMap{
@@ -2450,6 +3324,18 @@ func planVNIVPC(agent *agentapi.Agent, spec *dozer.Spec, vpcName string, vpc vpc
 	if vpc.Mode == vpcapi.VPCModeL2VNI {
 		spec.VRFs[vrfName].BGP.L2VPNEVPN.AdvertiseIPv4UnicastRouteMaps = []string{RouteMapFilterAttachedHost}
 	}
+	if vpc.HasIPv6() {
+		spec.VRFs[vrfName].BGP.IPv6Unicast = &dozer.SpecVRFBGPIPv6Unicast{
+			Enabled:      true,
+			MaxPaths:     pointer.To(getMaxPaths(agent)),
+			ImportPolicy: pointer.To(vpcImportVrfIPv6RouteMapName(vpcName)),
+			ImportVRFs:   map[string]*dozer.SpecVRFBGPImportVRF{},
+		}
+		spec.VRFs[vrfName].BGP.L2VPNEVPN.AdvertiseIPv6Unicast = pointer.To(true)
+		if vpc.Mode == vpcapi.VPCModeL2VNI {
+			spec.VRFs[vrfName].BGP.L2VPNEVPN.AdvertiseIPv6UnicastRouteMaps = []string{RouteMapFilterAttachedHost}
+		}
+	}
 
 	spec.VRFs[vrfName].TableConnections = map[string]*dozer.SpecVRFTableConnection{
 		string(dozer.SpecVRFBGPTableConnectionConnected): {
@@ -2461,6 +3347,11 @@ func planVNIVPC(agent *agentapi.Agent, spec *dozer.Spec, vpcName string, vpc vpc
 	}
 	spec.VRFs[vrfName].TableConnections[string(dozer.SpecVRFBGPTableConnectionAttachedHost)] = &dozer.SpecVRFTableConnection{}
 	spec.VRFs[vrfName].Interfaces[irbIface] = &dozer.SpecVRFInterface{}
+	if vpc.HasIPv6() {
+		spec.VRFs[vrfName].TableConnections[string(dozer.SpecVRFBGPTableConnectionConnectedIPv6)] = &dozer.SpecVRFTableConnection{
+			ImportPolicies: []string{vpcRedistributeConnectedRouteMap},
+		}
+	}
 
 	if agent.IsSpineLeaf() {
 		spec.SuppressVLANNeighs[irbIface] = &dozer.SpecSuppressVLANNeigh{}
@@ -2567,6 +3458,16 @@ func planVNIVPCPeering(agent *agentapi.Agent, spec *dozer.Spec, peeringName stri
 		}
 	}
 
+	ipv6Peering := vpc1.HasIPv6() && vpc2.HasIPv6()
+	if ipv6Peering {
+		if err := planVNIVPCPeeringIPv6PrefixList(agent, spec, vpc1Name, vpc2Name, vpc1); err != nil {
+			return err
+		}
+		if err := planVNIVPCPeeringIPv6PrefixList(agent, spec, vpc2Name, vpc1Name, vpc2); err != nil {
+			return err
+		}
+	}
+
 	// TODO dedup
 	vni1 := agent.Spec.Catalog.VPCVNIs[vpc1Name]
 	if vni1 == 0 {
@@ -2603,6 +3504,22 @@ func planVNIVPCPeering(agent *agentapi.Agent, spec *dozer.Spec, peeringName stri
 		},
 		Result: dozer.SpecRouteMapResultReject,
 	}
+	if ipv6Peering {
+		spec.RouteMaps[vpcImportVrfIPv6RouteMapName(vpc1Name)].Statements[fmt.Sprintf("%d", 10000+vni2/100)] = &dozer.SpecRouteMapStatement{
+			Conditions: dozer.SpecRouteMapConditions{
+				MatchPrefixList: pointer.To(vpcNotSubnetsIPv6PrefixListName(vpc2Name)),
+				MatchSourceVRF:  pointer.To(vpcVrfName(vpc2Name)),
+			},
+			Result: dozer.SpecRouteMapResultReject,
+		}
+		spec.RouteMaps[vpcImportVrfIPv6RouteMapName(vpc2Name)].Statements[fmt.Sprintf("%d", 10000+vni1/100)] = &dozer.SpecRouteMapStatement{
+			Conditions: dozer.SpecRouteMapConditions{
+				MatchPrefixList: pointer.To(vpcNotSubnetsIPv6PrefixListName(vpc1Name)),
+				MatchSourceVRF:  pointer.To(vpcVrfName(vpc1Name)),
+			},
+			Result: dozer.SpecRouteMapResultReject,
+		}
+	}
 
 	if err := extendVPCFilteringACL(agent, spec, vpc1Name, vpc2Name, vpc1, vpc2, peering); err != nil {
 		return errors.Wrapf(err, "failed to extend VPC filtering ACL for VPC peering %s", peeringName)
@@ -2616,10 +3533,16 @@ func planVNIVPCPeering(agent *agentapi.Agent, spec *dozer.Spec, peeringName stri
 
 	if vpc1Attached && !vpc2Attached || !agent.Spec.Config.LoopbackWorkaround {
 		spec.VRFs[vrf1Name].BGP.IPv4Unicast.ImportVRFs[vrf2Name] = &dozer.SpecVRFBGPImportVRF{}
+		if ipv6Peering {
+			spec.VRFs[vrf1Name].BGP.IPv6Unicast.ImportVRFs[vrf2Name] = &dozer.SpecVRFBGPImportVRF{}
+		}
 	}
 
 	if !vpc1Attached && vpc2Attached || !agent.Spec.Config.LoopbackWorkaround {
 		spec.VRFs[vrf2Name].BGP.IPv4Unicast.ImportVRFs[vrf1Name] = &dozer.SpecVRFBGPImportVRF{}
+		if ipv6Peering {
+			spec.VRFs[vrf2Name].BGP.IPv6Unicast.ImportVRFs[vrf1Name] = &dozer.SpecVRFBGPImportVRF{}
+		}
 	}
 
 	if vpc1Attached && vpc2Attached && agent.Spec.Config.LoopbackWorkaround {
@@ -2670,6 +3593,35 @@ func planVNIVPCPeering(agent *agentapi.Agent, spec *dozer.Spec, peeringName stri
 	return nil
 }
 
+// planVNIVPCPeeringIPv6PrefixList adds IPv6 subnets of the VPC to the IPv6 peers prefix list of the peer VPC
+func planVNIVPCPeeringIPv6PrefixList(agent *agentapi.Agent, spec *dozer.Spec, vpcName, peerName string, vpc vpcapi.VPCSpec) error {
+	peersPrefixList := vpcPeersIPv6PrefixListName(peerName)
+	if spec.PrefixLists[peersPrefixList] == nil {
+		return errors.Errorf("IPv6 peers prefix list for VPC %s not found", peerName)
+	}
+
+	for subnetName, subnet := range vpc.Subnets {
+		if subnet.SubnetIPv6 == "" {
+			continue
+		}
+
+		vni, ok := agent.Spec.Catalog.GetVPCSubnetVNI(vpcName, subnetName)
+		if vni == 0 || !ok {
+			return errors.Errorf("VNI for VPC %s subnet %s not found", vpcName, subnetName)
+		}
+
+		spec.PrefixLists[peersPrefixList].Prefixes[vni] = &dozer.SpecPrefixListEntry{
+			Prefix: dozer.SpecPrefixListPrefix{
+				Prefix: subnet.SubnetIPv6,
+				Le:     128,
+			},
+			Action: dozer.SpecPrefixListActionPermit,
+		}
+	}
+
+	return nil
+}
+
 func planL3FlatVPCPeering(agent *agentapi.Agent, spec *dozer.Spec, peering vpcapi.VPCPeeringSpec, vpc1Name, vpc2Name string, vpc1, vpc2 vpcapi.VPCSpec) error {
 	vpc1Allow := map[string]map[string]bool{}
 	vpc2Allow := map[string]map[string]bool{}
@@ -2784,7 +3736,7 @@ func planHostBGPSubnet(agent *agentapi.Agent, spec *dozer.Spec, vpcName string,
 	}
 
 	vpcFilteringACL := vpcFilteringAccessListName(vpcName, subnetName)
-	spec.ACLs[vpcFilteringACL], err = buildVNIVPCFilteringACL(agent, vpcName, vpc, subnetName, subnet)
+	spec.ACLs[vpcFilteringACL], err = buildVNIVPCFilteringACL(agent, vpcName, vpc, subnetName, subnet, false)
 	if err != nil {
 		return errors.Wrapf(err, "failed to plan VPC filtering ACL for VPC %s hostBGP subnet %s", vpcName, subnetName)
 	}
@@ -2859,6 +3811,17 @@ func planVNIVPCSubnet(agent *agentapi.Agent, spec *dozer.Spec, vpcName string, v
 		},
 	}
 
+	if subnet.SubnetIPv6 != "" {
+		subnetIPv6CIDR, err := iputil.ParseCIDR(subnet.SubnetIPv6)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse IPv6 subnet %s for VPC %s", subnet.SubnetIPv6, vpcName)
+		}
+
+		spec.Interfaces[subnetIface].VLANAnycastGatewayIPv6 = []string{
+			fmt.Sprintf("%s/%d", subnet.GatewayIPv6, subnetIPv6CIDR.Subnet.Bits()),
+		}
+	}
+
 	spec.VRFs[vrfName].Interfaces[subnetIface] = &dozer.SpecVRFInterface{}
 	spec.VRFs[vrfName].AttachedHosts[subnetIface] = &dozer.SpecVRFAttachedHost{}
 
@@ -2867,11 +3830,22 @@ func planVNIVPCSubnet(agent *agentapi.Agent, spec *dozer.Spec, vpcName string, v
 		Ingress: pointer.To(vpcFilteringACL),
 	}
 
-	spec.ACLs[vpcFilteringACL], err = buildVNIVPCFilteringACL(agent, vpcName, vpc, subnetName, subnet)
+	spec.ACLs[vpcFilteringACL], err = buildVNIVPCFilteringACL(agent, vpcName, vpc, subnetName, subnet, false)
 	if err != nil {
 		return errors.Wrapf(err, "failed to plan VPC filtering ACL for VPC %s subnet %s", vpcName, subnetName)
 	}
 
+	// dual-stack subnets get the same isolation, restriction and permit semantics for the IPv6 traffic
+	if subnet.SubnetIPv6 != "" {
+		vpcFilteringIPv6ACL := vpcFilteringIPv6AccessListName(vpcName, subnetName)
+		spec.ACLInterfaces[subnetIface].IngressIPv6 = pointer.To(vpcFilteringIPv6ACL)
+
+		spec.ACLs[vpcFilteringIPv6ACL], err = buildVNIVPCFilteringACL(agent, vpcName, vpc, subnetName, subnet, true)
+		if err != nil {
+			return errors.Wrapf(err, "failed to plan VPC filtering IPv6 ACL for VPC %s subnet %s", vpcName, subnetName)
+		}
+	}
+
 	if agent.IsSpineLeaf() {
 		spec.SuppressVLANNeighs[subnetIface] = &dozer.SpecSuppressVLANNeigh{}
 
@@ -2992,8 +3966,11 @@ func planL3FlatVPCSubnet(agent *agentapi.Agent, spec *dozer.Spec, vpcName string
 	return nil
 }
 
-func buildVNIVPCFilteringACL(agent *agentapi.Agent, vpcName string, vpc vpcapi.VPCSpec, subnetName string, subnet *vpcapi.VPCSubnet) (*dozer.SpecACL, error) {
+// buildVNIVPCFilteringACL builds the VPC subnet filtering ACL for the IPv4 or IPv6 (dual-stack subnets only) traffic,
+// the entries for both families are using the same sequence numbers derived from the IPv4 subnet IDs
+func buildVNIVPCFilteringACL(agent *agentapi.Agent, vpcName string, vpc vpcapi.VPCSpec, subnetName string, subnet *vpcapi.VPCSubnet, ipv6 bool) (*dozer.SpecACL, error) {
 	acl := &dozer.SpecACL{
+		IPv6: ipv6,
 		Entries: map[uint32]*dozer.SpecACLEntry{
 			65535: {
 				Action: dozer.SpecACLEntryActionAccept,
@@ -3002,22 +3979,23 @@ func buildVNIVPCFilteringACL(agent *agentapi.Agent, vpcName string, vpc vpcapi.V
 	}
 
 	if vpc.IsSubnetRestricted(subnetName) {
+		prefix := vpcSubnetPrefix(subnet, ipv6)
 		acl.Entries[1] = &dozer.SpecACLEntry{
-			SourceAddress:      pointer.To(subnet.Subnet),
-			DestinationAddress: pointer.To(subnet.Subnet),
+			SourceAddress:      pointer.To(prefix),
+			DestinationAddress: pointer.To(prefix),
 			Action:             dozer.SpecACLEntryActionDrop,
 		}
 	}
 
 	denySubnets := map[string]bool{}
 
-	for otherSubnetName, otherSubnet := range vpc.Subnets {
+	for otherSubnetName := range vpc.Subnets {
 		if otherSubnetName == subnetName {
 			continue
 		}
 
 		if vpc.IsSubnetIsolated(otherSubnetName) {
-			denySubnets[otherSubnet.Subnet] = true
+			denySubnets[otherSubnetName] = true
 		}
 	}
 
@@ -3031,28 +4009,34 @@ func buildVNIVPCFilteringACL(agent *agentapi.Agent, vpcName string, vpc vpcapi.V
 				continue
 			}
 
-			if otherSubnet, ok := vpc.Subnets[otherSubnetName]; ok {
-				delete(denySubnets, otherSubnet.Subnet)
+			if _, ok := vpc.Subnets[otherSubnetName]; ok {
+				delete(denySubnets, otherSubnetName)
 			} else {
 				return nil, errors.Errorf("permit policy #%d: subnet %s not found in VPC %s", permitIdx, otherSubnetName, vpcName)
 			}
 		}
 	}
 
-	for subnet := range denySubnets {
-		subnetID := agent.Spec.Catalog.SubnetIDs[subnet]
+	for otherSubnetName := range denySubnets {
+		otherSubnet := vpc.Subnets[otherSubnetName]
+		prefix := vpcSubnetPrefix(otherSubnet, ipv6)
+		if prefix == "" {
+			continue
+		}
+
+		subnetID := agent.Spec.Catalog.SubnetIDs[otherSubnet.Subnet]
 		if subnetID == 0 {
-			return nil, errors.Errorf("no subnet id found for vpc %s subnet %s", vpcName, subnet)
+			return nil, errors.Errorf("no subnet id found for vpc %s subnet %s", vpcName, otherSubnet.Subnet)
 		}
 		if subnetID < 100 {
-			return nil, errors.Errorf("subnet id for vpc %s subnet %s is too small", vpcName, subnet)
+			return nil, errors.Errorf("subnet id for vpc %s subnet %s is too small", vpcName, otherSubnet.Subnet)
 		}
 		if subnetID >= 65000 {
-			return nil, errors.Errorf("subnet id for vpc %s subnet %s is too large", vpcName, subnet)
+			return nil, errors.Errorf("subnet id for vpc %s subnet %s is too large", vpcName, otherSubnet.Subnet)
 		}
 
 		acl.Entries[subnetID] = &dozer.SpecACLEntry{
-			DestinationAddress: pointer.To(subnet),
+			DestinationAddress: pointer.To(prefix),
 			Action:             dozer.SpecACLEntryActionDiscard,
 		}
 	}
@@ -3060,6 +4044,18 @@ func buildVNIVPCFilteringACL(agent *agentapi.Agent, vpcName string, vpc vpcapi.V
 	return acl, nil
 }
 
+// vpcSubnetPrefix returns the subnet prefix of the requested family, it's empty for IPv6 if the subnet isn't dual-stack
+func vpcSubnetPrefix(subnet *vpcapi.VPCSubnet, ipv6 bool) string {
+	if subnet == nil {
+		return ""
+	}
+	if ipv6 {
+		return subnet.SubnetIPv6
+	}
+
+	return subnet.Subnet
+}
+
 func extendVPCFilteringACL(agent *agentapi.Agent, spec *dozer.Spec, vpc1Name, vpc2Name string, vpc1, vpc2 vpcapi.VPCSpec, vpcPeering vpcapi.VPCPeeringSpec) error {
 	vpc1Deny := map[string]map[string]bool{}
 	vpc2Deny := map[string]map[string]bool{}
@@ -3142,6 +4138,67 @@ func addVNIVPCFilteringACLEntryiesForVPC(agent *agentapi.Agent, spec *dozer.Spec
 					Action:             dozer.SpecACLEntryActionDrop,
 				}
 			}
+
+			ipv6ACLName := vpcFilteringIPv6AccessListName(vpc1Name, vpc1SubnetName)
+			if spec.ACLs[ipv6ACLName] != nil && vpc2Subnet.SubnetIPv6 != "" {
+				spec.ACLs[ipv6ACLName].Entries[subnetID] = &dozer.SpecACLEntry{
+					DestinationAddress: pointer.To(vpc2Subnet.SubnetIPv6),
+					Action:             dozer.SpecACLEntryActionDrop,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// planSecurityPolicy adds the policy statements to the filtering ACLs of the VPC subnets configured on the switch, the
+// statements are using sequence numbers below the subnet IDs so they take precedence over isolation and peering rules
+func planSecurityPolicy(agent *agentapi.Agent, spec *dozer.Spec, policyName string, policy vpcapi.SecurityPolicySpec) error {
+	vpc, exists := agent.Spec.VPCs[policy.VPC]
+	if !exists {
+		return errors.Errorf("VPC %s not found", policy.VPC)
+	}
+	if vpc.Mode != vpcapi.VPCModeL2VNI && vpc.Mode != vpcapi.VPCModeL3VNI {
+		return nil
+	}
+	if policy.Peering != "" {
+		// peering scoped policy is only applied while the peering is configured on the switch
+		if _, exists := agent.Spec.VPCPeerings[policy.Peering]; !exists {
+			return nil
+		}
+	}
+
+	for subnetName := range vpc.Subnets {
+		if !policy.AppliesTo(subnetName) {
+			continue
+		}
+
+		aclName := vpcFilteringAccessListName(policy.VPC, subnetName)
+		acl, exists := spec.ACLs[aclName]
+		if !exists {
+			continue
+		}
+
+		for _, stmt := range policy.Statements {
+			// security policies are IPv4 only, so the VPC filtering IPv6 ACLs are left as is
+			if !stmt.IsIPv4() {
+				continue
+			}
+			if stmt.Seq < vpcapi.ACLUserMinSeq || stmt.Seq > vpcapi.SecurityPolicyMaxSeq {
+				return errors.Errorf("invalid statement with sequence number %d out of the allowed range", stmt.Seq)
+			}
+
+			seq := uint32(stmt.Seq)
+			if _, exists := acl.Entries[seq]; exists {
+				return errors.Errorf("statement %d conflicts with existing entry in ACL %s", stmt.Seq, aclName)
+			}
+
+			entry, err := aclStatementToEntry(stmt)
+			if err != nil {
+				return err
+			}
+			acl.Entries[seq] = entry
 		}
 	}
 
@@ -3341,7 +4398,7 @@ func planExternalPeerings(agent *agentapi.Agent, spec *dozer.Spec) error {
 			if external.Static == nil && external.InboundCommunity != "" {
 				commListMatch = pointer.To(extInboundCommListName(externalName))
 			}
-			spec.RouteMaps[importVrfRouteMap].Statements[fmt.Sprintf("%d", 50000+idx)] = &dozer.SpecRouteMapStatement{
+			importStatement := &dozer.SpecRouteMapStatement{
 				Conditions: dozer.SpecRouteMapConditions{
 					MatchCommunityList: commListMatch,
 					MatchPrefixList:    pointer.To(importVrfPrefixList),
@@ -3349,6 +4406,39 @@ func planExternalPeerings(agent *agentapi.Agent, spec *dozer.Spec) error {
 				SetLocalPreference: pointer.To(uint32(ExternalPreference)),
 				Result:             dozer.SpecRouteMapResultAccept,
 			}
+			if policy := peering.Permit.External.RoutePolicy; policy != nil {
+				if policy.LocalPreference > 0 {
+					importStatement.SetLocalPreference = pointer.To(policy.LocalPreference)
+				}
+
+				// route map conditions could only reference a single community list, so the route policy is
+				// matched in a separate route map called from the import statement
+				if len(policy.ASPaths) > 0 || len(policy.Communities) > 0 {
+					policyName := vpcExtImportPolicyName(vpcName, externalName)
+					policyStatement := &dozer.SpecRouteMapStatement{
+						Result: dozer.SpecRouteMapResultAccept,
+					}
+					if len(policy.ASPaths) > 0 {
+						spec.AsPathLists[policyName] = &dozer.SpecAsPathList{
+							Members: slices.Clone(policy.ASPaths),
+						}
+						policyStatement.Conditions.MatchAsPathList = pointer.To(policyName)
+					}
+					if len(policy.Communities) > 0 {
+						spec.CommunityLists[policyName] = &dozer.SpecCommunityList{
+							Members: slices.Clone(policy.Communities),
+						}
+						policyStatement.Conditions.MatchCommunityList = pointer.To(policyName)
+					}
+					spec.RouteMaps[policyName] = &dozer.SpecRouteMap{
+						Statements: map[string]*dozer.SpecRouteMapStatement{
+							"10": policyStatement,
+						},
+					}
+					importStatement.Conditions.Call = pointer.To(policyName)
+				}
+			}
+			spec.RouteMaps[importVrfRouteMap].Statements[fmt.Sprintf("%d", 50000+idx)] = importStatement
 
 			spec.VRFs[extVrf].BGP.IPv4Unicast.ImportVRFs[vpcVrf] = &dozer.SpecVRFBGPImportVRF{}
 			spec.VRFs[vpcVrf].BGP.IPv4Unicast.ImportVRFs[extVrf] = &dozer.SpecVRFBGPImportVRF{}
@@ -3519,6 +4609,21 @@ func portChannelName(id uint16) string {
 	return fmt.Sprintf("PortChannel%d", id)
 }
 
+// externalConnInterface returns the interface used by the external connection, the physical port for the single link
+// or the port channel for the bundled links
+func externalConnInterface(agent *agentapi.Agent, connName string, conn *wiringapi.ConnExternal) (string, error) {
+	if !conn.IsBundled() {
+		return conn.Link.Switch.LocalPortName(), nil
+	}
+
+	portChan := agent.Spec.Catalog.PortChannelIDs[connName]
+	if portChan == 0 {
+		return "", errors.Errorf("no port channel found for external conn %s", connName)
+	}
+
+	return portChannelName(portChan), nil
+}
+
 func vlanName(vlan uint16) string {
 	return fmt.Sprintf("Vlan%d", vlan)
 }
@@ -3583,6 +4688,11 @@ func vpcExtImportVrfRouteMapName(vpc string) string {
 	return fmt.Sprintf("import-vrf--%s", vpc)
 }
 
+// route-map, AS-path and community lists to match the external peering route policy, called from the VPC import route-map
+func vpcExtImportPolicyName(vpc, ext string) string {
+	return fmt.Sprintf("import-policy--%s--%s", vpc, ext)
+}
+
 func vpcPeersCommListName(vpc string) string {
 	return fmt.Sprintf("vpc-peers--%s", vpc)
 }
@@ -3599,6 +4709,22 @@ func vpcNotSubnetsPrefixListName(vpc string) string {
 	return fmt.Sprintf("vpc-not-subnets--%s", vpc)
 }
 
+func vpcPeersIPv6PrefixListName(vpc string) string {
+	return fmt.Sprintf("vpc-peers-v6--%s", vpc)
+}
+
+func vpcSubnetsIPv6PrefixListName(vpc string) string {
+	return fmt.Sprintf("vpc-subnets-v6--%s", vpc)
+}
+
+func vpcNotSubnetsIPv6PrefixListName(vpc string) string {
+	return fmt.Sprintf("vpc-not-subnets-v6--%s", vpc)
+}
+
+func vpcImportVrfIPv6RouteMapName(vpc string) string {
+	return fmt.Sprintf("import-vrf-v6--%s", vpc)
+}
+
 func vpcStaticExtSubnetsPrefixListName(vpc string) string {
 	return fmt.Sprintf("vpc-static-ext-subnets--%s", vpc)
 }
@@ -3615,6 +4741,18 @@ func extInboundACLName(attachName string) string {
 	return fmt.Sprintf("ext-inbound--%s", attachName)
 }
 
+func extInboundIPv6ACLName(attachName string) string {
+	return fmt.Sprintf("ext-inbound-ipv6--%s", attachName)
+}
+
+func extOutboundACLName(attachName string) string {
+	return fmt.Sprintf("ext-outbound--%s", attachName)
+}
+
+func extOutboundIPv6ACLName(attachName string) string {
+	return fmt.Sprintf("ext-outbound-ipv6--%s", attachName)
+}
+
 func vpcRedistributeConnectedRouteMapName(vpc string) string {
 	return fmt.Sprintf("vpc-redistribute-connected--%s", vpc)
 }
@@ -3635,6 +4773,10 @@ func vpcFilteringAccessListName(vpc string, subnet string) string {
 	return fmt.Sprintf("vpc-filtering--%s--%s", vpc, subnet)
 }
 
+func vpcFilteringIPv6AccessListName(vpc string, subnet string) string {
+	return fmt.Sprintf("vpc-filtering-ipv6--%s--%s", vpc, subnet)
+}
+
 func vpcSubnetVIPsOnlyPrefixListName(vpc string, subnet string) string {
 	return fmt.Sprintf("vips-only--%s--%s", vpc, subnet)
 }
@@ -3670,7 +4812,7 @@ func communityForVPC(agent *agentapi.Agent, vpc string) (string, error) {
 }
 
 func planAllPortsUp(agent *agentapi.Agent, spec *dozer.Spec) error {
-	if !agent.Spec.Switch.EnableAllPorts {
+	if !agent.Spec.Switch.IsAllPortsEnabled() {
 		return nil
 	}
 
@@ -3862,6 +5004,56 @@ func translatePortNames(agent *agentapi.Agent, spec *dozer.Spec) error {
 	}
 	spec.ErrDisableInterfaces = newErrDisableIfaces
 
+	newStormControls := map[string]*dozer.SpecStormControl{}
+	for name, sc := range spec.StormControls {
+		portName := name
+		if isHedgehogPortName(name) {
+			portName, err = getNOSPortName(ports, name)
+			if err != nil {
+				return errors.Wrapf(err, "failed to translate port name for storm control %s", name)
+			}
+		}
+
+		newStormControls[portName] = sc
+	}
+	spec.StormControls = newStormControls
+
+	if spec.SFlow != nil {
+		newSFlowIfaces := map[string]*dozer.SpecSFlowInterface{}
+		for name, iface := range spec.SFlow.Interfaces {
+			portName := name
+			if isHedgehogPortName(name) {
+				portName, err = getNOSPortName(ports, name)
+				if err != nil {
+					return errors.Wrapf(err, "failed to translate port name for sflow interface %s", name)
+				}
+			}
+
+			newSFlowIfaces[portName] = iface
+		}
+		spec.SFlow.Interfaces = newSFlowIfaces
+	}
+
+	for name, session := range spec.MirrorSessions {
+		for idx, port := range session.SourcePorts {
+			if isHedgehogPortName(port) {
+				session.SourcePorts[idx], err = getNOSPortName(ports, port)
+				if err != nil {
+					return errors.Wrapf(err, "failed to translate source port name for mirror session %s", name)
+				}
+			}
+		}
+		sort.Strings(session.SourcePorts)
+
+		if session.DestinationPort != nil && isHedgehogPortName(*session.DestinationPort) {
+			portName, err := getNOSPortName(ports, *session.DestinationPort)
+			if err != nil {
+				return errors.Wrapf(err, "failed to translate destination port name for mirror session %s", name)
+			}
+			session.DestinationPort = pointer.To(portName)
+		}
+	}
+
 	for vrfName, vrf := range spec.VRFs {
 		newIfaces := map[string]*dozer.SpecVRFInterface{}
 		for name, iface := range vrf.Interfaces {
@@ -0,0 +1,117 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	"go.githedgehog.com/fabric/api/meta"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+func TestPlanAAA(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		aaa      *agentapi.AAAConfigCreds
+		expected *dozer.SpecAAA
+		err      bool
+	}{
+		{
+			name: "not-configured",
+		},
+		{
+			name: "no-servers",
+			aaa:  &agentapi.AAAConfigCreds{Secret: "s3cr3t"},
+		},
+		{
+			name: "no-secret",
+			aaa: &agentapi.AAAConfigCreds{
+				AAAConfig: meta.AAAConfig{
+					Servers: []meta.AAAServer{{Host: "10.0.0.1", Protocol: meta.AAAProtocolTACACS}},
+				},
+			},
+			err: true,
+		},
+		{
+			name: "tacacs-defaults",
+			aaa: &agentapi.AAAConfigCreds{
+				AAAConfig: meta.AAAConfig{
+					Servers: []meta.AAAServer{
+						{Host: "10.0.0.1", Protocol: meta.AAAProtocolTACACS},
+						{Host: "10.0.0.2", Protocol: meta.AAAProtocolTACACS},
+					},
+				},
+				Secret: "s3cr3t",
+			},
+			expected: &dozer.SpecAAA{
+				LoginMethods:   []string{AAAMethodTACACS, AAAMethodLocal},
+				Failthrough:    pointer.To(true),
+				AdminPrivLevel: pointer.To(uint8(meta.AAADefaultAdminPrivLevel)),
+				TACACSServers: map[string]*dozer.SpecAAAServer{
+					"10.0.0.1": {
+						Port:     pointer.To(uint16(meta.AAATACACSDefaultPort)),
+						Secret:   pointer.To("s3cr3t"),
+						Priority: pointer.To(uint8(2)),
+						VRF:      pointer.To(meta.ObservabilityVRFDefault),
+					},
+					"10.0.0.2": {
+						Port:     pointer.To(uint16(meta.AAATACACSDefaultPort)),
+						Secret:   pointer.To("s3cr3t"),
+						Priority: pointer.To(uint8(1)),
+						VRF:      pointer.To(meta.ObservabilityVRFDefault),
+					},
+				},
+				RADIUSServers: map[string]*dozer.SpecAAAServer{},
+			},
+		},
+		{
+			name: "radius-local-first",
+			aaa: &agentapi.AAAConfigCreds{
+				AAAConfig: meta.AAAConfig{
+					Servers: []meta.AAAServer{
+						{Host: "10.0.0.1", Port: 1645, Protocol: meta.AAAProtocolRADIUS},
+					},
+					VRF:            meta.ObservabilityVRFMgmt,
+					AdminPrivLevel: 10,
+					LoginOrder:     []meta.AAALoginMethod{meta.AAALoginMethodLocal, meta.AAALoginMethodRADIUS},
+				},
+				Secret: "s3cr3t",
+			},
+			expected: &dozer.SpecAAA{
+				LoginMethods:   []string{AAAMethodLocal, AAAMethodRADIUS},
+				Failthrough:    pointer.To(true),
+				AdminPrivLevel: pointer.To(uint8(10)),
+				TACACSServers:  map[string]*dozer.SpecAAAServer{},
+				RADIUSServers: map[string]*dozer.SpecAAAServer{
+					"10.0.0.1": {
+						Port:     pointer.To(uint16(1645)),
+						Secret:   pointer.To("s3cr3t"),
+						Priority: pointer.To(uint8(1)),
+						VRF:      pointer.To(meta.ObservabilityVRFMgmt),
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := &agentapi.Agent{}
+			agent.Spec.Config.AAA = tt.aaa
+
+			spec := &dozer.Spec{}
+
+			err := planAAA(agent, spec)
+			if tt.err {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, spec.AAA)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+func TestPlanPortChannelLACP(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		lacp     *wiringapi.ConnLACP
+		expected *dozer.SpecPortChannelConfig
+	}{
+		{
+			name:     "no-lacp",
+			expected: &dozer.SpecPortChannelConfig{Fallback: pointer.To(false)},
+		},
+		{
+			name:     "empty",
+			lacp:     &wiringapi.ConnLACP{},
+			expected: &dozer.SpecPortChannelConfig{Fallback: pointer.To(false)},
+		},
+		{
+			name: "slow",
+			lacp: &wiringapi.ConnLACP{
+				Rate:           wiringapi.ConnLACPRateSlow,
+				MinLinks:       2,
+				SystemPriority: 100,
+			},
+			expected: &dozer.SpecPortChannelConfig{
+				Fallback:       pointer.To(false),
+				MinLinks:       pointer.To(uint16(2)),
+				SystemPriority: pointer.To(uint16(100)),
+			},
+		},
+		{
+			name: "fast-default-priority",
+			lacp: &wiringapi.ConnLACP{
+				Rate:           wiringapi.ConnLACPRateFast,
+				MinLinks:       DefaultLACPMinLinks,
+				SystemPriority: DefaultLACPSystemPriority,
+			},
+			expected: &dozer.SpecPortChannelConfig{
+				Fallback: pointer.To(false),
+				FastRate: pointer.To(true),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			pcConfig := &dozer.SpecPortChannelConfig{Fallback: pointer.To(false)}
+			planPortChannelLACP(pcConfig, tt.lacp)
+			require.Equal(t, tt.expected, pcConfig)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+func TestPlanMirrorSessions(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		sessions map[string]wiringapi.MirrorSessionSpec
+		expected map[string]*dozer.SpecMirrorSession
+		err      bool
+	}{
+		{
+			name:     "none",
+			expected: map[string]*dozer.SpecMirrorSession{},
+		},
+		{
+			name: "span",
+			sessions: map[string]wiringapi.MirrorSessionSpec{
+				"span": {
+					Sources:   []string{"E1/2", "E1/1"},
+					Direction: wiringapi.MirrorDirectionRX,
+					SPAN:      &wiringapi.MirrorSPAN{Port: "E1/10"},
+				},
+			},
+			expected: map[string]*dozer.SpecMirrorSession{
+				"span": {
+					SourcePorts:     []string{"E1/2", "E1/1"},
+					Direction:       pointer.To(MirrorDirectionRX),
+					DestinationPort: pointer.To("E1/10"),
+				},
+			},
+		},
+		{
+			name: "erspan",
+			sessions: map[string]wiringapi.MirrorSessionSpec{
+				"erspan": {
+					Sources: []string{"E1/1"},
+					ERSPAN: &wiringapi.MirrorERSPAN{
+						DestinationIP: "10.10.0.1",
+						VPC:           "vpc-01",
+						DSCP:          8,
+						TTL:           64,
+					},
+				},
+			},
+			expected: map[string]*dozer.SpecMirrorSession{
+				"erspan": {
+					SourcePorts:   []string{"E1/1"},
+					Direction:     pointer.To(MirrorDirectionBoth),
+					SourceIP:      pointer.To("172.30.8.1"),
+					DestinationIP: pointer.To("10.10.0.1"),
+					VRF:           pointer.To(vpcVrfName("vpc-01")),
+					DSCP:          pointer.To(uint8(8)),
+					TTL:           pointer.To(uint8(64)),
+				},
+			},
+		},
+		{
+			name: "no-destination",
+			sessions: map[string]wiringapi.MirrorSessionSpec{
+				"broken": {Sources: []string{"E1/1"}},
+			},
+			err: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := &agentapi.Agent{}
+			agent.Name = "leaf-01"
+			agent.Spec.Switch.ProtocolIP = "172.30.8.1/32"
+			agent.Spec.MirrorSessions = tt.sessions
+
+			spec := &dozer.Spec{MirrorSessions: map[string]*dozer.SpecMirrorSession{}}
+			err := planMirrorSessions(agent, spec)
+			if tt.err {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, spec.MirrorSessions)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	"go.githedgehog.com/fabric/api/meta"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+func TestPlanSFlow(t *testing.T) {
+	fabric := meta.ObservabilitySFlow{
+		Collector:       "10.0.0.1",
+		SamplingRate:    4096,
+		PollingInterval: 20,
+	}
+
+	for _, tt := range []struct {
+		name      string
+		supported bool
+		fabric    meta.ObservabilitySFlow
+		sflow     *wiringapi.SwitchSFlow
+		expected  *dozer.SpecSFlow
+		err       bool
+	}{
+		{
+			name:      "not-configured",
+			supported: true,
+		},
+		{
+			name:   "not-supported",
+			fabric: fabric,
+		},
+		{
+			name:      "fabric-wide",
+			supported: true,
+			fabric:    fabric,
+			expected: &dozer.SpecSFlow{
+				Enabled:         pointer.To(true),
+				PollingInterval: pointer.To(uint16(20)),
+				Collectors: map[string]*dozer.SpecSFlowCollector{
+					SFlowCollectorName: {
+						Address: pointer.To("10.0.0.1"),
+						Port:    pointer.To(uint16(meta.SFlowDefaultCollectorPort)),
+						VRF:     pointer.To(meta.ObservabilityVRFDefault),
+					},
+				},
+				Interfaces: map[string]*dozer.SpecSFlowInterface{
+					"E1/1": {Enabled: pointer.To(true), SampleRate: pointer.To(uint32(4096))},
+					"E1/2": {Enabled: pointer.To(true), SampleRate: pointer.To(uint32(4096))},
+				},
+			},
+		},
+		{
+			name:      "switch-overrides",
+			supported: true,
+			fabric:    fabric,
+			sflow: &wiringapi.SwitchSFlow{
+				ObservabilitySFlow: meta.ObservabilitySFlow{
+					Collector: "10.0.0.2:6000",
+					VRF:       meta.ObservabilityVRFMgmt,
+				},
+				Ports: []string{"E1/2"},
+			},
+			expected: &dozer.SpecSFlow{
+				Enabled:         pointer.To(true),
+				PollingInterval: pointer.To(uint16(20)),
+				Collectors: map[string]*dozer.SpecSFlowCollector{
+					SFlowCollectorName: {
+						Address: pointer.To("10.0.0.2"),
+						Port:    pointer.To(uint16(6000)),
+						VRF:     pointer.To(meta.ObservabilityVRFMgmt),
+					},
+				},
+				Interfaces: map[string]*dozer.SpecSFlowInterface{
+					"E1/1": {Enabled: pointer.To(false)},
+					"E1/2": {Enabled: pointer.To(true), SampleRate: pointer.To(uint32(4096))},
+				},
+			},
+		},
+		{
+			name:      "switch-disabled",
+			supported: true,
+			fabric:    fabric,
+			sflow:     &wiringapi.SwitchSFlow{Disabled: true},
+		},
+		{
+			name:      "invalid-collector",
+			supported: true,
+			fabric:    meta.ObservabilitySFlow{Collector: "collector"},
+			err:       true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := &agentapi.Agent{}
+			agent.Name = "leaf-01"
+			agent.Spec.SwitchProfile = &wiringapi.SwitchProfileSpec{
+				Features: wiringapi.SwitchProfileFeatures{SFlow: tt.supported},
+			}
+			agent.Spec.Config.SFlow = tt.fabric
+			agent.Spec.Switch.SFlow = tt.sflow
+
+			spec := &dozer.Spec{
+				Interfaces: map[string]*dozer.SpecInterface{
+					"M1":       {},
+					"E1/1":     {},
+					"E1/2":     {},
+					"Vlan1000": {},
+				},
+			}
+			err := planSFlow(agent, spec)
+			if tt.err {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, spec.SFlow)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	"go.githedgehog.com/fabric/api/meta"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+func TestPlanSNMP(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		snmp     *agentapi.SNMPConfigCreds
+		expected *dozer.SpecSNMP
+		acl      *dozer.SpecACL
+		err      bool
+	}{
+		{
+			name: "not-configured",
+		},
+		{
+			name: "no-users",
+			snmp: &agentapi.SNMPConfigCreds{},
+		},
+		{
+			name: "no-keys",
+			snmp: &agentapi.SNMPConfigCreds{
+				SNMPConfig: meta.SNMPConfig{
+					Users: []meta.SNMPUser{{Name: "monitor", SecretName: "snmp-monitor"}},
+				},
+			},
+			err: true,
+		},
+		{
+			name: "defaults",
+			snmp: &agentapi.SNMPConfigCreds{
+				SNMPConfig: meta.SNMPConfig{
+					Users: []meta.SNMPUser{{Name: "monitor", SecretName: "snmp-monitor"}},
+				},
+				UserKeys: map[string]agentapi.SNMPUserKeys{
+					"monitor": {Auth: "authpass1", Priv: "privpass1"},
+				},
+			},
+			expected: &dozer.SpecSNMP{
+				Users: map[string]*dozer.SpecSNMPUser{
+					"monitor": {
+						AuthType: pointer.To(SNMPAuthTypeSHA),
+						AuthKey:  pointer.To("authpass1"),
+						PrivType: pointer.To(SNMPPrivTypeAES),
+						PrivKey:  pointer.To("privpass1"),
+					},
+				},
+			},
+		},
+		{
+			name: "full",
+			snmp: &agentapi.SNMPConfigCreds{
+				SNMPConfig: meta.SNMPConfig{
+					Users: []meta.SNMPUser{{
+						Name:         "monitor",
+						AuthProtocol: meta.SNMPAuthProtocolMD5,
+						PrivProtocol: meta.SNMPPrivProtocolDES,
+						SecretName:   "snmp-monitor",
+					}},
+					ManagerPrefixes: []string{"10.10.0.0/24", "10.20.0.5/32"},
+					Location:        "DC1 Row 2",
+					Contact:         "noc@example.com",
+				},
+				UserKeys: map[string]agentapi.SNMPUserKeys{
+					"monitor": {Auth: "authpass1", Priv: "privpass1"},
+				},
+			},
+			expected: &dozer.SpecSNMP{
+				Location: pointer.To("DC1 Row 2"),
+				Contact:  pointer.To("noc@example.com"),
+				Users: map[string]*dozer.SpecSNMPUser{
+					"monitor": {
+						AuthType: pointer.To(SNMPAuthTypeMD5),
+						AuthKey:  pointer.To("authpass1"),
+						PrivType: pointer.To(SNMPPrivTypeDES),
+						PrivKey:  pointer.To("privpass1"),
+					},
+				},
+			},
+			acl: &dozer.SpecACL{
+				Description: pointer.To("Allow SNMP only from the managers"),
+				Entries: map[uint32]*dozer.SpecACLEntry{
+					10: {
+						Protocol:        dozer.SpecACLEntryProtocolUDP,
+						SourceAddress:   pointer.To("10.10.0.0/24"),
+						DestinationPort: pointer.To(uint16(SNMPPort)),
+						Action:          dozer.SpecACLEntryActionAccept,
+					},
+					20: {
+						Protocol:        dozer.SpecACLEntryProtocolUDP,
+						SourceAddress:   pointer.To("10.20.0.5/32"),
+						DestinationPort: pointer.To(uint16(SNMPPort)),
+						Action:          dozer.SpecACLEntryActionAccept,
+					},
+					65000: {
+						Protocol:        dozer.SpecACLEntryProtocolUDP,
+						DestinationPort: pointer.To(uint16(SNMPPort)),
+						Action:          dozer.SpecACLEntryActionDrop,
+					},
+					65535: {
+						Action: dozer.SpecACLEntryActionAccept,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := &agentapi.Agent{}
+			agent.Spec.Config.SNMP = tt.snmp
+
+			spec := &dozer.Spec{
+				ACLs:          map[string]*dozer.SpecACL{},
+				ACLInterfaces: map[string]*dozer.SpecACLInterface{},
+			}
+
+			err := planSNMP(agent, spec)
+			if tt.err {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, spec.SNMP)
+			if tt.acl == nil {
+				require.Empty(t, spec.ACLs)
+				require.Empty(t, spec.ACLInterfaces)
+			} else {
+				require.Equal(t, tt.acl, spec.ACLs[SNMPManagersACL])
+				require.Equal(t, &dozer.SpecACLInterface{Ingress: pointer.To(SNMPManagersACL)}, spec.ACLInterfaces[CtrlPlaneIface])
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+func TestPlanStormControl(t *testing.T) {
+	link := func(sw, port string) wiringapi.ServerToSwitchLink {
+		return wiringapi.ServerToSwitchLink{
+			Server: wiringapi.BasePortName{Port: "server-01/" + port},
+			Switch: wiringapi.BasePortName{Port: sw + "/" + port},
+		}
+	}
+
+	for _, tt := range []struct {
+		name     string
+		switchSC *wiringapi.StormControl
+		conns    map[string]wiringapi.ConnectionSpec
+		expected map[string]*dozer.SpecStormControl
+	}{
+		{
+			name: "none",
+			conns: map[string]wiringapi.ConnectionSpec{
+				"unbundled": {Unbundled: &wiringapi.ConnUnbundled{Link: link("leaf-01", "E1/1")}},
+			},
+			expected: map[string]*dozer.SpecStormControl{},
+		},
+		{
+			name: "switch-default",
+			switchSC: &wiringapi.StormControl{
+				Unit:      wiringapi.StormControlUnitKbps,
+				Broadcast: 1000,
+			},
+			conns: map[string]wiringapi.ConnectionSpec{
+				"unbundled": {Unbundled: &wiringapi.ConnUnbundled{Link: link("leaf-01", "E1/1")}},
+				"fabric":    {Fabric: &wiringapi.ConnFabric{}},
+			},
+			expected: map[string]*dozer.SpecStormControl{
+				"E1/1": {Broadcast: &dozer.SpecStormControlThreshold{KBPS: pointer.To(uint64(1000))}},
+			},
+		},
+		{
+			name: "connection-override",
+			switchSC: &wiringapi.StormControl{
+				Unit:      wiringapi.StormControlUnitKbps,
+				Broadcast: 1000,
+			},
+			conns: map[string]wiringapi.ConnectionSpec{
+				"unbundled": {Unbundled: &wiringapi.ConnUnbundled{Link: link("leaf-01", "E1/1")}},
+				"eslag": {ESLAG: &wiringapi.ConnESLAG{
+					Links: []wiringapi.ServerToSwitchLink{link("leaf-01", "E1/2"), link("leaf-02", "E1/3")},
+					ServerFacingConnectionConfig: wiringapi.ServerFacingConnectionConfig{
+						StormControl: &wiringapi.StormControl{
+							Unit:           wiringapi.StormControlUnitPPS,
+							UnknownUnicast: 100,
+							Multicast:      200,
+						},
+					},
+				}},
+			},
+			expected: map[string]*dozer.SpecStormControl{
+				"E1/1": {Broadcast: &dozer.SpecStormControlThreshold{KBPS: pointer.To(uint64(1000))}},
+				"E1/2": {
+					UnknownUnicast:   &dozer.SpecStormControlThreshold{PPS: pointer.To(uint64(100))},
+					UnknownMulticast: &dozer.SpecStormControlThreshold{PPS: pointer.To(uint64(200))},
+				},
+			},
+		},
+		{
+			name: "mclag-skipped",
+			switchSC: &wiringapi.StormControl{
+				Unit:      wiringapi.StormControlUnitKbps,
+				Broadcast: 1000,
+			},
+			conns: map[string]wiringapi.ConnectionSpec{
+				"mclag": {MCLAG: &wiringapi.ConnMCLAG{
+					Links: []wiringapi.ServerToSwitchLink{link("leaf-01", "E1/4"), link("leaf-02", "E1/5")},
+				}},
+			},
+			expected: map[string]*dozer.SpecStormControl{},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := &agentapi.Agent{}
+			agent.Name = "leaf-01"
+			agent.Spec.Switch.StormControl = tt.switchSC
+			agent.Spec.Connections = tt.conns
+
+			spec := &dozer.Spec{StormControls: map[string]*dozer.SpecStormControl{}}
+			require.NoError(t, planStormControl(agent, spec))
+			require.Equal(t, tt.expected, spec.StormControls)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	"go.githedgehog.com/fabric/api/meta"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+func TestPlanSyslog(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		servers  []meta.ObservabilitySyslogServer
+		expected map[string]*dozer.SpecSyslogServer
+		err      bool
+	}{
+		{
+			name:     "none",
+			expected: map[string]*dozer.SpecSyslogServer{},
+		},
+		{
+			name: "defaults",
+			servers: []meta.ObservabilitySyslogServer{
+				{Host: "10.0.0.1"},
+			},
+			expected: map[string]*dozer.SpecSyslogServer{
+				"10.0.0.1": {
+					Port:     pointer.To(uint16(meta.SyslogDefaultPort)),
+					Protocol: pointer.To(SyslogProtocolUDP),
+					VRF:      pointer.To(VRFDefault),
+				},
+			},
+		},
+		{
+			name: "full",
+			servers: []meta.ObservabilitySyslogServer{
+				{
+					Host:            "10.0.0.1",
+					Port:            1514,
+					Protocol:        meta.SyslogProtocolTCP,
+					VRF:             meta.ObservabilityVRFMgmt,
+					Severity:        meta.SyslogSeverityInfo,
+					SourceInterface: meta.SyslogSourceInterfaceManagement,
+				},
+				{
+					Host:            "10.0.0.2",
+					Severity:        meta.SyslogSeverityError,
+					SourceInterface: meta.SyslogSourceInterfaceLoopback,
+				},
+			},
+			expected: map[string]*dozer.SpecSyslogServer{
+				"10.0.0.1": {
+					Port:            pointer.To(uint16(1514)),
+					Protocol:        pointer.To(SyslogProtocolTCP),
+					VRF:             pointer.To(meta.ObservabilityVRFMgmt),
+					Severity:        pointer.To(SyslogSeverityInformational),
+					SourceInterface: pointer.To(MgmtIface),
+				},
+				"10.0.0.2": {
+					Port:            pointer.To(uint16(meta.SyslogDefaultPort)),
+					Protocol:        pointer.To(SyslogProtocolUDP),
+					VRF:             pointer.To(VRFDefault),
+					Severity:        pointer.To(SyslogSeverityError),
+					SourceInterface: pointer.To(LoopbackProto),
+				},
+			},
+		},
+		{
+			name: "invalid-severity",
+			servers: []meta.ObservabilitySyslogServer{
+				{Host: "10.0.0.1", Severity: "verbose"},
+			},
+			err: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := &agentapi.Agent{}
+			agent.Spec.Config.SyslogServers = tt.servers
+
+			spec := &dozer.Spec{
+				SyslogServers: map[string]*dozer.SpecSyslogServer{},
+			}
+
+			err := planSyslog(agent, spec)
+			if tt.err {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, spec.SyslogServers)
+		})
+	}
+}
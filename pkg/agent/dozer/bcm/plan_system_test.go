@@ -0,0 +1,119 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	"go.githedgehog.com/fabric/api/meta"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+func TestPlanSystem(t *testing.T) {
+	fabric := meta.SystemConfig{
+		NTP: meta.NTPConfig{
+			Servers: []meta.NTPServer{{Address: "10.0.0.1", Prefer: true}, {Address: "10.0.0.2"}},
+			VRF:     meta.ObservabilityVRFMgmt,
+		},
+		DNS: meta.DNSConfig{
+			Servers:      []string{"10.0.0.53"},
+			SearchDomain: "example.com",
+		},
+		Timezone: "Europe/Berlin",
+	}
+
+	for _, tt := range []struct {
+		name       string
+		fabric     meta.SystemConfig
+		sw         *meta.SystemConfig
+		ntp        *dozer.SpecNTP
+		ntpServers map[string]*dozer.SpecNTPServer
+		dns        *dozer.SpecDNS
+		timezone   *dozer.SpecTimezone
+	}{
+		{
+			name: "not-configured",
+			ntp:  &dozer.SpecNTP{SourceInterface: []string{MgmtIface}},
+			ntpServers: map[string]*dozer.SpecNTPServer{
+				"172.30.0.1": {Prefer: pointer.To(true)},
+			},
+		},
+		{
+			name:   "fabric-wide",
+			fabric: fabric,
+			ntp: &dozer.SpecNTP{
+				SourceInterface: []string{MgmtIface},
+				VRF:             pointer.To(meta.ObservabilityVRFMgmt),
+			},
+			ntpServers: map[string]*dozer.SpecNTPServer{
+				"10.0.0.1": {Prefer: pointer.To(true)},
+				"10.0.0.2": {},
+			},
+			dns: &dozer.SpecDNS{
+				SearchDomain: pointer.To("example.com"),
+				Servers: map[string]*dozer.SpecDNSServer{
+					"10.0.0.53": {},
+				},
+			},
+			timezone: &dozer.SpecTimezone{Name: pointer.To("Europe/Berlin")},
+		},
+		{
+			name:   "switch-overrides",
+			fabric: fabric,
+			sw: &meta.SystemConfig{
+				NTP:      meta.NTPConfig{VRF: meta.ObservabilityVRFDefault},
+				DNS:      meta.DNSConfig{Servers: []string{"10.1.0.53", "10.1.1.53"}},
+				Timezone: TimezoneUTC,
+			},
+			ntp: &dozer.SpecNTP{SourceInterface: []string{MgmtIface}},
+			ntpServers: map[string]*dozer.SpecNTPServer{
+				"10.0.0.1": {Prefer: pointer.To(true)},
+				"10.0.0.2": {},
+			},
+			dns: &dozer.SpecDNS{
+				SearchDomain: pointer.To("example.com"),
+				Servers: map[string]*dozer.SpecDNSServer{
+					"10.1.0.53": {},
+					"10.1.1.53": {},
+				},
+			},
+		},
+		{
+			name: "switch-only-search-domain",
+			sw:   &meta.SystemConfig{DNS: meta.DNSConfig{SearchDomain: "example.com"}},
+			ntp:  &dozer.SpecNTP{SourceInterface: []string{MgmtIface}},
+			ntpServers: map[string]*dozer.SpecNTPServer{
+				"172.30.0.1": {Prefer: pointer.To(true)},
+			},
+			dns: &dozer.SpecDNS{
+				SearchDomain: pointer.To("example.com"),
+				Servers:      map[string]*dozer.SpecDNSServer{},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			agent := &agentapi.Agent{}
+			agent.Spec.Config.ControlVIP = "172.30.0.1/32"
+			agent.Spec.Config.System = tt.fabric
+			agent.Spec.Switch.System = tt.sw
+
+			spec := &dozer.Spec{
+				NTP:        &dozer.SpecNTP{},
+				NTPServers: map[string]*dozer.SpecNTPServer{},
+			}
+
+			require.NoError(t, planNTP(agent, spec))
+			require.NoError(t, planDNS(agent, spec))
+			require.NoError(t, planTimezone(agent, spec))
+
+			require.Equal(t, tt.ntp, spec.NTP)
+			require.Equal(t, tt.ntpServers, spec.NTPServers)
+			require.Equal(t, tt.dns, spec.DNS)
+			require.Equal(t, tt.timezone, spec.Timezone)
+		})
+	}
+}
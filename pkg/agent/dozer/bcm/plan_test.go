@@ -148,6 +148,7 @@ func TestTranslatePortNames(t *testing.T) {
 					"Ethernet26": {Groups: []string{"group1"}},
 				},
 				ErrDisableInterfaces: map[string]*dozer.SpecErrDisable{},
+				StormControls:        map[string]*dozer.SpecStormControl{},
 			},
 		},
 	} {
@@ -273,7 +274,7 @@ func TestPlan(t *testing.T) {
 			mock := newGNMIMock()
 			bp.client = mock
 			bp.skipCustomFuncs = true
-			_, err = bp.ApplyActions(t.Context(), actions)
+			_, err = bp.ApplyActions(t.Context(), ag, nil, actions)
 			require.NoError(t, err, "applying actions to mock gnmi client")
 
 			state, err := mock.StateMap()
@@ -0,0 +1,177 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	vpcapi "go.githedgehog.com/fabric/api/vpc/v1beta1"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+func TestBuildVNIVPCFilteringACLIPv6(t *testing.T) {
+	agent := &agentapi.Agent{}
+	agent.Spec.Catalog.SubnetIDs = map[string]uint32{
+		"10.0.1.0/24": 101,
+		"10.0.2.0/24": 102,
+		"10.0.3.0/24": 103,
+		"10.0.4.0/24": 104,
+	}
+
+	vpc := vpcapi.VPCSpec{
+		Subnets: map[string]*vpcapi.VPCSubnet{
+			"web": {
+				Subnet:     "10.0.1.0/24",
+				SubnetIPv6: "fd00:1::/64",
+				Restricted: pointer.To(true),
+			},
+			"db": {
+				Subnet:     "10.0.2.0/24",
+				SubnetIPv6: "fd00:2::/64",
+				Isolated:   pointer.To(true),
+			},
+			"legacy": {
+				Subnet:   "10.0.3.0/24",
+				Isolated: pointer.To(true),
+			},
+			"mgmt": {
+				Subnet:     "10.0.4.0/24",
+				SubnetIPv6: "fd00:4::/64",
+				Isolated:   pointer.To(true),
+			},
+		},
+		Permit: [][]string{{"web", "mgmt"}},
+	}
+
+	acl, err := buildVNIVPCFilteringACL(agent, "vpc-1", vpc, "web", vpc.Subnets["web"], false)
+	require.NoError(t, err)
+	require.Equal(t, &dozer.SpecACL{
+		Entries: map[uint32]*dozer.SpecACLEntry{
+			1: {
+				SourceAddress:      pointer.To("10.0.1.0/24"),
+				DestinationAddress: pointer.To("10.0.1.0/24"),
+				Action:             dozer.SpecACLEntryActionDrop,
+			},
+			102: {
+				DestinationAddress: pointer.To("10.0.2.0/24"),
+				Action:             dozer.SpecACLEntryActionDiscard,
+			},
+			103: {
+				DestinationAddress: pointer.To("10.0.3.0/24"),
+				Action:             dozer.SpecACLEntryActionDiscard,
+			},
+			65535: {
+				Action: dozer.SpecACLEntryActionAccept,
+			},
+		},
+	}, acl)
+
+	acl, err = buildVNIVPCFilteringACL(agent, "vpc-1", vpc, "web", vpc.Subnets["web"], true)
+	require.NoError(t, err)
+	require.Equal(t, &dozer.SpecACL{
+		IPv6: true,
+		Entries: map[uint32]*dozer.SpecACLEntry{
+			1: {
+				SourceAddress:      pointer.To("fd00:1::/64"),
+				DestinationAddress: pointer.To("fd00:1::/64"),
+				Action:             dozer.SpecACLEntryActionDrop,
+			},
+			102: {
+				DestinationAddress: pointer.To("fd00:2::/64"),
+				Action:             dozer.SpecACLEntryActionDiscard,
+			},
+			65535: {
+				Action: dozer.SpecACLEntryActionAccept,
+			},
+		},
+	}, acl)
+}
+
+func TestPlanSecurityPolicyIPv6(t *testing.T) {
+	agent := &agentapi.Agent{}
+	agent.Spec.VPCs = map[string]vpcapi.VPCSpec{
+		"vpc-1": {
+			Mode: vpcapi.VPCModeL2VNI,
+			Subnets: map[string]*vpcapi.VPCSubnet{
+				"web": {Subnet: "10.0.1.0/24", SubnetIPv6: "fd00:1::/64"},
+			},
+		},
+	}
+
+	spec := &dozer.Spec{
+		ACLs: map[string]*dozer.SpecACL{
+			vpcFilteringAccessListName("vpc-1", "web"): {
+				Entries: map[uint32]*dozer.SpecACLEntry{65535: {Action: dozer.SpecACLEntryActionAccept}},
+			},
+			vpcFilteringIPv6AccessListName("vpc-1", "web"): {
+				IPv6:    true,
+				Entries: map[uint32]*dozer.SpecACLEntry{65535: {Action: dozer.SpecACLEntryActionAccept}},
+			},
+		},
+	}
+
+	require.NoError(t, planSecurityPolicy(agent, spec, "no-ssh", vpcapi.SecurityPolicySpec{
+		VPC: "vpc-1",
+		Statements: []vpcapi.ACLStatement{
+			{Seq: 10, Action: vpcapi.ACLActionDeny, Protocol: vpcapi.ACLProtocolTCP, SrcPrefix: vpcapi.ACLAny, DstPrefix: vpcapi.ACLAny, PortRangeBegin: 22, PortRangeEnd: 22},
+			{Seq: 20, Action: vpcapi.ACLActionDeny, Protocol: vpcapi.ACLProtocolICMP, SrcPrefix: vpcapi.ACLAny, DstPrefix: vpcapi.ACLAny},
+		},
+	}))
+
+	ipv4 := spec.ACLs[vpcFilteringAccessListName("vpc-1", "web")]
+	require.Contains(t, ipv4.Entries, uint32(10))
+	require.Contains(t, ipv4.Entries, uint32(20))
+
+	ipv6 := spec.ACLs[vpcFilteringIPv6AccessListName("vpc-1", "web")]
+	require.NotContains(t, ipv6.Entries, uint32(10))
+	require.NotContains(t, ipv6.Entries, uint32(20))
+}
+
+func TestPlanSecurityPolicyPeering(t *testing.T) {
+	agent := &agentapi.Agent{}
+	agent.Spec.VPCs = map[string]vpcapi.VPCSpec{
+		"vpc-1": {
+			Mode: vpcapi.VPCModeL2VNI,
+			Subnets: map[string]*vpcapi.VPCSubnet{
+				"web": {Subnet: "10.0.1.0/24"},
+			},
+		},
+	}
+	policy := vpcapi.SecurityPolicySpec{
+		VPC:     "vpc-1",
+		Peering: "vpc-1--vpc-2",
+		Statements: []vpcapi.ACLStatement{
+			{Seq: 10, Action: vpcapi.ACLActionDeny, Protocol: vpcapi.ACLProtocolTCP, SrcPrefix: vpcapi.ACLAny, DstPrefix: "10.0.2.0/24", PortRangeBegin: 22, PortRangeEnd: 22},
+		},
+	}
+	newSpec := func() *dozer.Spec {
+		return &dozer.Spec{
+			ACLs: map[string]*dozer.SpecACL{
+				vpcFilteringAccessListName("vpc-1", "web"): {
+					Entries: map[uint32]*dozer.SpecACLEntry{65535: {Action: dozer.SpecACLEntryActionAccept}},
+				},
+			},
+		}
+	}
+
+	spec := newSpec()
+	require.NoError(t, planSecurityPolicy(agent, spec, "no-ssh-to-vpc-2", policy))
+	require.NotContains(t, spec.ACLs[vpcFilteringAccessListName("vpc-1", "web")].Entries, uint32(10))
+
+	agent.Spec.VPCPeerings = map[string]vpcapi.VPCPeeringSpec{
+		"vpc-1--vpc-2": {Permit: []map[string]vpcapi.VPCPeer{{"vpc-1": {}, "vpc-2": {}}}},
+	}
+
+	spec = newSpec()
+	require.NoError(t, planSecurityPolicy(agent, spec, "no-ssh-to-vpc-2", policy))
+	require.Equal(t, &dozer.SpecACLEntry{
+		Protocol:           dozer.SpecACLEntryProtocolTCP,
+		DestinationAddress: pointer.To("10.0.2.0/24"),
+		DestinationPort:    pointer.To(uint16(22)),
+		Action:             dozer.SpecACLEntryActionDrop,
+	}, spec.ACLs[vpcFilteringAccessListName("vpc-1", "web")].Entries[10])
+}
@@ -204,16 +204,112 @@ func (p *BroadcomProcessor) CalculateActions(_ context.Context, actual, desired
 	return actions.actions, nil
 }
 
-func (p *BroadcomProcessor) ApplyActions(ctx context.Context, actions []dozer.Action) ([]string, error) {
+func (p *BroadcomProcessor) ApplyActions(ctx context.Context, agent *agentapi.Agent, previous *dozer.Spec, actions []dozer.Action) ([]string, error) {
 	if p.client == nil {
 		return nil, errors.New("gnmi client is not set")
 	}
 
+	applyErr := p.applyActions(ctx, actions, p.skipCustomFuncs)
+	if applyErr == nil || previous == nil {
+		return nil, applyErr
+	}
+
+	slog.Warn("Failed to apply actions, rolling back to the previous state", "err", applyErr)
+
+	if err := p.rollback(ctx, agent, previous); err != nil {
+		slog.Error("Failed to roll back to the previous state", "err", err)
+
+		return nil, fmt.Errorf("%w: %w: rollback: %w", dozer.ErrRollbackFailed, applyErr, err)
+	}
+
+	slog.Info("Rolled back to the previous state")
+
+	return nil, fmt.Errorf("%w: %w", dozer.ErrRolledBack, applyErr)
+}
+
+// rollback brings the partially configured switch back to the previous actual state by applying the inverse actions
+func (p *BroadcomProcessor) rollback(ctx context.Context, agent *agentapi.Agent, previous *dozer.Spec) error {
+	partial, err := p.LoadActualState(ctx, agent)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load partially applied state")
+	}
+
+	target, err := previous.Clone()
+	if err != nil {
+		return errors.Wrapf(err, "failed to clone previous state")
+	}
+
+	keepSecretParts(target, partial)
+
+	actions, err := p.CalculateActions(ctx, partial, target)
+	if err != nil {
+		return errors.Wrapf(err, "failed to calculate rollback actions")
+	}
+
+	slog.Info("Rolling back", "actions", len(actions))
+
+	// custom funcs run outside of gNMI (e.g. installing authorized keys), so they are not rolled back
+	if err := p.applyActions(ctx, actions, true); err != nil {
+		return errors.Wrapf(err, "failed to apply rollback actions")
+	}
+
+	return nil
+}
+
+// keepSecretParts keeps the partially applied state of everything carrying secrets in the rollback target, as secrets
+// are either never loaded from the switch or only loaded in the stored form, so they can't be pushed back
+func keepSecretParts(target, partial *dozer.Spec) {
+	target.Users = partial.Users
+	target.AAA = partial.AAA
+
+	switch {
+	case partial.SNMP != nil && target.SNMP == nil:
+		target.SNMP = &dozer.SpecSNMP{Users: partial.SNMP.Users}
+	case partial.SNMP != nil:
+		target.SNMP.Users = partial.SNMP.Users
+	case target.SNMP != nil:
+		target.SNMP.Users = nil
+	}
+
+	for vrfName, vrf := range target.VRFs {
+		if vrf == nil || vrf.BGP == nil {
+			continue
+		}
+
+		var partialNeighbors map[string]*dozer.SpecVRFBGPNeighbor
+		if partialVRF := partial.VRFs[vrfName]; partialVRF != nil && partialVRF.BGP != nil {
+			partialNeighbors = partialVRF.BGP.Neighbors
+		}
+
+		for name, neighbor := range vrf.BGP.Neighbors {
+			if neighbor == nil || neighbor.Password == nil {
+				continue
+			}
+			if partialNeighbor, ok := partialNeighbors[name]; ok {
+				vrf.BGP.Neighbors[name] = partialNeighbor
+			} else {
+				delete(vrf.BGP.Neighbors, name)
+			}
+		}
+
+		for name, partialNeighbor := range partialNeighbors {
+			if partialNeighbor == nil || partialNeighbor.Password == nil {
+				continue
+			}
+			if vrf.BGP.Neighbors == nil {
+				vrf.BGP.Neighbors = map[string]*dozer.SpecVRFBGPNeighbor{}
+			}
+			vrf.BGP.Neighbors[name] = partialNeighbor
+		}
+	}
+}
+
+func (p *BroadcomProcessor) applyActions(ctx context.Context, actions []dozer.Action, skipCustomFuncs bool) error {
 	for idx, action := range actions {
 		act := action.(*Action)
 
 		if act.CustomFunc != nil {
-			if p.skipCustomFuncs {
+			if skipCustomFuncs {
 				slog.Debug("Action (custom func) skipped", "idx", idx, "weight", act.Weight, "summary", action.Summary())
 
 				continue
@@ -222,7 +318,7 @@ func (p *BroadcomProcessor) ApplyActions(ctx context.Context, actions []dozer.Ac
 
 			err := act.CustomFunc(ctx, p.client)
 			if err != nil {
-				return nil, errors.Wrapf(err, "failed to run custom action")
+				return errors.Wrapf(err, "failed to run custom action")
 			}
 		} else {
 			slog.Debug("Action", "idx", idx, "weight", act.Weight, "summary", action.Summary(), "command", act.Type, "path", act.Path)
@@ -232,7 +328,7 @@ func (p *BroadcomProcessor) ApplyActions(ctx context.Context, actions []dozer.Ac
 			if act.Value != nil && !(reflect.ValueOf(act.Value).Kind() == reflect.Ptr && reflect.ValueOf(act.Value).IsNil()) {
 				ocData, err = gnmi.Marshal(act.Value)
 				if err != nil {
-					return nil, errors.Wrapf(err, "failed to OC marshal gnmi action value")
+					return errors.Wrapf(err, "failed to OC marshal gnmi action value")
 				}
 			}
 
@@ -245,18 +341,18 @@ func (p *BroadcomProcessor) ApplyActions(ctx context.Context, actions []dozer.Ac
 			case ActionTypeDelete:
 				options = append(options, api.Delete(act.Path))
 			default:
-				return nil, errors.Errorf("unsupported gnmi action %+v", act)
+				return errors.Errorf("unsupported gnmi action %+v", act)
 			}
 
 			if err := retrySetRequest(ctx, p.client, act.Path, options...); err != nil {
-				return nil, err
+				return err
 			}
 		}
 
 		slog.Info("Action applied", "idx", idx, "summary", action.Summary())
 	}
 
-	return nil, nil
+	return nil
 }
 
 // retrySetRequest retries a gNMI set requests which failed with recoverable or retriable errors.
@@ -0,0 +1,197 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gnmiproto "github.com/openconfig/gnmi/proto/gnmi"
+	gnmipath "github.com/openconfig/gnmic/pkg/api/path"
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+var errMockSetFailed = errors.New("mock set failed")
+
+// recordingGNMIClient records the paths of all set requests and fails the ones selected by fail
+type recordingGNMIClient struct {
+	*gnmiMockClient
+	fail  func(path string) bool
+	paths []string
+}
+
+func (c *recordingGNMIClient) Set(ctx context.Context, req *gnmiproto.SetRequest) error {
+	paths := []*gnmiproto.Path{}
+	paths = append(paths, req.GetDelete()...)
+	for _, u := range append(req.GetReplace(), req.GetUpdate()...) {
+		paths = append(paths, u.GetPath())
+	}
+
+	for _, p := range paths {
+		path := gnmipath.GnmiPathToXPath(p, false)
+		c.paths = append(c.paths, path)
+		if c.fail != nil && c.fail(path) {
+			return errMockSetFailed
+		}
+	}
+
+	return c.gnmiMockClient.Set(ctx, req)
+}
+
+func TestApplyActionsRollback(t *testing.T) {
+	const (
+		hostnamePath = "/system/config"
+		userName     = "hh-rollback-test"
+		userPath     = "/system/aaa/authentication/users/user[username=" + userName + "]"
+	)
+
+	for _, tt := range []struct {
+		name         string
+		fail         func(path string) bool
+		wantErr      error
+		wantPaths    []string
+		wantHostname string
+	}{
+		{
+			name:    "rolled back",
+			wantErr: dozer.ErrRolledBack,
+			wantPaths: []string{
+				hostnamePath, userPath, // applied before the authorized keys custom func failed
+				hostnamePath, // rollback, user is kept and authorized keys custom func is skipped
+			},
+			wantHostname: "leaf-01",
+		},
+		{
+			name: "rollback failed",
+			fail: func() func(path string) bool {
+				hostnameSets := 0
+
+				return func(path string) bool {
+					if path != hostnamePath {
+						return false
+					}
+					hostnameSets++
+
+					return hostnameSets > 1
+				}
+			}(),
+			wantErr:      dozer.ErrRollbackFailed,
+			wantPaths:    []string{hostnamePath, userPath, hostnamePath},
+			wantHostname: "leaf-02",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ag := &agentapi.Agent{}
+			client := &recordingGNMIClient{gnmiMockClient: newGNMIMock()}
+			bp := &BroadcomProcessor{client: client}
+
+			seed, err := bp.CalculateActions(t.Context(), &dozer.Spec{}, &dozer.Spec{Hostname: pointer.To("leaf-01")})
+			require.NoError(t, err)
+			_, err = bp.ApplyActions(t.Context(), ag, nil, seed)
+			require.NoError(t, err)
+
+			previous, err := bp.LoadActualState(t.Context(), ag)
+			require.NoError(t, err)
+
+			desired, err := previous.Clone()
+			require.NoError(t, err)
+			desired.Hostname = pointer.To("leaf-02")
+			desired.Users = map[string]*dozer.SpecUser{
+				userName: {Role: "admin", Password: "$5$8nAYPGcl4l6G7Av1$Qi4/gnM0yPtGv9kjpMh78NuNSfQWy7vR1rulHpurL36"},
+			}
+
+			// authorized keys custom func fails as the user doesn't exist on the OS running the test
+			actions, err := bp.CalculateActions(t.Context(), previous, desired)
+			require.NoError(t, err)
+
+			client.paths = nil
+			client.fail = tt.fail
+			_, err = bp.ApplyActions(t.Context(), ag, previous, actions)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.wantPaths, client.paths)
+
+			client.fail = nil
+			actual, err := bp.LoadActualState(t.Context(), ag)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantHostname, *actual.Hostname)
+			require.Contains(t, actual.Users, userName, "users carry secrets and are not rolled back")
+		})
+	}
+}
+
+func TestKeepSecretParts(t *testing.T) {
+	target := &dozer.Spec{
+		Hostname: pointer.To("leaf-01"),
+		SNMP: &dozer.SpecSNMP{
+			Location: pointer.To("dc-1"),
+			Users: map[string]*dozer.SpecSNMPUser{
+				"old": {AuthType: pointer.To(SNMPAuthTypeSHA)},
+			},
+		},
+		VRFs: map[string]*dozer.SpecVRF{
+			"VrfV1": {
+				BGP: &dozer.SpecVRFBGP{
+					Neighbors: map[string]*dozer.SpecVRFBGPNeighbor{
+						"10.0.0.1": {RemoteAS: pointer.To(uint32(65101))},
+						"10.0.0.2": {RemoteAS: pointer.To(uint32(65102)), Password: pointer.To("U2FsdGVkX1+stored")},
+						"10.0.0.3": {RemoteAS: pointer.To(uint32(65103)), Password: pointer.To("U2FsdGVkX1+stored")},
+					},
+				},
+			},
+		},
+	}
+	partial := &dozer.Spec{
+		Hostname: pointer.To("leaf-02"),
+		Users: map[string]*dozer.SpecUser{
+			"admin": {Role: "admin"},
+		},
+		AAA: &dozer.SpecAAA{
+			TACACSServers: map[string]*dozer.SpecAAAServer{"10.0.0.10": {Port: pointer.To(uint16(49))}},
+		},
+		SNMP: &dozer.SpecSNMP{
+			Location: pointer.To("dc-2"),
+			Users: map[string]*dozer.SpecSNMPUser{
+				"new": {AuthType: pointer.To(SNMPAuthTypeSHA)},
+			},
+		},
+		VRFs: map[string]*dozer.SpecVRF{
+			"VrfV1": {
+				BGP: &dozer.SpecVRFBGP{
+					Neighbors: map[string]*dozer.SpecVRFBGPNeighbor{
+						"10.0.0.1": {RemoteAS: pointer.To(uint32(65201))},
+						"10.0.0.2": {RemoteAS: pointer.To(uint32(65202)), Password: pointer.To("U2FsdGVkX1+stored")},
+						"10.0.0.4": {RemoteAS: pointer.To(uint32(65204)), Password: pointer.To("U2FsdGVkX1+stored")},
+					},
+				},
+			},
+		},
+	}
+
+	keepSecretParts(target, partial)
+
+	require.Equal(t, &dozer.Spec{
+		Hostname: pointer.To("leaf-01"),
+		Users:    partial.Users,
+		AAA:      partial.AAA,
+		SNMP: &dozer.SpecSNMP{
+			Location: pointer.To("dc-1"),
+			Users:    partial.SNMP.Users,
+		},
+		VRFs: map[string]*dozer.SpecVRF{
+			"VrfV1": {
+				BGP: &dozer.SpecVRFBGP{
+					Neighbors: map[string]*dozer.SpecVRFBGPNeighbor{
+						"10.0.0.1": {RemoteAS: pointer.To(uint32(65101))},
+						"10.0.0.2": {RemoteAS: pointer.To(uint32(65202)), Password: pointer.To("U2FsdGVkX1+stored")},
+						"10.0.0.4": {RemoteAS: pointer.To(uint32(65204)), Password: pointer.To("U2FsdGVkX1+stored")},
+					},
+				},
+			},
+		},
+	}, target)
+}
@@ -56,6 +56,18 @@ var specEnforcer = &DefaultValueEnforcer[string, *dozer.Spec]{
 			return errors.Wrap(err, "failed to handle ntp servers")
 		}
 
+		if err := specDNSEnforcer.Handle(basePath, "", actual.DNS, desired.DNS, actions); err != nil {
+			return errors.Wrap(err, "failed to handle dns")
+		}
+
+		if err := specTimezoneEnforcer.Handle(basePath, "", actual.Timezone, desired.Timezone, actions); err != nil {
+			return errors.Wrap(err, "failed to handle timezone")
+		}
+
+		if err := specSyslogServersEnforcer.Handle(basePath, actual.SyslogServers, desired.SyslogServers, actions); err != nil {
+			return errors.Wrap(err, "failed to handle syslog servers")
+		}
+
 		if err := specPortGroupsEnforcer.Handle(basePath, actual.PortGroups, desired.PortGroups, actions); err != nil {
 			return errors.Wrap(err, "failed to handle port groups")
 		}
@@ -68,6 +80,14 @@ var specEnforcer = &DefaultValueEnforcer[string, *dozer.Spec]{
 			return errors.Wrap(err, "failed to handle users")
 		}
 
+		if err := specAAAEnforcer.Handle(basePath, "", actual.AAA, desired.AAA, actions); err != nil {
+			return errors.Wrap(err, "failed to handle aaa")
+		}
+
+		if err := specSNMPEnforcer.Handle(basePath, "", actual.SNMP, desired.SNMP, actions); err != nil {
+			return errors.Wrap(err, "failed to handle snmp")
+		}
+
 		if err := specUsersAuthorizedKeysEnforcer.Handle(basePath, actual.Users, desired.Users, actions); err != nil {
 			return errors.Wrap(err, "failed to handle users authorized keys")
 		}
@@ -160,6 +180,18 @@ var specEnforcer = &DefaultValueEnforcer[string, *dozer.Spec]{
 			return errors.Wrap(err, "failed to handle neighbor global")
 		}
 
+		if err := specStormControlsEnforcer.Handle(basePath, actual.StormControls, desired.StormControls, actions); err != nil {
+			return errors.Wrap(err, "failed to handle storm controls")
+		}
+
+		if err := specMirrorSessionsEnforcer.Handle(basePath, actual.MirrorSessions, desired.MirrorSessions, actions); err != nil {
+			return errors.Wrap(err, "failed to handle mirror sessions")
+		}
+
+		if err := specSFlowEnforcer.Handle(basePath, "", actual.SFlow, desired.SFlow, actions); err != nil {
+			return errors.Wrap(err, "failed to handle sflow")
+		}
+
 		return nil
 	},
 }
@@ -189,6 +221,18 @@ func loadActualSpec(ctx context.Context, agent *agentapi.Agent, client GNMICClie
 		return errors.Wrapf(err, "failed to load ntp servers")
 	}
 
+	if err := loadActualDNS(ctx, client, spec); err != nil {
+		return errors.Wrapf(err, "failed to load dns")
+	}
+
+	if err := loadActualTimezone(ctx, client, spec); err != nil {
+		return errors.Wrapf(err, "failed to load timezone")
+	}
+
+	if err := loadActualSyslogServers(ctx, client, spec); err != nil {
+		return errors.Wrapf(err, "failed to load syslog servers")
+	}
+
 	if err := loadActualPortGroups(ctx, client, spec); err != nil {
 		return errors.Wrapf(err, "failed to load port groups")
 	}
@@ -201,6 +245,14 @@ func loadActualSpec(ctx context.Context, agent *agentapi.Agent, client GNMICClie
 		return errors.Wrapf(err, "failed to load users")
 	}
 
+	if err := loadActualAAA(ctx, client, spec); err != nil {
+		return errors.Wrapf(err, "failed to load aaa")
+	}
+
+	if err := loadActualSNMP(ctx, client, spec); err != nil {
+		return errors.Wrapf(err, "failed to load snmp")
+	}
+
 	if err := loadActualInterfaces(ctx, agent, client, spec); err != nil {
 		return errors.Wrapf(err, "failed to load interfaces")
 	}
@@ -282,5 +334,17 @@ func loadActualSpec(ctx context.Context, agent *agentapi.Agent, client GNMICClie
 		}
 	}
 
+	if err := loadActualStormControls(ctx, client, spec); err != nil {
+		return errors.Wrapf(err, "failed to load storm controls")
+	}
+
+	if err := loadActualMirrorSessions(ctx, client, spec); err != nil {
+		return errors.Wrapf(err, "failed to load mirror sessions")
+	}
+
+	if err := loadActualSFlow(ctx, client, spec); err != nil {
+		return errors.Wrapf(err, "failed to load sflow")
+	}
+
 	return nil
 }
@@ -0,0 +1,220 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"context"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/pkg/errors"
+	"go.githedgehog.com/fabric-bcm-ygot/pkg/oc"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+const (
+	AAAMethodTACACS = "tacacs+"
+	AAAMethodRADIUS = "radius"
+	AAAMethodLocal  = "local"
+
+	AAAServerGroupTACACS = "TACACS"
+	AAAServerGroupRADIUS = "RADIUS"
+)
+
+var specAAAEnforcer = &DefaultValueEnforcer[string, *dozer.SpecAAA]{
+	Summary: "AAA",
+	CustomHandler: func(basePath string, name string, actual, desired *dozer.SpecAAA, actions *ActionQueue) error {
+		actualTACACS, desiredTACACS := ValueOrNil(actual, desired,
+			func(value *dozer.SpecAAA) map[string]*dozer.SpecAAAServer { return value.TACACSServers })
+		if err := specAAATACACSServersEnforcer.Handle(basePath, actualTACACS, desiredTACACS, actions); err != nil {
+			return errors.Wrap(err, "failed to handle aaa tacacs servers")
+		}
+
+		actualRADIUS, desiredRADIUS := ValueOrNil(actual, desired,
+			func(value *dozer.SpecAAA) map[string]*dozer.SpecAAAServer { return value.RADIUSServers })
+		if err := specAAARADIUSServersEnforcer.Handle(basePath, actualRADIUS, desiredRADIUS, actions); err != nil {
+			return errors.Wrap(err, "failed to handle aaa radius servers")
+		}
+
+		if err := specAAAAuthenticationEnforcer.Handle(basePath, name, actual, desired, actions); err != nil {
+			return errors.Wrap(err, "failed to handle aaa authentication")
+		}
+
+		return nil
+	},
+}
+
+var specAAAAuthenticationEnforcer = &DefaultValueEnforcer[string, *dozer.SpecAAA]{
+	Summary: "AAA authentication",
+	Getter: func(_ string, value *dozer.SpecAAA) any {
+		return []any{value.LoginMethods, value.Failthrough, value.AdminPrivLevel}
+	},
+	Path:   "/system/aaa/authentication/config",
+	Weight: ActionWeightAAA,
+	Marshal: func(_ string, value *dozer.SpecAAA) (ygot.ValidatedGoStruct, error) {
+		methods := []oc.OpenconfigSystem_System_Aaa_Authentication_Config_AuthenticationMethod_Union{}
+		for _, method := range value.LoginMethods {
+			methods = append(methods, oc.UnionString(method))
+		}
+
+		return &oc.OpenconfigSystem_System_Aaa_Authentication{
+			Config: &oc.OpenconfigSystem_System_Aaa_Authentication_Config{
+				AuthenticationMethod: methods,
+				Failthrough:          value.Failthrough,
+				PrivilegeLevel:       value.AdminPrivLevel,
+			},
+		}, nil
+	},
+}
+
+var specAAATACACSServersEnforcer = &DefaultMapEnforcer[string, *dozer.SpecAAAServer]{
+	Summary:      "AAA TACACS+ servers",
+	ValueHandler: specAAATACACSServerEnforcer,
+}
+
+var specAAATACACSServerEnforcer = &DefaultValueEnforcer[string, *dozer.SpecAAAServer]{
+	Summary:      "AAA TACACS+ server %s",
+	Getter:       specAAAServerGetter,
+	Path:         "/system/aaa/server-groups/server-group[name=" + AAAServerGroupTACACS + "]/servers/server[address=%s]",
+	UpdateWeight: ActionWeightAAAServerUpdate,
+	DeleteWeight: ActionWeightAAAServerDelete,
+	Marshal: func(address string, value *dozer.SpecAAAServer) (ygot.ValidatedGoStruct, error) {
+		return &oc.OpenconfigSystem_System_Aaa_ServerGroups_ServerGroup_Servers{
+			Server: map[string]*oc.OpenconfigSystem_System_Aaa_ServerGroups_ServerGroup_Servers_Server{
+				address: {
+					Address: pointer.To(address),
+					Config: &oc.OpenconfigSystem_System_Aaa_ServerGroups_ServerGroup_Servers_Server_Config{
+						Address:  pointer.To(address),
+						Priority: value.Priority,
+						Vrf:      value.VRF,
+					},
+					Tacacs: &oc.OpenconfigSystem_System_Aaa_ServerGroups_ServerGroup_Servers_Server_Tacacs{
+						Config: &oc.OpenconfigSystem_System_Aaa_ServerGroups_ServerGroup_Servers_Server_Tacacs_Config{
+							Port:      value.Port,
+							SecretKey: value.Secret,
+						},
+					},
+				},
+			},
+		}, nil
+	},
+}
+
+var specAAARADIUSServersEnforcer = &DefaultMapEnforcer[string, *dozer.SpecAAAServer]{
+	Summary:      "AAA RADIUS servers",
+	ValueHandler: specAAARADIUSServerEnforcer,
+}
+
+var specAAARADIUSServerEnforcer = &DefaultValueEnforcer[string, *dozer.SpecAAAServer]{
+	Summary:      "AAA RADIUS server %s",
+	Getter:       specAAAServerGetter,
+	Path:         "/system/aaa/server-groups/server-group[name=" + AAAServerGroupRADIUS + "]/servers/server[address=%s]",
+	UpdateWeight: ActionWeightAAAServerUpdate,
+	DeleteWeight: ActionWeightAAAServerDelete,
+	Marshal: func(address string, value *dozer.SpecAAAServer) (ygot.ValidatedGoStruct, error) {
+		return &oc.OpenconfigSystem_System_Aaa_ServerGroups_ServerGroup_Servers{
+			Server: map[string]*oc.OpenconfigSystem_System_Aaa_ServerGroups_ServerGroup_Servers_Server{
+				address: {
+					Address: pointer.To(address),
+					Config: &oc.OpenconfigSystem_System_Aaa_ServerGroups_ServerGroup_Servers_Server_Config{
+						Address:  pointer.To(address),
+						Priority: value.Priority,
+						Vrf:      value.VRF,
+					},
+					Radius: &oc.OpenconfigSystem_System_Aaa_ServerGroups_ServerGroup_Servers_Server_Radius{
+						Config: &oc.OpenconfigSystem_System_Aaa_ServerGroups_ServerGroup_Servers_Server_Radius_Config{
+							AuthPort:  value.Port,
+							SecretKey: value.Secret,
+						},
+					},
+				},
+			},
+		}, nil
+	},
+}
+
+// secret is never loaded as it's only returned encrypted, so it's excluded from the comparison
+func specAAAServerGetter(_ string, value *dozer.SpecAAAServer) any {
+	server := *value
+	server.Secret = nil
+
+	return &server
+}
+
+func loadActualAAA(ctx context.Context, client GNMICClient, spec *dozer.Spec) error {
+	ocAAA := &oc.OpenconfigSystem_System_Aaa{}
+	err := client.Get(ctx, "/system/aaa", ocAAA)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read aaa")
+	}
+	spec.AAA, err = unmarshalOCAAA(ocAAA)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal aaa")
+	}
+
+	return nil
+}
+
+func unmarshalOCAAA(ocVal *oc.OpenconfigSystem_System_Aaa) (*dozer.SpecAAA, error) { //nolint:unparam
+	if ocVal == nil {
+		return nil, nil
+	}
+
+	aaa := &dozer.SpecAAA{
+		TACACSServers: map[string]*dozer.SpecAAAServer{},
+		RADIUSServers: map[string]*dozer.SpecAAAServer{},
+	}
+
+	if ocVal.Authentication != nil && ocVal.Authentication.Config != nil {
+		for _, method := range ocVal.Authentication.Config.AuthenticationMethod {
+			if union, ok := method.(oc.UnionString); ok {
+				aaa.LoginMethods = append(aaa.LoginMethods, string(union))
+			}
+		}
+		aaa.Failthrough = ocVal.Authentication.Config.Failthrough
+		aaa.AdminPrivLevel = ocVal.Authentication.Config.PrivilegeLevel
+	}
+
+	if ocVal.ServerGroups != nil {
+		for name, group := range ocVal.ServerGroups.ServerGroup {
+			if group == nil || group.Servers == nil {
+				continue
+			}
+
+			for address, ocServer := range group.Servers.Server {
+				if ocServer == nil {
+					continue
+				}
+
+				// secret is only returned encrypted, so it's never loaded and not compared
+				server := &dozer.SpecAAAServer{}
+				if ocServer.Config != nil {
+					server.Priority = ocServer.Config.Priority
+					server.VRF = ocServer.Config.Vrf
+				}
+
+				switch name {
+				case AAAServerGroupTACACS:
+					if ocServer.Tacacs != nil && ocServer.Tacacs.Config != nil {
+						server.Port = ocServer.Tacacs.Config.Port
+					}
+					aaa.TACACSServers[address] = server
+				case AAAServerGroupRADIUS:
+					if ocServer.Radius != nil && ocServer.Radius.Config != nil {
+						server.Port = ocServer.Radius.Config.AuthPort
+					}
+					aaa.RADIUSServers[address] = server
+				}
+			}
+		}
+	}
+
+	// local only authentication without any servers is the NOS default that's the same as AAA not configured
+	if len(aaa.TACACSServers) == 0 && len(aaa.RADIUSServers) == 0 &&
+		(len(aaa.LoginMethods) == 0 || len(aaa.LoginMethods) == 1 && aaa.LoginMethods[0] == AAAMethodLocal) {
+		return nil, nil
+	}
+
+	return aaa, nil
+}
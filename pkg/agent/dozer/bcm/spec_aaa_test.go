@@ -0,0 +1,53 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+func TestSpecAAAServerSecretEnforcer(t *testing.T) {
+	const address = "10.0.0.10"
+
+	for _, tt := range []struct {
+		name        string
+		actual      *dozer.SpecAAAServer
+		desired     *dozer.SpecAAAServer
+		wantActions int
+	}{
+		{
+			name:        "secret not loaded",
+			actual:      &dozer.SpecAAAServer{Port: pointer.To(uint16(49)), VRF: pointer.To("mgmt")},
+			desired:     &dozer.SpecAAAServer{Port: pointer.To(uint16(49)), VRF: pointer.To("mgmt"), Secret: pointer.To("secret")},
+			wantActions: 0,
+		},
+		{
+			name:        "server added",
+			desired:     &dozer.SpecAAAServer{Port: pointer.To(uint16(49)), VRF: pointer.To("mgmt"), Secret: pointer.To("secret")},
+			wantActions: 1,
+		},
+		{
+			name:        "other field changed",
+			actual:      &dozer.SpecAAAServer{Port: pointer.To(uint16(49)), VRF: pointer.To("mgmt")},
+			desired:     &dozer.SpecAAAServer{Port: pointer.To(uint16(4949)), VRF: pointer.To("mgmt"), Secret: pointer.To("secret")},
+			wantActions: 1,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, enforcer := range []*DefaultValueEnforcer[string, *dozer.SpecAAAServer]{
+				specAAATACACSServerEnforcer,
+				specAAARADIUSServerEnforcer,
+			} {
+				actions := &ActionQueue{}
+				err := enforcer.Handle("", address, tt.actual, tt.desired, actions)
+				require.NoError(t, err)
+				require.Len(t, actions.actions, tt.wantActions)
+			}
+		})
+	}
+}
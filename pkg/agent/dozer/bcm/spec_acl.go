@@ -36,26 +36,61 @@ var specACLsEnforcer = &DefaultMapEnforcer[string, *dozer.SpecACL]{
 var specACLEnforcer = &DefaultValueEnforcer[string, *dozer.SpecACL]{
 	Summary: "ACL %s",
 	CustomHandler: func(basePath string, name string, actual, desired *dozer.SpecACL, actions *ActionQueue) error {
-		basePath += fmt.Sprintf("/acl/acl-sets/acl-set[name=%s][type=ACL_IPV4]", name)
-
-		// we aren't passing basepath here as we need to custom handle it
-		if err := specACLBaseEnforcer.Handle("", name, actual, desired, actions); err != nil {
-			return errors.Wrap(err, "failed to handle acl base")
-		}
-
-		actualEntries, desiredEntries := ValueOrNil(actual, desired,
-			func(value *dozer.SpecACL) map[uint32]*dozer.SpecACLEntry { return value.Entries })
-		if err := specACLEntriesEnforcer.Handle(basePath, actualEntries, desiredEntries, actions); err != nil {
-			return errors.Wrap(err, "failed to handle acl entries")
+		// ACL type is part of the acl-set key, so changing it requires deleting the old ACL first
+		if actual != nil && desired != nil && actual.IPv6 != desired.IPv6 {
+			if err := handleACL(basePath, name, actual, nil, actions); err != nil {
+				return err
+			}
+			actual = nil
 		}
 
-		return nil
+		return handleACL(basePath, name, actual, desired, actions)
 	},
 }
 
+func handleACL(basePath string, name string, actual, desired *dozer.SpecACL, actions *ActionQueue) error {
+	ipv6 := desired != nil && desired.IPv6 || desired == nil && actual != nil && actual.IPv6
+	basePath += aclSetPath(name, ipv6)
+
+	// we aren't passing basepath here as we need to custom handle it
+	if err := specACLBaseEnforcer.Handle("", name, actual, desired, actions); err != nil {
+		return errors.Wrap(err, "failed to handle acl base")
+	}
+
+	entriesEnforcer := specACLEntriesEnforcer
+	if ipv6 {
+		entriesEnforcer = specACLIPv6EntriesEnforcer
+	}
+
+	actualEntries, desiredEntries := ValueOrNil(actual, desired,
+		func(value *dozer.SpecACL) map[uint32]*dozer.SpecACLEntry { return value.Entries })
+	if err := entriesEnforcer.Handle(basePath, actualEntries, desiredEntries, actions); err != nil {
+		return errors.Wrap(err, "failed to handle acl entries")
+	}
+
+	return nil
+}
+
+func aclType(ipv6 bool) oc.E_OpenconfigAcl_ACL_TYPE {
+	if ipv6 {
+		return oc.OpenconfigAcl_ACL_TYPE_ACL_IPV6
+	}
+
+	return oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4
+}
+
+func aclSetPath(name string, ipv6 bool) string {
+	aclTypeName := "ACL_IPV4"
+	if ipv6 {
+		aclTypeName = "ACL_IPV6"
+	}
+
+	return fmt.Sprintf("/acl/acl-sets/acl-set[name=%s][type=%s]", name, aclTypeName)
+}
+
 var specACLBaseEnforcer = &DefaultValueEnforcer[string, *dozer.SpecACL]{
 	Summary:    "ACL %s base",
-	Path:       "/acl/acl-sets/acl-set[name=%s][type=ACL_IPV4]",
+	PathFunc:   func(name string, value *dozer.SpecACL) string { return aclSetPath(name, value.IPv6) },
 	CreatePath: "/acl/acl-sets/acl-set",
 	MutateDesired: func(key string, desired *dozer.SpecACL) *dozer.SpecACL {
 		if desired != nil && desired.Description == nil {
@@ -71,14 +106,14 @@ var specACLBaseEnforcer = &DefaultValueEnforcer[string, *dozer.SpecACL]{
 		return &oc.OpenconfigAcl_Acl_AclSets{
 			AclSet: map[oc.OpenconfigAcl_Acl_AclSets_AclSet_Key]*oc.OpenconfigAcl_Acl_AclSets_AclSet{
 				{
-					Type: oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4,
+					Type: aclType(value.IPv6),
 					Name: name,
 				}: {
 					Name: pointer.To(name),
-					Type: oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4,
+					Type: aclType(value.IPv6),
 					Config: &oc.OpenconfigAcl_Acl_AclSets_AclSet_Config{
 						Name:        pointer.To(name),
-						Type:        oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4,
+						Type:        aclType(value.IPv6),
 						Description: value.Description,
 					},
 				},
@@ -89,101 +124,140 @@ var specACLBaseEnforcer = &DefaultValueEnforcer[string, *dozer.SpecACL]{
 
 var specACLEntriesEnforcer = &DefaultMapEnforcer[uint32, *dozer.SpecACLEntry]{
 	Summary:      "ACL entries",
-	ValueHandler: specACLEntryEnforcer,
+	ValueHandler: newSpecACLEntryEnforcer(false),
 }
 
-var specACLEntryEnforcer = &DefaultValueEnforcer[uint32, *dozer.SpecACLEntry]{
-	Summary:          "ACL entry %d",
-	Path:             "/acl-entries/acl-entry[sequence-id=%d]",
-	CreatePath:       "/acl-entries/acl-entry",
-	RecreateOnUpdate: true, // TODO validate
-	UpdateWeight:     ActionWeightACLEntryUpdate,
-	DeleteWeight:     ActionWeightACLEntryDelete,
-	Marshal: func(seq uint32, value *dozer.SpecACLEntry) (ygot.ValidatedGoStruct, error) {
-		var action oc.E_OpenconfigAcl_FORWARDING_ACTION
-		switch value.Action {
-		case "":
-			// just unset
-		case dozer.SpecACLEntryActionAccept:
-			action = oc.OpenconfigAcl_FORWARDING_ACTION_ACCEPT
-		case dozer.SpecACLEntryActionDrop:
-			action = oc.OpenconfigAcl_FORWARDING_ACTION_DROP
-		case dozer.SpecACLEntryActionDiscard:
-			action = oc.OpenconfigAcl_FORWARDING_ACTION_DISCARD
-		case dozer.SpecACLEntryActionTransit:
-			action = oc.OpenconfigAcl_FORWARDING_ACTION_TRANSIT
-		default:
-			return nil, errors.Errorf("unknown ACL Entry action: %s", value.Action)
-		}
+var specACLIPv6EntriesEnforcer = &DefaultMapEnforcer[uint32, *dozer.SpecACLEntry]{
+	Summary:      "ACL IPv6 entries",
+	ValueHandler: newSpecACLEntryEnforcer(true),
+}
 
-		var protocol oc.E_OpenconfigPacketMatchTypes_IP_PROTOCOL
-		switch value.Protocol { //nolint:exhaustive
-		case "", dozer.SpecACLEntryProtocolIP:
-			// unset — matches any IP protocol
-		case dozer.SpecACLEntryProtocolTCP:
-			protocol = oc.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_TCP
-		case dozer.SpecACLEntryProtocolUDP:
-			protocol = oc.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_UDP
-		case dozer.SpecACLEntryProtocolICMP:
-			protocol = oc.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_ICMP
-		default:
-			return nil, errors.Errorf("unknown ACL Entry protocol: %s", value.Protocol)
-		}
+// aclProtocolICMPv6 is the IP protocol number of ICMPv6, there is no IP_PROTOCOL identity for it in OpenConfig
+const aclProtocolICMPv6 = 58
+
+func newSpecACLEntryEnforcer(ipv6 bool) *DefaultValueEnforcer[uint32, *dozer.SpecACLEntry] {
+	return &DefaultValueEnforcer[uint32, *dozer.SpecACLEntry]{
+		Summary:          "ACL entry %d",
+		Path:             "/acl-entries/acl-entry[sequence-id=%d]",
+		CreatePath:       "/acl-entries/acl-entry",
+		RecreateOnUpdate: true, // TODO validate
+		UpdateWeight:     ActionWeightACLEntryUpdate,
+		DeleteWeight:     ActionWeightACLEntryDelete,
+		Marshal: func(seq uint32, value *dozer.SpecACLEntry) (ygot.ValidatedGoStruct, error) {
+			return marshalACLEntry(seq, value, ipv6)
+		},
+	}
+}
 
-		transport := &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Transport{
-			Config: &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Transport_Config{},
-		}
-		if value.SourcePort != nil {
-			transport.Config.SourcePort = oc.UnionUint16(*value.SourcePort)
-		}
-		if value.DestinationPort != nil {
-			transport.Config.DestinationPort = oc.UnionUint16(*value.DestinationPort)
+func marshalACLEntry(seq uint32, value *dozer.SpecACLEntry, ipv6 bool) (ygot.ValidatedGoStruct, error) {
+	var action oc.E_OpenconfigAcl_FORWARDING_ACTION
+	switch value.Action {
+	case "":
+		// just unset
+	case dozer.SpecACLEntryActionAccept:
+		action = oc.OpenconfigAcl_FORWARDING_ACTION_ACCEPT
+	case dozer.SpecACLEntryActionDrop:
+		action = oc.OpenconfigAcl_FORWARDING_ACTION_DROP
+	case dozer.SpecACLEntryActionDiscard:
+		action = oc.OpenconfigAcl_FORWARDING_ACTION_DISCARD
+	case dozer.SpecACLEntryActionTransit:
+		action = oc.OpenconfigAcl_FORWARDING_ACTION_TRANSIT
+	default:
+		return nil, errors.Errorf("unknown ACL Entry action: %s", value.Action)
+	}
+
+	var protocol oc.E_OpenconfigPacketMatchTypes_IP_PROTOCOL
+	icmpv6 := false
+	switch value.Protocol { //nolint:exhaustive
+	case "", dozer.SpecACLEntryProtocolIP:
+		// unset — matches any IP protocol
+	case dozer.SpecACLEntryProtocolTCP:
+		protocol = oc.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_TCP
+	case dozer.SpecACLEntryProtocolUDP:
+		protocol = oc.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_UDP
+	case dozer.SpecACLEntryProtocolICMP:
+		if ipv6 {
+			return nil, errors.Errorf("ICMP protocol isn't supported in IPv6 ACL entry %d, use ICMPv6", seq)
 		}
-		if value.DestinationPortRange != nil { // mutually exclusive with DestinationPort; range takes precedence
-			transport.Config.DestinationPort = oc.UnionString(*value.DestinationPortRange)
+		protocol = oc.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_ICMP
+	case dozer.SpecACLEntryProtocolICMPv6:
+		if !ipv6 {
+			return nil, errors.Errorf("ICMPv6 protocol isn't supported in IPv4 ACL entry %d", seq)
 		}
-		if len(value.TCPFlags) > 0 {
-			flags, err := marshalTCPFlags(value.TCPFlags)
-			if err != nil {
-				return nil, err
-			}
-			transport.Config.TcpFlags = flags
+		icmpv6 = true
+	default:
+		return nil, errors.Errorf("unknown ACL Entry protocol: %s", value.Protocol)
+	}
+
+	transport := &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Transport{
+		Config: &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Transport_Config{},
+	}
+	if value.SourcePort != nil {
+		transport.Config.SourcePort = oc.UnionUint16(*value.SourcePort)
+	}
+	if value.DestinationPort != nil {
+		transport.Config.DestinationPort = oc.UnionUint16(*value.DestinationPort)
+	}
+	if value.DestinationPortRange != nil { // mutually exclusive with DestinationPort; range takes precedence
+		transport.Config.DestinationPort = oc.UnionString(*value.DestinationPortRange)
+	}
+	if len(value.TCPFlags) > 0 {
+		flags, err := marshalTCPFlags(value.TCPFlags)
+		if err != nil {
+			return nil, err
 		}
-		if value.TCPSessionEstablished != nil {
-			transport.Config.TcpSessionEstablished = value.TCPSessionEstablished
+		transport.Config.TcpFlags = flags
+	}
+	if value.TCPSessionEstablished != nil {
+		transport.Config.TcpSessionEstablished = value.TCPSessionEstablished
+	}
+	if value.ICMPType != nil {
+		transport.Config.IcmpType = value.ICMPType
+	}
+	if value.ICMPCode != nil {
+		transport.Config.IcmpCode = value.ICMPCode
+	}
+
+	entry := &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry{
+		SequenceId: pointer.To(seq),
+		Config: &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Config{
+			SequenceId:  pointer.To(seq),
+			Description: value.Description,
+		},
+		Actions: &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Actions{
+			Config: &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Actions_Config{
+				ForwardingAction: action,
+			},
+		},
+		Transport: transport,
+	}
+
+	if ipv6 {
+		entry.Ipv6 = &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Ipv6{
+			Config: &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Ipv6_Config{
+				Protocol:           protocol,
+				SourceAddress:      value.SourceAddress,
+				DestinationAddress: value.DestinationAddress,
+			},
 		}
-		if value.ICMPType != nil {
-			transport.Config.IcmpType = value.ICMPType
+		if icmpv6 {
+			entry.Ipv6.Config.Protocol = oc.UnionUint8(aclProtocolICMPv6)
 		}
-		if value.ICMPCode != nil {
-			transport.Config.IcmpCode = value.ICMPCode
+	} else {
+		entry.Ipv4 = &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Ipv4{
+			Config: &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Ipv4_Config{
+				Protocol:           protocol,
+				SourceAddress:      value.SourceAddress,
+				DestinationAddress: value.DestinationAddress,
+			},
 		}
+	}
 
-		return &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries{
-			AclEntry: map[uint32]*oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry{
-				seq: {
-					SequenceId: pointer.To(seq),
-					Config: &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Config{
-						SequenceId:  pointer.To(seq),
-						Description: value.Description,
-					},
-					Actions: &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Actions{
-						Config: &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Actions_Config{
-							ForwardingAction: action,
-						},
-					},
-					Ipv4: &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Ipv4{
-						Config: &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry_Ipv4_Config{
-							Protocol:           protocol,
-							SourceAddress:      value.SourceAddress,
-							DestinationAddress: value.DestinationAddress,
-						},
-					},
-					Transport: transport,
-				},
-			},
-		}, nil
-	},
+	return &oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries{
+		AclEntry: map[uint32]*oc.OpenconfigAcl_Acl_AclSets_AclSet_AclEntries_AclEntry{
+			seq: entry,
+		},
+	}, nil
 }
 
 var specACLInterfacesEnforcer = &DefaultMapEnforcer[string, *dozer.SpecACLInterface]{
@@ -235,22 +309,12 @@ var specACLInterfaceEnforcer = &DefaultValueEnforcer[string, *dozer.SpecACLInter
 			return errors.Wrap(err, "failed to add ACL interface action")
 		}
 
-		if desired.Ingress != nil {
+		if desired.Ingress != nil || desired.IngressIPv6 != nil {
 			ingressVal := &oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets{
-				IngressAclSet: map[oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets_IngressAclSet_Key]*oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets_IngressAclSet{
-					{
-						SetName: *desired.Ingress,
-						Type:    oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4,
-					}: {
-						SetName: desired.Ingress,
-						Type:    oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4,
-						Config: &oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets_IngressAclSet_Config{
-							SetName: desired.Ingress,
-							Type:    oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4,
-						},
-					},
-				},
+				IngressAclSet: map[oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets_IngressAclSet_Key]*oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets_IngressAclSet{},
 			}
+			addIngressACLSet(ingressVal, desired.Ingress, false)
+			addIngressACLSet(ingressVal, desired.IngressIPv6, true)
 
 			if err := actions.Add(&Action{
 				Weight:   ActionWeightACLInterfaceUpdate,
@@ -267,45 +331,61 @@ var specACLInterfaceEnforcer = &DefaultValueEnforcer[string, *dozer.SpecACLInter
 	},
 }
 
+func addIngressACLSet(sets *oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets, setName *string, ipv6 bool) {
+	if setName == nil {
+		return
+	}
+
+	sets.IngressAclSet[oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets_IngressAclSet_Key{
+		SetName: *setName,
+		Type:    aclType(ipv6),
+	}] = &oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets_IngressAclSet{
+		SetName: setName,
+		Type:    aclType(ipv6),
+		Config: &oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets_IngressAclSet_Config{
+			SetName: setName,
+			Type:    aclType(ipv6),
+		},
+	}
+}
+
+func addEgressACLSet(sets *oc.OpenconfigAcl_Acl_Interfaces_Interface_EgressAclSets, setName *string, ipv6 bool) {
+	if setName == nil {
+		return
+	}
+
+	sets.EgressAclSet[oc.OpenconfigAcl_Acl_Interfaces_Interface_EgressAclSets_EgressAclSet_Key{
+		SetName: *setName,
+		Type:    aclType(ipv6),
+	}] = &oc.OpenconfigAcl_Acl_Interfaces_Interface_EgressAclSets_EgressAclSet{
+		SetName: setName,
+		Type:    aclType(ipv6),
+		Config: &oc.OpenconfigAcl_Acl_Interfaces_Interface_EgressAclSets_EgressAclSet_Config{
+			SetName: setName,
+			Type:    aclType(ipv6),
+		},
+	}
+}
+
 // marshalACLInterface builds the ygot value for an ACL interface binding.
 // When includeIngress is false, IngressAclSets is omitted from the result.
 func marshalACLInterface(name string, value *dozer.SpecACLInterface, includeIngress bool) (ygot.ValidatedGoStruct, error) {
 	var ingressACLSets *oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets
-	if includeIngress && value.Ingress != nil {
+	if includeIngress && (value.Ingress != nil || value.IngressIPv6 != nil) {
 		ingressACLSets = &oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets{
-			IngressAclSet: map[oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets_IngressAclSet_Key]*oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets_IngressAclSet{
-				{
-					SetName: *value.Ingress,
-					Type:    oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4,
-				}: {
-					SetName: value.Ingress,
-					Type:    oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4,
-					Config: &oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets_IngressAclSet_Config{
-						SetName: value.Ingress,
-						Type:    oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4,
-					},
-				},
-			},
+			IngressAclSet: map[oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets_IngressAclSet_Key]*oc.OpenconfigAcl_Acl_Interfaces_Interface_IngressAclSets_IngressAclSet{},
 		}
+		addIngressACLSet(ingressACLSets, value.Ingress, false)
+		addIngressACLSet(ingressACLSets, value.IngressIPv6, true)
 	}
 
 	var egressACLSets *oc.OpenconfigAcl_Acl_Interfaces_Interface_EgressAclSets
-	if value.Egress != nil {
+	if value.Egress != nil || value.EgressIPv6 != nil {
 		egressACLSets = &oc.OpenconfigAcl_Acl_Interfaces_Interface_EgressAclSets{
-			EgressAclSet: map[oc.OpenconfigAcl_Acl_Interfaces_Interface_EgressAclSets_EgressAclSet_Key]*oc.OpenconfigAcl_Acl_Interfaces_Interface_EgressAclSets_EgressAclSet{
-				{
-					SetName: *value.Egress,
-					Type:    oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4,
-				}: {
-					SetName: value.Egress,
-					Type:    oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4,
-					Config: &oc.OpenconfigAcl_Acl_Interfaces_Interface_EgressAclSets_EgressAclSet_Config{
-						SetName: value.Egress,
-						Type:    oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4,
-					},
-				},
-			},
+			EgressAclSet: map[oc.OpenconfigAcl_Acl_Interfaces_Interface_EgressAclSets_EgressAclSet_Key]*oc.OpenconfigAcl_Acl_Interfaces_Interface_EgressAclSets_EgressAclSet{},
 		}
+		addEgressACLSet(egressACLSets, value.Egress, false)
+		addEgressACLSet(egressACLSets, value.EgressIPv6, true)
 	}
 
 	ifaceRef := &oc.OpenconfigAcl_Acl_Interfaces_Interface_InterfaceRef_Config{
@@ -360,9 +440,10 @@ func unmarshalOCACLs(ocVal *oc.OpenconfigAcl_Acl) (map[string]*dozer.SpecACL, er
 	}
 
 	for key, acl := range ocVal.AclSets.AclSet {
-		if key.Type != oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4 {
+		if key.Type != oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4 && key.Type != oc.OpenconfigAcl_ACL_TYPE_ACL_IPV6 {
 			continue
 		}
+		ipv6 := key.Type == oc.OpenconfigAcl_ACL_TYPE_ACL_IPV6
 
 		entries := map[uint32]*dozer.SpecACLEntry{}
 		if acl.AclEntries != nil {
@@ -373,30 +454,16 @@ func unmarshalOCACLs(ocVal *oc.OpenconfigAcl_Acl) (map[string]*dozer.SpecACL, er
 
 				var protocol dozer.SpecACLEntryProtocol
 				var sourceAddress, destinationAddress *string
-				if entry.Ipv4 != nil && entry.Ipv4.Config != nil {
-					if entry.Ipv4.Config.SourceAddress != nil {
-						sourceAddress = entry.Ipv4.Config.SourceAddress
-					}
-					if entry.Ipv4.Config.DestinationAddress != nil {
-						destinationAddress = entry.Ipv4.Config.DestinationAddress
-					}
-					// Protocol is a union type (E_IP_PROTOCOL or UnionUint8). When absent
-					// in the gNMI response, ygot leaves the interface as nil — not as the
-					// UNSET enum value (0). Match nil explicitly so that other valid but
-					// unsupported protocols (IP_GRE, IP_AUTH, …) are not silently mapped to IP.
-					switch entry.Ipv4.Config.Protocol {
-					case nil, oc.OpenconfigPacketMatchTypes_IP_PROTOCOL_UNSET:
-						// absent or explicitly unset → match any IP
-						protocol = dozer.SpecACLEntryProtocolIP
-					case oc.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_TCP:
-						protocol = dozer.SpecACLEntryProtocolTCP
-					case oc.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_UDP:
-						protocol = dozer.SpecACLEntryProtocolUDP
-					case oc.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_ICMP:
-						protocol = dozer.SpecACLEntryProtocolICMP
-					}
+				if !ipv6 && entry.Ipv4 != nil && entry.Ipv4.Config != nil {
+					sourceAddress = entry.Ipv4.Config.SourceAddress
+					destinationAddress = entry.Ipv4.Config.DestinationAddress
+					protocol = unmarshalACLEntryProtocol(entry.Ipv4.Config.Protocol, false)
+				} else if ipv6 && entry.Ipv6 != nil && entry.Ipv6.Config != nil {
+					sourceAddress = entry.Ipv6.Config.SourceAddress
+					destinationAddress = entry.Ipv6.Config.DestinationAddress
+					protocol = unmarshalACLEntryProtocol(entry.Ipv6.Config.Protocol, true)
 				} else {
-					// No IPv4 container returned by switch (e.g. entry with no addresses
+					// No IPv4/IPv6 container returned by switch (e.g. entry with no addresses
 					// and no specific protocol) → match any IP
 					protocol = dozer.SpecACLEntryProtocolIP
 				}
@@ -459,6 +526,7 @@ func unmarshalOCACLs(ocVal *oc.OpenconfigAcl_Acl) (map[string]*dozer.SpecACL, er
 
 		acls[key.Name] = &dozer.SpecACL{
 			Description: acl.Config.Description,
+			IPv6:        ipv6,
 			Entries:     entries,
 		}
 	}
@@ -466,6 +534,31 @@ func unmarshalOCACLs(ocVal *oc.OpenconfigAcl_Acl) (map[string]*dozer.SpecACL, er
 	return acls, nil
 }
 
+// unmarshalACLEntryProtocol handles the protocol union type (E_IP_PROTOCOL or UnionUint8) of both IPv4 and IPv6
+// ACL entries. When absent in the gNMI response, ygot leaves the interface as nil — not as the UNSET enum value (0).
+// Match nil explicitly so that other valid but unsupported protocols (IP_GRE, IP_AUTH, …) are not silently mapped to IP.
+func unmarshalACLEntryProtocol(value any, ipv6 bool) dozer.SpecACLEntryProtocol {
+	switch value {
+	case nil, oc.OpenconfigPacketMatchTypes_IP_PROTOCOL_UNSET:
+		// absent or explicitly unset → match any IP
+		return dozer.SpecACLEntryProtocolIP
+	case oc.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_TCP:
+		return dozer.SpecACLEntryProtocolTCP
+	case oc.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_UDP:
+		return dozer.SpecACLEntryProtocolUDP
+	case oc.OpenconfigPacketMatchTypes_IP_PROTOCOL_IP_ICMP:
+		if ipv6 {
+			return dozer.SpecACLEntryProtocolICMPv6
+		}
+
+		return dozer.SpecACLEntryProtocolICMP
+	case oc.UnionUint8(aclProtocolICMPv6):
+		return dozer.SpecACLEntryProtocolICMPv6
+	}
+
+	return dozer.SpecACLEntryProtocolUnset
+}
+
 func loadActualACLInterfaces(ctx context.Context, client GNMICClient, spec *dozer.Spec) error {
 	ocVal := &oc.OpenconfigAcl_Acl{}
 	err := client.Get(ctx, "/acl/interfaces", ocVal, api.DataTypeCONFIG())
@@ -496,29 +589,36 @@ func unmarshalOCACLInterfaces(ocVal *oc.OpenconfigAcl_Acl) (map[string]*dozer.Sp
 		var ingress *string
 		var egress *string
 
+		var ingressIPv6 *string
+		var egressIPv6 *string
+
 		if iface.IngressAclSets != nil {
 			for key, value := range iface.IngressAclSets.IngressAclSet {
-				if key.Type != oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4 {
-					continue
+				switch key.Type { //nolint:exhaustive
+				case oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4:
+					ingress = value.SetName
+				case oc.OpenconfigAcl_ACL_TYPE_ACL_IPV6:
+					ingressIPv6 = value.SetName
 				}
-
-				ingress = value.SetName
 			}
 		}
 
 		if iface.EgressAclSets != nil {
 			for key, value := range iface.EgressAclSets.EgressAclSet {
-				if key.Type != oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4 {
-					continue
+				switch key.Type { //nolint:exhaustive
+				case oc.OpenconfigAcl_ACL_TYPE_ACL_IPV4:
+					egress = value.SetName
+				case oc.OpenconfigAcl_ACL_TYPE_ACL_IPV6:
+					egressIPv6 = value.SetName
 				}
-
-				egress = value.SetName
 			}
 		}
 
 		interfaces[name] = &dozer.SpecACLInterface{
-			Ingress: ingress,
-			Egress:  egress,
+			Ingress:     ingress,
+			Egress:      egress,
+			IngressIPv6: ingressIPv6,
+			EgressIPv6:  egressIPv6,
 		}
 	}
 
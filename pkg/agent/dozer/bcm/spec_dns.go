@@ -0,0 +1,124 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"context"
+
+	"github.com/openconfig/gnmic/pkg/api"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/pkg/errors"
+	"go.githedgehog.com/fabric-bcm-ygot/pkg/oc"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+var specDNSEnforcer = &DefaultValueEnforcer[string, *dozer.SpecDNS]{
+	Summary: "DNS",
+	CustomHandler: func(basePath string, name string, actual, desired *dozer.SpecDNS, actions *ActionQueue) error {
+		if err := specDNSSearchEnforcer.Handle(basePath, name, actual, desired, actions); err != nil {
+			return errors.Wrap(err, "failed to handle dns search domain")
+		}
+
+		actualServers, desiredServers := ValueOrNil(actual, desired,
+			func(value *dozer.SpecDNS) map[string]*dozer.SpecDNSServer { return value.Servers })
+		if err := specDNSServersEnforcer.Handle(basePath, actualServers, desiredServers, actions); err != nil {
+			return errors.Wrap(err, "failed to handle dns servers")
+		}
+
+		return nil
+	},
+}
+
+var specDNSSearchEnforcer = &DefaultValueEnforcer[string, *dozer.SpecDNS]{
+	Summary:       "DNS search domain",
+	MutateActual:  dnsWithSearchDomainOnly,
+	MutateDesired: dnsWithSearchDomainOnly,
+	Path:          "/system/dns/config",
+	UpdateWeight:  ActionWeightDNSUpdate,
+	DeleteWeight:  ActionWeightDNSDelete,
+	Marshal: func(_ string, value *dozer.SpecDNS) (ygot.ValidatedGoStruct, error) {
+		return &oc.OpenconfigSystem_System_Dns{
+			Config: &oc.OpenconfigSystem_System_Dns_Config{
+				Search: []string{*value.SearchDomain},
+			},
+		}, nil
+	},
+}
+
+// dnsWithSearchDomainOnly makes DNS config without search domain nil so it gets deleted instead of updated
+func dnsWithSearchDomainOnly(_ string, value *dozer.SpecDNS) *dozer.SpecDNS {
+	if value == nil || value.SearchDomain == nil {
+		return nil
+	}
+
+	return &dozer.SpecDNS{
+		SearchDomain: value.SearchDomain,
+	}
+}
+
+var specDNSServersEnforcer = &DefaultMapEnforcer[string, *dozer.SpecDNSServer]{
+	Summary:      "DNS servers",
+	ValueHandler: specDNSServerEnforcer,
+}
+
+var specDNSServerEnforcer = &DefaultValueEnforcer[string, *dozer.SpecDNSServer]{
+	Summary:      "DNS server %s",
+	Path:         "/system/dns/servers/server[address=%s]",
+	UpdateWeight: ActionWeightDNSServerUpdate,
+	DeleteWeight: ActionWeightDNSServerDelete,
+	Marshal: func(address string, _ *dozer.SpecDNSServer) (ygot.ValidatedGoStruct, error) {
+		return &oc.OpenconfigSystem_System_Dns_Servers{
+			Server: map[string]*oc.OpenconfigSystem_System_Dns_Servers_Server{
+				address: {
+					Address: pointer.To(address),
+					Config: &oc.OpenconfigSystem_System_Dns_Servers_Server_Config{
+						Address: pointer.To(address),
+					},
+				},
+			},
+		}, nil
+	},
+}
+
+func loadActualDNS(ctx context.Context, client GNMICClient, spec *dozer.Spec) error {
+	ocDNS := &oc.OpenconfigSystem_System_Dns{}
+	err := client.Get(ctx, "/system/dns", ocDNS, api.DataTypeCONFIG())
+	if err != nil {
+		return errors.Wrapf(err, "failed to read dns")
+	}
+	spec.DNS, err = unmarshalOCDNS(ocDNS)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal dns")
+	}
+
+	return nil
+}
+
+func unmarshalOCDNS(ocVal *oc.OpenconfigSystem_System_Dns) (*dozer.SpecDNS, error) { //nolint:unparam
+	if ocVal == nil {
+		return nil, nil
+	}
+
+	dns := &dozer.SpecDNS{
+		Servers: map[string]*dozer.SpecDNSServer{},
+	}
+
+	if ocVal.Config != nil && len(ocVal.Config.Search) > 0 {
+		dns.SearchDomain = pointer.To(ocVal.Config.Search[0])
+	}
+
+	if ocVal.Servers != nil {
+		for address := range ocVal.Servers.Server {
+			dns.Servers[address] = &dozer.SpecDNSServer{}
+		}
+	}
+
+	// no servers and search domain is the NOS default that's the same as DNS not configured
+	if dns.SearchDomain == nil && len(dns.Servers) == 0 {
+		return nil, nil
+	}
+
+	return dns, nil
+}
@@ -119,6 +119,10 @@ var specInterfaceEnforcer = &DefaultValueEnforcer[string, *dozer.SpecInterface]{
 			return errors.Wrap(err, "failed to handle interface VLAN Anycast Gateway")
 		}
 
+		if err := specInterfaceVLANAnycastGatewayIPv6Enforcer.Handle(basePath, name, actual, desired, actions); err != nil {
+			return errors.Wrap(err, "failed to handle interface VLAN IPv6 Anycast Gateway")
+		}
+
 		actualSubs, desiredSubs := ValueOrNil(actual, desired,
 			func(value *dozer.SpecInterface) map[uint32]*dozer.SpecSubinterface { return value.Subinterfaces })
 		if err := specInterfaceSubinterfacesEnforcer.Handle(basePath, actualSubs, desiredSubs, actions); err != nil {
@@ -673,6 +677,34 @@ var specInterfaceVLANAnycastGatewayEnforcer = &DefaultValueEnforcer[string, *doz
 	},
 }
 
+var specInterfaceVLANAnycastGatewayIPv6Enforcer = &DefaultValueEnforcer[string, *dozer.SpecInterface]{
+	Summary: "Interface %s VLAN IPv6 Anycast Gateway",
+	Skip:    func(name string, _, _ *dozer.SpecInterface) bool { return !isVLAN(name) },
+	Getter:  func(_ string, value *dozer.SpecInterface) any { return value.VLANAnycastGatewayIPv6 },
+	MutateDesired: func(_ string, desired *dozer.SpecInterface) *dozer.SpecInterface {
+		if desired != nil && len(desired.VLANAnycastGatewayIPv6) == 0 {
+			return nil
+		}
+
+		return desired
+	},
+	MutateActual: func(_ string, actual *dozer.SpecInterface) *dozer.SpecInterface {
+		if actual != nil && len(actual.VLANAnycastGatewayIPv6) == 0 {
+			return nil
+		}
+
+		return actual
+	},
+	Path:         "/routed-vlan/ipv6/sag-ipv6/config/static-anycast-gateway",
+	UpdateWeight: ActionWeightInterfaceVLANAnycastGatewayUpdate,
+	DeleteWeight: ActionWeightInterfaceVLANAnycastGatewayDelete,
+	Marshal: func(_ string, value *dozer.SpecInterface) (ygot.ValidatedGoStruct, error) {
+		return &oc.OpenconfigInterfaces_Interfaces_Interface_RoutedVlan_Ipv6_SagIpv6_Config{
+			StaticAnycastGateway: value.VLANAnycastGatewayIPv6,
+		}, nil
+	},
+}
+
 func loadActualInterfaces(ctx context.Context, agent *agentapi.Agent, client GNMICClient, spec *dozer.Spec) error {
 	ocInterfaces := &oc.OpenconfigInterfaces_Interfaces{}
 	err := client.Get(ctx, "/interfaces/interface", ocInterfaces)
@@ -899,6 +931,9 @@ func unmarshalOCInterfaces(agent *agentapi.Agent, ocVal *oc.OpenconfigInterfaces
 					}
 				}
 			}
+			if ocIface.RoutedVlan.Ipv6 != nil && ocIface.RoutedVlan.Ipv6.SagIpv6 != nil && ocIface.RoutedVlan.Ipv6.SagIpv6.Config != nil {
+				iface.VLANAnycastGatewayIPv6 = ocIface.RoutedVlan.Ipv6.SagIpv6.Config.StaticAnycastGateway
+			}
 		}
 		if vlan && !isVLAN(name) {
 			return nil, errors.Errorf("interface %s has VLAN config but not a Vlan", name)
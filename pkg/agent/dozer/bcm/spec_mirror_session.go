@@ -0,0 +1,144 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/pkg/errors"
+	"go.githedgehog.com/fabric-bcm-ygot/pkg/oc"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+const (
+	MirrorDirectionRX   = "RX"
+	MirrorDirectionTX   = "TX"
+	MirrorDirectionBoth = "BOTH"
+
+	mirrorGREType = "0x88be" // ERSPAN type II
+)
+
+var specMirrorSessionsEnforcer = &DefaultMapEnforcer[string, *dozer.SpecMirrorSession]{
+	Summary:      "Mirror Sessions",
+	ValueHandler: specMirrorSessionEnforcer,
+}
+
+var specMirrorSessionEnforcer = &DefaultValueEnforcer[string, *dozer.SpecMirrorSession]{
+	Summary:          "Mirror Session %s",
+	Path:             "/sonic-mirror-session/MIRROR_SESSION/MIRROR_SESSION_LIST[name=%s]",
+	UpdateWeight:     ActionWeightMirrorSessionUpdate,
+	DeleteWeight:     ActionWeightMirrorSessionDelete,
+	RecreateOnUpdate: true, // mirror sessions can't be modified in place
+	Marshal: func(name string, value *dozer.SpecMirrorSession) (ygot.ValidatedGoStruct, error) {
+		var direction oc.E_SonicMirrorSession_SonicMirrorSession_MIRROR_SESSION_MIRROR_SESSION_LIST_Direction
+		if value.Direction != nil {
+			switch *value.Direction {
+			case MirrorDirectionRX:
+				direction = oc.SonicMirrorSession_SonicMirrorSession_MIRROR_SESSION_MIRROR_SESSION_LIST_Direction_RX
+			case MirrorDirectionTX:
+				direction = oc.SonicMirrorSession_SonicMirrorSession_MIRROR_SESSION_MIRROR_SESSION_LIST_Direction_TX
+			case MirrorDirectionBoth:
+				direction = oc.SonicMirrorSession_SonicMirrorSession_MIRROR_SESSION_MIRROR_SESSION_LIST_Direction_BOTH
+			default:
+				return nil, errors.Errorf("unknown mirror direction %s", *value.Direction)
+			}
+		}
+
+		session := &oc.SonicMirrorSession_SonicMirrorSession_MIRROR_SESSION_MIRROR_SESSION_LIST{
+			Name:      pointer.To(name),
+			Direction: direction,
+		}
+		if len(value.SourcePorts) > 0 {
+			session.SrcPort = pointer.To(strings.Join(value.SourcePorts, ","))
+		}
+
+		if value.DestinationIP != nil {
+			session.Type = oc.SonicMirrorSession_SonicMirrorSession_MIRROR_SESSION_MIRROR_SESSION_LIST_Type_ERSPAN
+			session.SrcIp = value.SourceIP
+			session.DstIp = value.DestinationIP
+			session.VrfName = value.VRF
+			session.Dscp = value.DSCP
+			session.Ttl = value.TTL
+			session.GreType = pointer.To(mirrorGREType)
+		} else {
+			session.Type = oc.SonicMirrorSession_SonicMirrorSession_MIRROR_SESSION_MIRROR_SESSION_LIST_Type_SPAN
+			session.DstPort = value.DestinationPort
+		}
+
+		return &oc.SonicMirrorSession_SonicMirrorSession_MIRROR_SESSION{
+			MIRROR_SESSION_LIST: map[string]*oc.SonicMirrorSession_SonicMirrorSession_MIRROR_SESSION_MIRROR_SESSION_LIST{
+				name: session,
+			},
+		}, nil
+	},
+}
+
+func loadActualMirrorSessions(ctx context.Context, client GNMICClient, spec *dozer.Spec) error {
+	ocMirror := &oc.SonicMirrorSession_SonicMirrorSession{}
+	err := client.Get(ctx, "/sonic-mirror-session/MIRROR_SESSION", ocMirror)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get mirror sessions")
+	}
+
+	spec.MirrorSessions, err = unmarshalActualMirrorSessions(ocMirror.MIRROR_SESSION)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal mirror sessions")
+	}
+
+	return nil
+}
+
+func unmarshalActualMirrorSessions(ocVal *oc.SonicMirrorSession_SonicMirrorSession_MIRROR_SESSION) (map[string]*dozer.SpecMirrorSession, error) { //nolint:unparam
+	sessions := map[string]*dozer.SpecMirrorSession{}
+
+	if ocVal == nil {
+		return sessions, nil
+	}
+
+	for name, session := range ocVal.MIRROR_SESSION_LIST {
+		if session == nil {
+			continue
+		}
+
+		var direction *string
+		switch session.Direction {
+		case oc.SonicMirrorSession_SonicMirrorSession_MIRROR_SESSION_MIRROR_SESSION_LIST_Direction_RX:
+			direction = pointer.To(MirrorDirectionRX)
+		case oc.SonicMirrorSession_SonicMirrorSession_MIRROR_SESSION_MIRROR_SESSION_LIST_Direction_TX:
+			direction = pointer.To(MirrorDirectionTX)
+		case oc.SonicMirrorSession_SonicMirrorSession_MIRROR_SESSION_MIRROR_SESSION_LIST_Direction_BOTH:
+			direction = pointer.To(MirrorDirectionBoth)
+		}
+
+		var sourcePorts []string
+		if session.SrcPort != nil && *session.SrcPort != "" {
+			sourcePorts = strings.Split(*session.SrcPort, ",")
+			sort.Strings(sourcePorts)
+		}
+
+		if session.Type == oc.SonicMirrorSession_SonicMirrorSession_MIRROR_SESSION_MIRROR_SESSION_LIST_Type_ERSPAN {
+			sessions[name] = &dozer.SpecMirrorSession{
+				SourcePorts:   sourcePorts,
+				Direction:     direction,
+				SourceIP:      session.SrcIp,
+				DestinationIP: session.DstIp,
+				VRF:           session.VrfName,
+				DSCP:          session.Dscp,
+				TTL:           session.Ttl,
+			}
+		} else {
+			sessions[name] = &dozer.SpecMirrorSession{
+				SourcePorts:     sourcePorts,
+				Direction:       direction,
+				DestinationPort: session.DstPort,
+			}
+		}
+	}
+
+	return sessions, nil
+}
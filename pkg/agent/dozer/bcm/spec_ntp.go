@@ -32,6 +32,7 @@ var specNTPEnforcer = &DefaultValueEnforcer[string, *dozer.SpecNTP]{
 		return &oc.OpenconfigSystem_System_Ntp{
 			Config: &oc.OpenconfigSystem_System_Ntp_Config{
 				SourceInterface: value.SourceInterface,
+				NetworkInstance: value.VRF,
 			},
 		}, nil
 	},
@@ -81,9 +82,16 @@ func unmarshalOCNTP(ocVal *oc.OpenconfigSystem_System_Ntp) (*dozer.SpecNTP, erro
 		return &dozer.SpecNTP{}, nil
 	}
 
-	return &dozer.SpecNTP{
+	ntp := &dozer.SpecNTP{
 		SourceInterface: ocVal.Config.SourceInterface,
-	}, nil
+	}
+
+	// default VRF is the NOS default, so it's the same as not set
+	if ocVal.Config.NetworkInstance != nil && *ocVal.Config.NetworkInstance != VRFDefault {
+		ntp.VRF = ocVal.Config.NetworkInstance
+	}
+
+	return ntp, nil
 }
 
 func loadActualNTPServers(ctx context.Context, client GNMICClient, spec *dozer.Spec) error {
@@ -16,6 +16,7 @@ package bcm
 
 import (
 	"context"
+	"strings"
 
 	"github.com/openconfig/ygot/ygot"
 	"github.com/pkg/errors"
@@ -23,6 +24,13 @@ import (
 	"go.githedgehog.com/fabric/pkg/agent/dozer"
 )
 
+const (
+	// DefaultLACPSystemPriority is the LACP system priority used by the NOS if not configured explicitly
+	DefaultLACPSystemPriority = 65535
+	// DefaultLACPMinLinks is the port channel min links used by the NOS if not configured explicitly
+	DefaultLACPMinLinks = 1
+)
+
 var specPortChannelConfigsEnforcer = &DefaultMapEnforcer[string, *dozer.SpecPortChannelConfig]{
 	Summary:      "PortChannel Configs",
 	ValueHandler: specPortChannelConfigEnforcer,
@@ -39,6 +47,18 @@ var specPortChannelConfigEnforcer = &DefaultValueEnforcer[string, *dozer.SpecPor
 			return errors.Wrap(err, "failed to handle fallback")
 		}
 
+		if err := specPortChannelConfigFastRateEnforcer.Handle(basePath, key, actual, desired, actions); err != nil {
+			return errors.Wrap(err, "failed to handle fast rate")
+		}
+
+		if err := specPortChannelConfigMinLinksEnforcer.Handle(basePath, key, actual, desired, actions); err != nil {
+			return errors.Wrap(err, "failed to handle min links")
+		}
+
+		if err := specPortChannelConfigSystemPriorityEnforcer.Handle(basePath, key, actual, desired, actions); err != nil {
+			return errors.Wrap(err, "failed to handle system priority")
+		}
+
 		return nil
 	},
 }
@@ -73,6 +93,51 @@ var specPortChannelConfigFallbackEnforcer = &DefaultValueEnforcer[string, *dozer
 	},
 }
 
+var specPortChannelConfigFastRateEnforcer = &DefaultValueEnforcer[string, *dozer.SpecPortChannelConfig]{
+	Summary: "PortChannel Fast Rate %s",
+	Path:    "/sonic-portchannel/PORTCHANNEL/PORTCHANNEL_LIST[name=%s]/fast_rate",
+	Getter: func(_ string, value *dozer.SpecPortChannelConfig) any {
+		return value.FastRate
+	},
+	UpdateWeight: ActionWeightPortChannelConfigFastRateUpdate,
+	DeleteWeight: ActionWeightPortChannelConfigFastRateDelete,
+	Marshal: func(_ string, value *dozer.SpecPortChannelConfig) (ygot.ValidatedGoStruct, error) {
+		return &oc.SonicPortchannel_SonicPortchannel_PORTCHANNEL_PORTCHANNEL_LIST{
+			FastRate: value.FastRate,
+		}, nil
+	},
+}
+
+var specPortChannelConfigMinLinksEnforcer = &DefaultValueEnforcer[string, *dozer.SpecPortChannelConfig]{
+	Summary: "PortChannel Min Links %s",
+	Path:    "/sonic-portchannel/PORTCHANNEL/PORTCHANNEL_LIST[name=%s]/min_links",
+	Getter: func(_ string, value *dozer.SpecPortChannelConfig) any {
+		return value.MinLinks
+	},
+	UpdateWeight: ActionWeightPortChannelConfigMinLinksUpdate,
+	DeleteWeight: ActionWeightPortChannelConfigMinLinksDelete,
+	Marshal: func(_ string, value *dozer.SpecPortChannelConfig) (ygot.ValidatedGoStruct, error) {
+		return &oc.SonicPortchannel_SonicPortchannel_PORTCHANNEL_PORTCHANNEL_LIST{
+			MinLinks: value.MinLinks,
+		}, nil
+	},
+}
+
+var specPortChannelConfigSystemPriorityEnforcer = &DefaultValueEnforcer[string, *dozer.SpecPortChannelConfig]{
+	Summary: "PortChannel System Priority %s",
+	Path:    "/openconfig-lacp:lacp/interfaces/interface[name=%s]/config/system-priority",
+	Getter: func(_ string, value *dozer.SpecPortChannelConfig) any {
+		return value.SystemPriority
+	},
+	UpdateWeight: ActionWeightPortChannelConfigSystemPriorityUpdate,
+	DeleteWeight: ActionWeightPortChannelConfigSystemPriorityDelete,
+	Marshal: func(_ string, value *dozer.SpecPortChannelConfig) (ygot.ValidatedGoStruct, error) {
+		return &oc.OpenconfigLacp_Lacp_Interfaces_Interface_Config{
+			SystemPriority: value.SystemPriority,
+		}, nil
+	},
+}
+
 func loadActualPortChannelConfigs(ctx context.Context, client GNMICClient, spec *dozer.Spec) error {
 	ocPortChannel := &oc.SonicPortchannel_SonicPortchannel{}
 	err := client.Get(ctx, "/sonic-portchannel/PORTCHANNEL", ocPortChannel)
@@ -80,7 +145,13 @@ func loadActualPortChannelConfigs(ctx context.Context, client GNMICClient, spec
 		return errors.Wrapf(err, "failed to get portchannel")
 	}
 
-	spec.PortChannelConfigs, err = unmarshalActualPortChannelConfigs(ocPortChannel)
+	ocLACP := &oc.OpenconfigLacp_Lacp{}
+	err = client.Get(ctx, "/openconfig-lacp:lacp/interfaces", ocLACP)
+	if err != nil && !strings.Contains(err.Error(), errGRPCNotFound) {
+		return errors.Wrapf(err, "failed to get lacp interfaces")
+	}
+
+	spec.PortChannelConfigs, err = unmarshalActualPortChannelConfigs(ocPortChannel, ocLACP.Interfaces)
 	if err != nil {
 		return errors.Wrapf(err, "failed to unmarshal portchannel")
 	}
@@ -88,21 +159,49 @@ func loadActualPortChannelConfigs(ctx context.Context, client GNMICClient, spec
 	return nil
 }
 
-func unmarshalActualPortChannelConfigs(ocVal *oc.SonicPortchannel_SonicPortchannel) (map[string]*dozer.SpecPortChannelConfig, error) { //nolint:unparam
+func unmarshalActualPortChannelConfigs(ocVal *oc.SonicPortchannel_SonicPortchannel, ocLACP *oc.OpenconfigLacp_Lacp_Interfaces) (map[string]*dozer.SpecPortChannelConfig, error) { //nolint:unparam
 	portChannelConfigs := map[string]*dozer.SpecPortChannelConfig{}
 
-	if ocVal == nil || ocVal.PORTCHANNEL == nil {
-		return portChannelConfigs, nil
-	}
-
-	for name, portChannel := range ocVal.PORTCHANNEL.PORTCHANNEL_LIST {
-		if portChannel == nil || portChannel.SystemMac == nil && portChannel.Fallback == nil {
-			continue
+	if ocVal != nil && ocVal.PORTCHANNEL != nil {
+		for name, portChannel := range ocVal.PORTCHANNEL.PORTCHANNEL_LIST {
+			if portChannel == nil {
+				continue
+			}
+
+			// NOS defaults are reported for all port channels, so they're skipped to match the desired state
+			fastRate := portChannel.FastRate
+			if fastRate != nil && !*fastRate {
+				fastRate = nil
+			}
+			minLinks := portChannel.MinLinks
+			if minLinks != nil && *minLinks == DefaultLACPMinLinks {
+				minLinks = nil
+			}
+
+			if portChannel.SystemMac == nil && portChannel.Fallback == nil && fastRate == nil && minLinks == nil {
+				continue
+			}
+
+			portChannelConfigs[name] = &dozer.SpecPortChannelConfig{
+				SystemMAC: portChannel.SystemMac,
+				Fallback:  portChannel.Fallback,
+				FastRate:  fastRate,
+				MinLinks:  minLinks,
+			}
 		}
+	}
 
-		portChannelConfigs[name] = &dozer.SpecPortChannelConfig{
-			SystemMAC: portChannel.SystemMac,
-			Fallback:  portChannel.Fallback,
+	if ocLACP != nil {
+		for name, iface := range ocLACP.Interface {
+			// default system priority is reported for all port channels, so it's skipped to match the desired state
+			if iface == nil || iface.Config == nil || iface.Config.SystemPriority == nil || *iface.Config.SystemPriority == DefaultLACPSystemPriority {
+				continue
+			}
+
+			if portChannelConfigs[name] == nil {
+				portChannelConfigs[name] = &dozer.SpecPortChannelConfig{}
+			}
+			portChannelConfigs[name].SystemPriority = iface.Config.SystemPriority
 		}
 	}
 
@@ -0,0 +1,105 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.githedgehog.com/fabric-bcm-ygot/pkg/oc"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+func TestUnmarshalActualPortChannelConfigs(t *testing.T) {
+	const mac = "0c:20:12:fe:00:01"
+
+	for _, tt := range []struct {
+		name     string
+		pc       *oc.SonicPortchannel_SonicPortchannel_PORTCHANNEL_PORTCHANNEL_LIST
+		priority *uint16
+		expected map[string]*dozer.SpecPortChannelConfig
+	}{
+		{
+			name: "nos-defaults",
+			pc: &oc.SonicPortchannel_SonicPortchannel_PORTCHANNEL_PORTCHANNEL_LIST{
+				FastRate: pointer.To(false),
+				MinLinks: pointer.To(uint16(DefaultLACPMinLinks)),
+			},
+			priority: pointer.To(uint16(DefaultLACPSystemPriority)),
+			expected: map[string]*dozer.SpecPortChannelConfig{},
+		},
+		{
+			name: "nos-defaults-with-mac",
+			pc: &oc.SonicPortchannel_SonicPortchannel_PORTCHANNEL_PORTCHANNEL_LIST{
+				SystemMac: pointer.To(mac),
+				Fallback:  pointer.To(false),
+				FastRate:  pointer.To(false),
+				MinLinks:  pointer.To(uint16(DefaultLACPMinLinks)),
+			},
+			priority: pointer.To(uint16(DefaultLACPSystemPriority)),
+			expected: map[string]*dozer.SpecPortChannelConfig{
+				"PortChannel1": {
+					SystemMAC: pointer.To(mac),
+					Fallback:  pointer.To(false),
+				},
+			},
+		},
+		{
+			name: "configured",
+			pc: &oc.SonicPortchannel_SonicPortchannel_PORTCHANNEL_PORTCHANNEL_LIST{
+				SystemMac: pointer.To(mac),
+				FastRate:  pointer.To(true),
+				MinLinks:  pointer.To(uint16(2)),
+			},
+			priority: pointer.To(uint16(100)),
+			expected: map[string]*dozer.SpecPortChannelConfig{
+				"PortChannel1": {
+					SystemMAC:      pointer.To(mac),
+					FastRate:       pointer.To(true),
+					MinLinks:       pointer.To(uint16(2)),
+					SystemPriority: pointer.To(uint16(100)),
+				},
+			},
+		},
+		{
+			name: "no-lacp-interfaces",
+			pc: &oc.SonicPortchannel_SonicPortchannel_PORTCHANNEL_PORTCHANNEL_LIST{
+				SystemMac: pointer.To(mac),
+			},
+			expected: map[string]*dozer.SpecPortChannelConfig{
+				"PortChannel1": {
+					SystemMAC: pointer.To(mac),
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ocPortChannel := &oc.SonicPortchannel_SonicPortchannel{
+				PORTCHANNEL: &oc.SonicPortchannel_SonicPortchannel_PORTCHANNEL{
+					PORTCHANNEL_LIST: map[string]*oc.SonicPortchannel_SonicPortchannel_PORTCHANNEL_PORTCHANNEL_LIST{
+						"PortChannel1": tt.pc,
+					},
+				},
+			}
+
+			var ocLACP *oc.OpenconfigLacp_Lacp_Interfaces
+			if tt.priority != nil {
+				ocLACP = &oc.OpenconfigLacp_Lacp_Interfaces{
+					Interface: map[string]*oc.OpenconfigLacp_Lacp_Interfaces_Interface{
+						"PortChannel1": {
+							Config: &oc.OpenconfigLacp_Lacp_Interfaces_Interface_Config{
+								SystemPriority: tt.priority,
+							},
+						},
+					},
+				}
+			}
+
+			pcConfigs, err := unmarshalActualPortChannelConfigs(ocPortChannel, ocLACP)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, pcConfigs)
+		})
+	}
+}
@@ -58,18 +58,23 @@ var specPrefixListBaseEnforcer = &DefaultValueEnforcer[string, *dozer.SpecPrefix
 	Summary:   "Prefix List Base %s",
 	NoReplace: true, // we don't want to replace the whole prefix list, just update the entries
 	Getter: func(name string, value *dozer.SpecPrefixList) any {
-		return name // we do only care about the name of the prefix list
+		return []any{name, value.IPv6} // we do only care about the name and mode of the prefix list
 	},
 	UpdateWeight: ActionWeightPrefixListUpdate,
 	DeleteWeight: ActionWeightPrefixListDelete,
-	Marshal: func(name string, _ *dozer.SpecPrefixList) (ygot.ValidatedGoStruct, error) {
+	Marshal: func(name string, value *dozer.SpecPrefixList) (ygot.ValidatedGoStruct, error) {
+		mode := oc.OpenconfigRoutingPolicy_RoutingPolicy_DefinedSets_PrefixSets_PrefixSet_Config_Mode_IPV4
+		if value.IPv6 {
+			mode = oc.OpenconfigRoutingPolicy_RoutingPolicy_DefinedSets_PrefixSets_PrefixSet_Config_Mode_IPV6
+		}
+
 		return &oc.OpenconfigRoutingPolicy_RoutingPolicy_DefinedSets_PrefixSets{
 			PrefixSet: map[string]*oc.OpenconfigRoutingPolicy_RoutingPolicy_DefinedSets_PrefixSets_PrefixSet{
 				name: {
 					Name: pointer.To(name),
 					Config: &oc.OpenconfigRoutingPolicy_RoutingPolicy_DefinedSets_PrefixSets_PrefixSet_Config{
 						Name: pointer.To(name),
-						Mode: oc.OpenconfigRoutingPolicy_RoutingPolicy_DefinedSets_PrefixSets_PrefixSet_Config_Mode_IPV4,
+						Mode: mode,
 					},
 				},
 			},
@@ -98,6 +103,9 @@ func getMaskLenRange(entry *dozer.SpecPrefixListEntry) (string, error) {
 		}
 		if entry.Prefix.Le == 0 {
 			le = "32"
+			if strings.Contains(entry.Prefix.Prefix, ":") {
+				le = "128"
+			}
 		}
 
 		maskLenRange = fmt.Sprintf("%s..%s", ge, le)
@@ -176,11 +184,21 @@ func unmarshalOCPrefixLists(ocVal *oc.OpenconfigRoutingPolicy_RoutingPolicy_Defi
 	}
 
 	for name, ocPrefixList := range ocVal.PrefixSets.PrefixSet {
-		if ocPrefixList.Config == nil || ocPrefixList.Config.Mode != oc.OpenconfigRoutingPolicy_RoutingPolicy_DefinedSets_PrefixSets_PrefixSet_Config_Mode_IPV4 {
+		if ocPrefixList.Config == nil {
+			continue
+		}
+
+		ipv6 := false
+		switch ocPrefixList.Config.Mode { //nolint:exhaustive
+		case oc.OpenconfigRoutingPolicy_RoutingPolicy_DefinedSets_PrefixSets_PrefixSet_Config_Mode_IPV4:
+		case oc.OpenconfigRoutingPolicy_RoutingPolicy_DefinedSets_PrefixSets_PrefixSet_Config_Mode_IPV6:
+			ipv6 = true
+		default:
 			continue
 		}
 
 		prefixList := &dozer.SpecPrefixList{
+			IPv6:     ipv6,
 			Prefixes: map[uint32]*dozer.SpecPrefixListEntry{},
 		}
 
@@ -0,0 +1,212 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"context"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/pkg/errors"
+	"go.githedgehog.com/fabric-bcm-ygot/pkg/oc"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+// sflowGlobalKey is the fixed key of the single SFLOW_LIST entry
+const sflowGlobalKey = "global"
+
+var specSFlowEnforcer = &DefaultValueEnforcer[string, *dozer.SpecSFlow]{
+	Summary: "sFlow",
+	CustomHandler: func(basePath string, name string, actual, desired *dozer.SpecSFlow, actions *ActionQueue) error {
+		if err := specSFlowBaseEnforcer.Handle(basePath, name, actual, desired, actions); err != nil {
+			return errors.Wrap(err, "failed to handle sflow base")
+		}
+
+		actualCollectors, desiredCollectors := ValueOrNil(actual, desired,
+			func(value *dozer.SpecSFlow) map[string]*dozer.SpecSFlowCollector { return value.Collectors })
+		if err := specSFlowCollectorsEnforcer.Handle(basePath, actualCollectors, desiredCollectors, actions); err != nil {
+			return errors.Wrap(err, "failed to handle sflow collectors")
+		}
+
+		actualInterfaces, desiredInterfaces := ValueOrNil(actual, desired,
+			func(value *dozer.SpecSFlow) map[string]*dozer.SpecSFlowInterface { return value.Interfaces })
+		if err := specSFlowInterfacesEnforcer.Handle(basePath, actualInterfaces, desiredInterfaces, actions); err != nil {
+			return errors.Wrap(err, "failed to handle sflow interfaces")
+		}
+
+		return nil
+	},
+}
+
+var specSFlowBaseEnforcer = &DefaultValueEnforcer[string, *dozer.SpecSFlow]{
+	Summary: "sFlow base",
+	Getter: func(_ string, value *dozer.SpecSFlow) any {
+		return []any{value.Enabled, value.PollingInterval}
+	},
+	Path:         "/sonic-sflow/SFLOW/SFLOW_LIST[sflow_key=" + sflowGlobalKey + "]",
+	UpdateWeight: ActionWeightSFlowUpdate,
+	DeleteWeight: ActionWeightSFlowDelete,
+	Marshal: func(_ string, value *dozer.SpecSFlow) (ygot.ValidatedGoStruct, error) {
+		return &oc.SonicSflow_SonicSflow_SFLOW{
+			SFLOW_LIST: map[string]*oc.SonicSflow_SonicSflow_SFLOW_SFLOW_LIST{
+				sflowGlobalKey: {
+					SflowKey:        pointer.To(sflowGlobalKey),
+					AdminState:      marshalSFlowAdminState(value.Enabled),
+					PollingInterval: value.PollingInterval,
+				},
+			},
+		}, nil
+	},
+}
+
+var specSFlowCollectorsEnforcer = &DefaultMapEnforcer[string, *dozer.SpecSFlowCollector]{
+	Summary:      "sFlow collectors",
+	ValueHandler: specSFlowCollectorEnforcer,
+}
+
+var specSFlowCollectorEnforcer = &DefaultValueEnforcer[string, *dozer.SpecSFlowCollector]{
+	Summary:      "sFlow collector %s",
+	Path:         "/sonic-sflow/SFLOW_COLLECTOR/SFLOW_COLLECTOR_LIST[collector_name=%s]",
+	UpdateWeight: ActionWeightSFlowCollectorUpdate,
+	DeleteWeight: ActionWeightSFlowCollectorDelete,
+	Marshal: func(name string, value *dozer.SpecSFlowCollector) (ygot.ValidatedGoStruct, error) {
+		return &oc.SonicSflow_SonicSflow_SFLOW_COLLECTOR{
+			SFLOW_COLLECTOR_LIST: map[string]*oc.SonicSflow_SonicSflow_SFLOW_COLLECTOR_SFLOW_COLLECTOR_LIST{
+				name: {
+					CollectorName: pointer.To(name),
+					CollectorIp:   value.Address,
+					CollectorPort: value.Port,
+					CollectorVrf:  value.VRF,
+				},
+			},
+		}, nil
+	},
+}
+
+var specSFlowInterfacesEnforcer = &DefaultMapEnforcer[string, *dozer.SpecSFlowInterface]{
+	Summary:      "sFlow interfaces",
+	ValueHandler: specSFlowInterfaceEnforcer,
+}
+
+var specSFlowInterfaceEnforcer = &DefaultValueEnforcer[string, *dozer.SpecSFlowInterface]{
+	Summary:      "sFlow interface %s",
+	Path:         "/sonic-sflow/SFLOW_SESSION/SFLOW_SESSION_LIST[port=%s]",
+	UpdateWeight: ActionWeightSFlowInterfaceUpdate,
+	DeleteWeight: ActionWeightSFlowInterfaceDelete,
+	Marshal: func(name string, value *dozer.SpecSFlowInterface) (ygot.ValidatedGoStruct, error) {
+		return &oc.SonicSflow_SonicSflow_SFLOW_SESSION{
+			SFLOW_SESSION_LIST: map[string]*oc.SonicSflow_SonicSflow_SFLOW_SESSION_SFLOW_SESSION_LIST{
+				name: {
+					Port:       pointer.To(name),
+					AdminState: marshalSFlowSessionAdminState(value.Enabled),
+					SampleRate: value.SampleRate,
+				},
+			},
+		}, nil
+	},
+}
+
+func marshalSFlowAdminState(enabled *bool) oc.E_SonicSflow_SonicSflow_SFLOW_SFLOW_LIST_AdminState {
+	if enabled == nil {
+		return oc.SonicSflow_SonicSflow_SFLOW_SFLOW_LIST_AdminState_UNSET
+	}
+	if *enabled {
+		return oc.SonicSflow_SonicSflow_SFLOW_SFLOW_LIST_AdminState_up
+	}
+
+	return oc.SonicSflow_SonicSflow_SFLOW_SFLOW_LIST_AdminState_down
+}
+
+func marshalSFlowSessionAdminState(enabled *bool) oc.E_SonicSflow_SonicSflow_SFLOW_SESSION_SFLOW_SESSION_LIST_AdminState {
+	if enabled == nil {
+		return oc.SonicSflow_SonicSflow_SFLOW_SESSION_SFLOW_SESSION_LIST_AdminState_UNSET
+	}
+	if *enabled {
+		return oc.SonicSflow_SonicSflow_SFLOW_SESSION_SFLOW_SESSION_LIST_AdminState_up
+	}
+
+	return oc.SonicSflow_SonicSflow_SFLOW_SESSION_SFLOW_SESSION_LIST_AdminState_down
+}
+
+func loadActualSFlow(ctx context.Context, client GNMICClient, spec *dozer.Spec) error {
+	ocSFlow := &oc.SonicSflow_SonicSflow{}
+	err := client.Get(ctx, "/sonic-sflow", ocSFlow)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get sflow")
+	}
+
+	spec.SFlow, err = unmarshalActualSFlow(ocSFlow)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal sflow")
+	}
+
+	return nil
+}
+
+func unmarshalActualSFlow(ocVal *oc.SonicSflow_SonicSflow) (*dozer.SpecSFlow, error) { //nolint:unparam
+	if ocVal == nil {
+		return nil, nil
+	}
+
+	sflow := &dozer.SpecSFlow{
+		Collectors: map[string]*dozer.SpecSFlowCollector{},
+		Interfaces: map[string]*dozer.SpecSFlowInterface{},
+	}
+	exists := false
+
+	if ocVal.SFLOW != nil {
+		if global, ok := ocVal.SFLOW.SFLOW_LIST[sflowGlobalKey]; ok && global != nil {
+			exists = true
+
+			switch global.AdminState {
+			case oc.SonicSflow_SonicSflow_SFLOW_SFLOW_LIST_AdminState_up:
+				sflow.Enabled = pointer.To(true)
+			case oc.SonicSflow_SonicSflow_SFLOW_SFLOW_LIST_AdminState_down:
+				sflow.Enabled = pointer.To(false)
+			}
+			sflow.PollingInterval = global.PollingInterval
+		}
+	}
+
+	if ocVal.SFLOW_COLLECTOR != nil {
+		for name, collector := range ocVal.SFLOW_COLLECTOR.SFLOW_COLLECTOR_LIST {
+			if collector == nil {
+				continue
+			}
+
+			exists = true
+			sflow.Collectors[name] = &dozer.SpecSFlowCollector{
+				Address: collector.CollectorIp,
+				Port:    collector.CollectorPort,
+				VRF:     collector.CollectorVrf,
+			}
+		}
+	}
+
+	if ocVal.SFLOW_SESSION != nil {
+		for name, session := range ocVal.SFLOW_SESSION.SFLOW_SESSION_LIST {
+			if session == nil {
+				continue
+			}
+
+			exists = true
+			iface := &dozer.SpecSFlowInterface{
+				SampleRate: session.SampleRate,
+			}
+			switch session.AdminState {
+			case oc.SonicSflow_SonicSflow_SFLOW_SESSION_SFLOW_SESSION_LIST_AdminState_up:
+				iface.Enabled = pointer.To(true)
+			case oc.SonicSflow_SonicSflow_SFLOW_SESSION_SFLOW_SESSION_LIST_AdminState_down:
+				iface.Enabled = pointer.To(false)
+			}
+			sflow.Interfaces[name] = iface
+		}
+	}
+
+	if !exists {
+		return nil, nil
+	}
+
+	return sflow, nil
+}
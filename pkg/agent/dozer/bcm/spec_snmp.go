@@ -0,0 +1,166 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"context"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/pkg/errors"
+	"go.githedgehog.com/fabric-bcm-ygot/pkg/oc"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+const (
+	SNMPAuthTypeMD5 = "MD5"
+	SNMPAuthTypeSHA = "SHA"
+	SNMPPrivTypeDES = "DES"
+	SNMPPrivTypeAES = "AES"
+
+	// all users are read-only and use both authentication and privacy
+	snmpUserType       = "Priv"
+	snmpUserPermission = "RO"
+)
+
+var specSNMPEnforcer = &DefaultValueEnforcer[string, *dozer.SpecSNMP]{
+	Summary: "SNMP",
+	CustomHandler: func(basePath string, name string, actual, desired *dozer.SpecSNMP, actions *ActionQueue) error {
+		if err := specSNMPBaseEnforcer.Handle(basePath, name, actual, desired, actions); err != nil {
+			return errors.Wrap(err, "failed to handle snmp base")
+		}
+
+		actualUsers, desiredUsers := ValueOrNil(actual, desired,
+			func(value *dozer.SpecSNMP) map[string]*dozer.SpecSNMPUser { return value.Users })
+		if err := specSNMPUsersEnforcer.Handle(basePath, actualUsers, desiredUsers, actions); err != nil {
+			return errors.Wrap(err, "failed to handle snmp users")
+		}
+
+		return nil
+	},
+}
+
+var specSNMPBaseEnforcer = &DefaultValueEnforcer[string, *dozer.SpecSNMP]{
+	Summary:       "SNMP base",
+	MutateActual:  snmpWithBaseOnly,
+	MutateDesired: snmpWithBaseOnly,
+	Path:          "/sonic-snmp/SNMP",
+	UpdateWeight:  ActionWeightSNMPUpdate,
+	DeleteWeight:  ActionWeightSNMPDelete,
+	Marshal: func(_ string, value *dozer.SpecSNMP) (ygot.ValidatedGoStruct, error) {
+		snmp := &oc.SonicSnmp_SonicSnmp_SNMP{}
+		if value.Location != nil {
+			snmp.LOCATION = &oc.SonicSnmp_SonicSnmp_SNMP_LOCATION{
+				Location: value.Location,
+			}
+		}
+		if value.Contact != nil {
+			snmp.CONTACT = &oc.SonicSnmp_SonicSnmp_SNMP_CONTACT{
+				Contact: value.Contact,
+			}
+		}
+
+		return snmp, nil
+	},
+}
+
+// snmpWithBaseOnly makes SNMP config without location and contact nil so it gets deleted instead of updated
+func snmpWithBaseOnly(_ string, value *dozer.SpecSNMP) *dozer.SpecSNMP {
+	if value == nil || (value.Location == nil && value.Contact == nil) {
+		return nil
+	}
+
+	return &dozer.SpecSNMP{
+		Location: value.Location,
+		Contact:  value.Contact,
+	}
+}
+
+var specSNMPUsersEnforcer = &DefaultMapEnforcer[string, *dozer.SpecSNMPUser]{
+	Summary:      "SNMP users",
+	ValueHandler: specSNMPUserEnforcer,
+}
+
+var specSNMPUserEnforcer = &DefaultValueEnforcer[string, *dozer.SpecSNMPUser]{
+	Summary: "SNMP user %s",
+	// keys are never loaded as they're only returned encrypted, so they're excluded from the comparison
+	Getter: func(_ string, value *dozer.SpecSNMPUser) any {
+		return []any{value.AuthType, value.PrivType}
+	},
+	Path:         "/sonic-snmp/SNMP_USER/SNMP_USER_LIST[user=%s]",
+	UpdateWeight: ActionWeightSNMPUserUpdate,
+	DeleteWeight: ActionWeightSNMPUserDelete,
+	Marshal: func(name string, value *dozer.SpecSNMPUser) (ygot.ValidatedGoStruct, error) {
+		return &oc.SonicSnmp_SonicSnmp_SNMP_USER{
+			SNMP_USER_LIST: map[string]*oc.SonicSnmp_SonicSnmp_SNMP_USER_SNMP_USER_LIST{
+				name: {
+					User:                       pointer.To(name),
+					SnmpUserType:               pointer.To(snmpUserType),
+					SnmpUserPermissionType:     pointer.To(snmpUserPermission),
+					SnmpUserAuthType:           value.AuthType,
+					SnmpUserAuthPassword:       value.AuthKey,
+					SnmpUserEncryptionType:     value.PrivType,
+					SnmpUserEncryptionPassword: value.PrivKey,
+				},
+			},
+		}, nil
+	},
+}
+
+func loadActualSNMP(ctx context.Context, client GNMICClient, spec *dozer.Spec) error {
+	ocSNMP := &oc.SonicSnmp_SonicSnmp{}
+	err := client.Get(ctx, "/sonic-snmp", ocSNMP)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read snmp")
+	}
+	spec.SNMP, err = unmarshalActualSNMP(ocSNMP)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal snmp")
+	}
+
+	return nil
+}
+
+func unmarshalActualSNMP(ocVal *oc.SonicSnmp_SonicSnmp) (*dozer.SpecSNMP, error) { //nolint:unparam
+	if ocVal == nil {
+		return nil, nil
+	}
+
+	snmp := &dozer.SpecSNMP{
+		Users: map[string]*dozer.SpecSNMPUser{},
+	}
+	exists := false
+
+	if ocVal.SNMP != nil {
+		if ocVal.SNMP.LOCATION != nil && ocVal.SNMP.LOCATION.Location != nil {
+			exists = true
+			snmp.Location = ocVal.SNMP.LOCATION.Location
+		}
+		if ocVal.SNMP.CONTACT != nil && ocVal.SNMP.CONTACT.Contact != nil {
+			exists = true
+			snmp.Contact = ocVal.SNMP.CONTACT.Contact
+		}
+	}
+
+	if ocVal.SNMP_USER != nil {
+		for name, user := range ocVal.SNMP_USER.SNMP_USER_LIST {
+			if user == nil {
+				continue
+			}
+
+			// keys are only returned encrypted, so they're never loaded and not compared
+			exists = true
+			snmp.Users[name] = &dozer.SpecSNMPUser{
+				AuthType: user.SnmpUserAuthType,
+				PrivType: user.SnmpUserEncryptionType,
+			}
+		}
+	}
+
+	if !exists {
+		return nil, nil
+	}
+
+	return snmp, nil
+}
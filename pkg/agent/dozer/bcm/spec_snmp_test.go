@@ -0,0 +1,101 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+func TestSpecSNMPUserKeysEnforcer(t *testing.T) {
+	const user = "monitor"
+
+	for _, tt := range []struct {
+		name        string
+		actual      *dozer.SpecSNMPUser
+		desired     *dozer.SpecSNMPUser
+		wantActions int
+	}{
+		{
+			name:   "keys not loaded",
+			actual: &dozer.SpecSNMPUser{AuthType: pointer.To(SNMPAuthTypeSHA), PrivType: pointer.To(SNMPPrivTypeAES)},
+			desired: &dozer.SpecSNMPUser{
+				AuthType: pointer.To(SNMPAuthTypeSHA), AuthKey: pointer.To("auth-key"),
+				PrivType: pointer.To(SNMPPrivTypeAES), PrivKey: pointer.To("priv-key"),
+			},
+			wantActions: 0,
+		},
+		{
+			name: "user added",
+			desired: &dozer.SpecSNMPUser{
+				AuthType: pointer.To(SNMPAuthTypeSHA), AuthKey: pointer.To("auth-key"),
+				PrivType: pointer.To(SNMPPrivTypeAES), PrivKey: pointer.To("priv-key"),
+			},
+			wantActions: 1,
+		},
+		{
+			name:   "auth type changed",
+			actual: &dozer.SpecSNMPUser{AuthType: pointer.To(SNMPAuthTypeMD5), PrivType: pointer.To(SNMPPrivTypeAES)},
+			desired: &dozer.SpecSNMPUser{
+				AuthType: pointer.To(SNMPAuthTypeSHA), AuthKey: pointer.To("auth-key"),
+				PrivType: pointer.To(SNMPPrivTypeAES), PrivKey: pointer.To("priv-key"),
+			},
+			wantActions: 1,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actions := &ActionQueue{}
+			err := specSNMPUserEnforcer.Handle("", user, tt.actual, tt.desired, actions)
+			require.NoError(t, err)
+			require.Len(t, actions.actions, tt.wantActions)
+		})
+	}
+}
+
+func TestSpecSNMPBaseEnforcer(t *testing.T) {
+	users := map[string]*dozer.SpecSNMPUser{
+		"monitor": {AuthType: pointer.To(SNMPAuthTypeSHA), PrivType: pointer.To(SNMPPrivTypeAES)},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		actual   *dozer.SpecSNMP
+		desired  *dozer.SpecSNMP
+		wantType []ActionType
+	}{
+		{
+			name:     "users only changed",
+			actual:   &dozer.SpecSNMP{Location: pointer.To("dc-1")},
+			desired:  &dozer.SpecSNMP{Location: pointer.To("dc-1"), Users: users},
+			wantType: []ActionType{},
+		},
+		{
+			name:     "location and contact removed",
+			actual:   &dozer.SpecSNMP{Location: pointer.To("dc-1"), Contact: pointer.To("noc")},
+			desired:  &dozer.SpecSNMP{Users: users},
+			wantType: []ActionType{ActionTypeDelete},
+		},
+		{
+			name:     "location changed",
+			actual:   &dozer.SpecSNMP{Location: pointer.To("dc-1")},
+			desired:  &dozer.SpecSNMP{Location: pointer.To("dc-2")},
+			wantType: []ActionType{ActionTypeUpdate},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actions := &ActionQueue{}
+			err := specSNMPBaseEnforcer.Handle("", "", tt.actual, tt.desired, actions)
+			require.NoError(t, err)
+
+			types := []ActionType{}
+			for _, action := range actions.actions {
+				types = append(types, action.(*Action).Type)
+			}
+			require.Equal(t, tt.wantType, types)
+		})
+	}
+}
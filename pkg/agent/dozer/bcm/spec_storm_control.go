@@ -0,0 +1,125 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"context"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/pkg/errors"
+	"go.githedgehog.com/fabric-bcm-ygot/pkg/oc"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+var specStormControlsEnforcer = &DefaultMapEnforcer[string, *dozer.SpecStormControl]{
+	Summary:      "Storm Controls",
+	ValueHandler: specStormControlEnforcer,
+}
+
+var specStormControlEnforcer = &DefaultValueEnforcer[string, *dozer.SpecStormControl]{
+	Summary: "Storm Control %s",
+	CustomHandler: func(basePath string, name string, actual, desired *dozer.SpecStormControl, actions *ActionQueue) error {
+		if actual == nil {
+			actual = &dozer.SpecStormControl{}
+		}
+		if desired == nil {
+			desired = &dozer.SpecStormControl{}
+		}
+
+		if err := specStormControlBroadcastEnforcer.Handle(basePath, name, actual.Broadcast, desired.Broadcast, actions); err != nil {
+			return errors.Wrap(err, "failed to handle broadcast")
+		}
+
+		if err := specStormControlUnknownUnicastEnforcer.Handle(basePath, name, actual.UnknownUnicast, desired.UnknownUnicast, actions); err != nil {
+			return errors.Wrap(err, "failed to handle unknown unicast")
+		}
+
+		if err := specStormControlUnknownMulticastEnforcer.Handle(basePath, name, actual.UnknownMulticast, desired.UnknownMulticast, actions); err != nil {
+			return errors.Wrap(err, "failed to handle unknown multicast")
+		}
+
+		return nil
+	},
+}
+
+var (
+	specStormControlBroadcastEnforcer = newSpecStormControlThresholdEnforcer(
+		oc.SonicPortStormControl_SonicPortStormControl_PORT_STORM_CONTROL_PORT_STORM_CONTROL_LIST_StormType_broadcast, "broadcast")
+	specStormControlUnknownUnicastEnforcer = newSpecStormControlThresholdEnforcer(
+		oc.SonicPortStormControl_SonicPortStormControl_PORT_STORM_CONTROL_PORT_STORM_CONTROL_LIST_StormType_unknown_unicast, "unknown-unicast")
+	specStormControlUnknownMulticastEnforcer = newSpecStormControlThresholdEnforcer(
+		oc.SonicPortStormControl_SonicPortStormControl_PORT_STORM_CONTROL_PORT_STORM_CONTROL_LIST_StormType_unknown_multicast, "unknown-multicast")
+)
+
+func newSpecStormControlThresholdEnforcer(stormType oc.E_SonicPortStormControl_SonicPortStormControl_PORT_STORM_CONTROL_PORT_STORM_CONTROL_LIST_StormType, stormTypeName string) *DefaultValueEnforcer[string, *dozer.SpecStormControlThreshold] {
+	return &DefaultValueEnforcer[string, *dozer.SpecStormControlThreshold]{
+		Summary:          "Storm Control %s " + stormTypeName,
+		Path:             "/sonic-port-storm-control/PORT_STORM_CONTROL/PORT_STORM_CONTROL_LIST[ifname=%s][storm_type=" + stormTypeName + "]",
+		UpdateWeight:     ActionWeightStormControlUpdate,
+		DeleteWeight:     ActionWeightStormControlDelete,
+		RecreateOnUpdate: true, // switching between kbps and pps requires removing the old threshold
+		Marshal: func(name string, value *dozer.SpecStormControlThreshold) (ygot.ValidatedGoStruct, error) {
+			return &oc.SonicPortStormControl_SonicPortStormControl_PORT_STORM_CONTROL{
+				PORT_STORM_CONTROL_LIST: map[oc.SonicPortStormControl_SonicPortStormControl_PORT_STORM_CONTROL_PORT_STORM_CONTROL_LIST_Key]*oc.SonicPortStormControl_SonicPortStormControl_PORT_STORM_CONTROL_PORT_STORM_CONTROL_LIST{
+					{Ifname: name, StormType: stormType}: {
+						Ifname:    pointer.To(name),
+						StormType: stormType,
+						Kbps:      value.KBPS,
+						Pps:       value.PPS,
+					},
+				},
+			}, nil
+		},
+	}
+}
+
+func loadActualStormControls(ctx context.Context, client GNMICClient, spec *dozer.Spec) error {
+	ocStormControl := &oc.SonicPortStormControl_SonicPortStormControl{}
+	err := client.Get(ctx, "/sonic-port-storm-control/PORT_STORM_CONTROL", ocStormControl)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get storm control")
+	}
+
+	spec.StormControls, err = unmarshalActualStormControls(ocStormControl.PORT_STORM_CONTROL)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal storm control")
+	}
+
+	return nil
+}
+
+func unmarshalActualStormControls(ocVal *oc.SonicPortStormControl_SonicPortStormControl_PORT_STORM_CONTROL) (map[string]*dozer.SpecStormControl, error) { //nolint:unparam
+	stormControls := map[string]*dozer.SpecStormControl{}
+
+	if ocVal == nil {
+		return stormControls, nil
+	}
+
+	for key, entry := range ocVal.PORT_STORM_CONTROL_LIST {
+		if entry == nil || entry.Kbps == nil && entry.Pps == nil {
+			continue
+		}
+
+		if stormControls[key.Ifname] == nil {
+			stormControls[key.Ifname] = &dozer.SpecStormControl{}
+		}
+
+		threshold := &dozer.SpecStormControlThreshold{
+			KBPS: entry.Kbps,
+			PPS:  entry.Pps,
+		}
+
+		switch key.StormType {
+		case oc.SonicPortStormControl_SonicPortStormControl_PORT_STORM_CONTROL_PORT_STORM_CONTROL_LIST_StormType_broadcast:
+			stormControls[key.Ifname].Broadcast = threshold
+		case oc.SonicPortStormControl_SonicPortStormControl_PORT_STORM_CONTROL_PORT_STORM_CONTROL_LIST_StormType_unknown_unicast:
+			stormControls[key.Ifname].UnknownUnicast = threshold
+		case oc.SonicPortStormControl_SonicPortStormControl_PORT_STORM_CONTROL_PORT_STORM_CONTROL_LIST_StormType_unknown_multicast:
+			stormControls[key.Ifname].UnknownMulticast = threshold
+		}
+	}
+
+	return stormControls, nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"context"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/pkg/errors"
+	"go.githedgehog.com/fabric-bcm-ygot/pkg/oc"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+const (
+	SyslogProtocolUDP = "UDP"
+	SyslogProtocolTCP = "TCP"
+)
+
+var syslogProtocols = map[string]oc.E_OpenconfigSystemExt_LoggingProtocol{
+	SyslogProtocolUDP: oc.OpenconfigSystemExt_LoggingProtocol_UDP,
+	SyslogProtocolTCP: oc.OpenconfigSystemExt_LoggingProtocol_TCP,
+}
+
+const (
+	SyslogSeverityEmergency     = "EMERGENCY"
+	SyslogSeverityAlert         = "ALERT"
+	SyslogSeverityCritical      = "CRITICAL"
+	SyslogSeverityError         = "ERROR"
+	SyslogSeverityWarning       = "WARNING"
+	SyslogSeverityNotice        = "NOTICE"
+	SyslogSeverityInformational = "INFORMATIONAL"
+	SyslogSeverityDebug         = "DEBUG"
+)
+
+var syslogSeverities = map[string]oc.E_OpenconfigSystemLogging_SyslogSeverity{
+	SyslogSeverityEmergency:     oc.OpenconfigSystemLogging_SyslogSeverity_EMERGENCY,
+	SyslogSeverityAlert:         oc.OpenconfigSystemLogging_SyslogSeverity_ALERT,
+	SyslogSeverityCritical:      oc.OpenconfigSystemLogging_SyslogSeverity_CRITICAL,
+	SyslogSeverityError:         oc.OpenconfigSystemLogging_SyslogSeverity_ERROR,
+	SyslogSeverityWarning:       oc.OpenconfigSystemLogging_SyslogSeverity_WARNING,
+	SyslogSeverityNotice:        oc.OpenconfigSystemLogging_SyslogSeverity_NOTICE,
+	SyslogSeverityInformational: oc.OpenconfigSystemLogging_SyslogSeverity_INFORMATIONAL,
+	SyslogSeverityDebug:         oc.OpenconfigSystemLogging_SyslogSeverity_DEBUG,
+}
+
+var specSyslogServersEnforcer = &DefaultMapEnforcer[string, *dozer.SpecSyslogServer]{
+	Summary:      "Syslog servers",
+	ValueHandler: specSyslogServerEnforcer,
+}
+
+var specSyslogServerEnforcer = &DefaultValueEnforcer[string, *dozer.SpecSyslogServer]{
+	Summary:      "Syslog server %s",
+	Path:         "/system/logging/remote-servers/remote-server[host=%s]",
+	UpdateWeight: ActionWeightSyslogServerUpdate,
+	DeleteWeight: ActionWeightSyslogServerDelete,
+	Marshal: func(host string, value *dozer.SpecSyslogServer) (ygot.ValidatedGoStruct, error) {
+		protocol := oc.OpenconfigSystemExt_LoggingProtocol_UNSET
+		if value.Protocol != nil {
+			ok := false
+			if protocol, ok = syslogProtocols[*value.Protocol]; !ok {
+				return nil, errors.Errorf("unknown syslog protocol %q", *value.Protocol)
+			}
+		}
+
+		severity := oc.OpenconfigSystemLogging_SyslogSeverity_UNSET
+		if value.Severity != nil {
+			ok := false
+			if severity, ok = syslogSeverities[*value.Severity]; !ok {
+				return nil, errors.Errorf("unknown syslog severity %q", *value.Severity)
+			}
+		}
+
+		return &oc.OpenconfigSystem_System_Logging_RemoteServers{
+			RemoteServer: map[string]*oc.OpenconfigSystem_System_Logging_RemoteServers_RemoteServer{
+				host: {
+					Host: pointer.To(host),
+					Config: &oc.OpenconfigSystem_System_Logging_RemoteServers_RemoteServer_Config{
+						Host:            pointer.To(host),
+						RemotePort:      value.Port,
+						Protocol:        protocol,
+						VrfName:         value.VRF,
+						Severity:        severity,
+						SourceInterface: value.SourceInterface,
+					},
+				},
+			},
+		}, nil
+	},
+}
+
+func loadActualSyslogServers(ctx context.Context, client GNMICClient, spec *dozer.Spec) error {
+	ocLogging := &oc.OpenconfigSystem_System_Logging{}
+	err := client.Get(ctx, "/system/logging/remote-servers", ocLogging)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read syslog servers")
+	}
+	spec.SyslogServers, err = unmarshalOCSyslogServers(ocLogging)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal syslog servers")
+	}
+
+	return nil
+}
+
+func unmarshalOCSyslogServers(ocVal *oc.OpenconfigSystem_System_Logging) (map[string]*dozer.SpecSyslogServer, error) { //nolint:unparam
+	if ocVal == nil || ocVal.RemoteServers == nil {
+		return map[string]*dozer.SpecSyslogServer{}, nil
+	}
+
+	servers := map[string]*dozer.SpecSyslogServer{}
+	for host, ocServer := range ocVal.RemoteServers.RemoteServer {
+		server := &dozer.SpecSyslogServer{}
+
+		if ocServer.Config != nil {
+			server.Port = ocServer.Config.RemotePort
+			server.VRF = ocServer.Config.VrfName
+			server.SourceInterface = ocServer.Config.SourceInterface
+
+			for name, value := range syslogProtocols {
+				if ocServer.Config.Protocol == value {
+					server.Protocol = pointer.To(name)
+				}
+			}
+			for name, value := range syslogSeverities {
+				if ocServer.Config.Severity == value {
+					server.Severity = pointer.To(name)
+				}
+			}
+		}
+
+		servers[host] = server
+	}
+
+	return servers, nil
+}
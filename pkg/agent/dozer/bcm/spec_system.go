@@ -108,6 +108,48 @@ func unmarshalOCSystemConfig(ocVal *oc.OpenconfigSystem_System) (*string, error)
 	return ocVal.Config.Hostname, nil
 }
 
+var specTimezoneEnforcer = &DefaultValueEnforcer[string, *dozer.SpecTimezone]{
+	Summary: "Timezone",
+	Path:    "/system/clock/config",
+	Weight:  ActionWeightTimezone,
+	Marshal: func(_ string, value *dozer.SpecTimezone) (ygot.ValidatedGoStruct, error) {
+		return &oc.OpenconfigSystem_System_Clock{
+			Config: &oc.OpenconfigSystem_System_Clock_Config{
+				TimezoneName: value.Name,
+			},
+		}, nil
+	},
+}
+
+func loadActualTimezone(ctx context.Context, client GNMICClient, spec *dozer.Spec) error {
+	ocClock := &oc.OpenconfigSystem_System_Clock{}
+	err := client.Get(ctx, "/system/clock/config", ocClock, api.DataTypeCONFIG())
+	if err != nil {
+		return errors.Wrapf(err, "failed to read clock config")
+	}
+	spec.Timezone, err = unmarshalOCClock(ocClock)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal clock config")
+	}
+
+	return nil
+}
+
+func unmarshalOCClock(ocVal *oc.OpenconfigSystem_System_Clock) (*dozer.SpecTimezone, error) { //nolint:unparam
+	if ocVal == nil || ocVal.Config == nil || ocVal.Config.TimezoneName == nil {
+		return nil, nil
+	}
+
+	// UTC is the NOS default that's the same as timezone not configured
+	if name := *ocVal.Config.TimezoneName; name == "" || name == TimezoneUTC || name == "Etc/"+TimezoneUTC {
+		return nil, nil
+	}
+
+	return &dozer.SpecTimezone{
+		Name: ocVal.Config.TimezoneName,
+	}, nil
+}
+
 var specPortGroupsEnforcer = &DefaultMapEnforcer[string, *dozer.SpecPortGroup]{
 	Summary:      "Port groups",
 	ValueHandler: specPortGroupEnforcer,
@@ -231,6 +231,14 @@ var specVRFBGPEnforcer = &DefaultValueEnforcer[string, *dozer.SpecVRFBGP]{
 			return errors.Wrap(err, "failed to handle vrf bgp import policy")
 		}
 
+		if err := specVRFImportVrfIPv6Enforcer.Handle(basePath, name, actual, desired, actions); err != nil {
+			return errors.Wrap(err, "failed to handle vrf bgp ipv6 import vrfs")
+		}
+
+		if err := specVRFImportPolicyIPv6Enforcer.Handle(basePath, name, actual, desired, actions); err != nil {
+			return errors.Wrap(err, "failed to handle vrf bgp ipv6 import policy")
+		}
+
 		actualNeighbors, desiredNeighbors := ValueOrNil(actual, desired,
 			func(value *dozer.SpecVRFBGP) map[string]*dozer.SpecVRFBGPNeighbor { return value.Neighbors })
 		if err := specVRFBGPNeighborsEnforcer.Handle(basePath, actualNeighbors, desiredNeighbors, actions); err != nil {
@@ -248,6 +256,13 @@ var specVRFBGPEnforcer = &DefaultValueEnforcer[string, *dozer.SpecVRFBGP]{
 }
 
 var specVRFBGPBaseEnforcerGetter = func(_ string, value *dozer.SpecVRFBGP) any {
+	ipv6Unicast := false
+	var ipv6MaxPaths *uint32
+	if value.IPv6Unicast != nil {
+		ipv6Unicast = value.IPv6Unicast.Enabled
+		ipv6MaxPaths = value.IPv6Unicast.MaxPaths
+	}
+
 	return []any{
 		value.AS, value.RouterID, value.NetworkImportCheck,
 		// value.IPv4Unicast, // TODO it's probably not enough for some cases, check if current approach is ok
@@ -255,6 +270,8 @@ var specVRFBGPBaseEnforcerGetter = func(_ string, value *dozer.SpecVRFBGP) any {
 		value.IPv4Unicast.MaxPaths,
 		value.IPv4Unicast.MaxPathsIBGP,
 		value.IPv4Unicast.TableMap,
+		ipv6Unicast,
+		ipv6MaxPaths,
 	}
 }
 
@@ -298,6 +315,27 @@ var specVRFBGPBaseEnforcer = &DefaultValueEnforcer[string, *dozer.SpecVRFBGP]{
 			afiSafi[oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_IPV4_UNICAST] = ipv4Unicast
 		}
 
+		if value.IPv6Unicast != nil && value.IPv6Unicast.Enabled {
+			ipv6Unicast := &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Global_AfiSafis_AfiSafi{
+				AfiSafiName: oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_IPV6_UNICAST,
+				Config: &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Global_AfiSafis_AfiSafi_Config{
+					AfiSafiName: oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_IPV6_UNICAST,
+				},
+			}
+
+			if value.IPv6Unicast.MaxPaths != nil {
+				ipv6Unicast.UseMultiplePaths = &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Global_AfiSafis_AfiSafi_UseMultiplePaths{
+					Ebgp: &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Global_AfiSafis_AfiSafi_UseMultiplePaths_Ebgp{
+						Config: &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Global_AfiSafis_AfiSafi_UseMultiplePaths_Ebgp_Config{
+							MaximumPaths: value.IPv6Unicast.MaxPaths,
+						},
+					},
+				}
+			}
+
+			afiSafi[oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_IPV6_UNICAST] = ipv6Unicast
+		}
+
 		var as oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Global_Config_As_Union
 		if value.AS != nil {
 			as = oc.UnionUint32(*value.AS)
@@ -339,6 +377,15 @@ var specVRFBGPL2VPNEnforcer = &DefaultValueEnforcer[string, *dozer.SpecVRFBGP]{
 				},
 			}
 		}
+		if value.L2VPNEVPN.AdvertiseIPv6Unicast != nil && *value.L2VPNEVPN.AdvertiseIPv6Unicast {
+			routeAdvertise[oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_IPV6_UNICAST] = &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Global_AfiSafis_AfiSafi_L2VpnEvpn_RouteAdvertise_RouteAdvertiseList{
+				AdvertiseAfiSafi: oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_IPV6_UNICAST,
+				Config: &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Global_AfiSafis_AfiSafi_L2VpnEvpn_RouteAdvertise_RouteAdvertiseList_Config{
+					AdvertiseAfiSafi: oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_IPV6_UNICAST,
+					RouteMap:         value.L2VPNEVPN.AdvertiseIPv6UnicastRouteMaps,
+				},
+			}
+		}
 
 		return &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Global_AfiSafis{
 			AfiSafi: map[oc.E_OpenconfigBgpTypes_AFI_SAFI_TYPE]*oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Global_AfiSafis_AfiSafi{ //nolint:exhaustive
@@ -368,7 +415,14 @@ var specVRFBGPNeighborsEnforcer = &DefaultMapEnforcer[string, *dozer.SpecVRFBGPN
 }
 
 var specVRFBGPNeighborEnforcer = &DefaultValueEnforcer[string, *dozer.SpecVRFBGPNeighbor]{
-	Summary:      "VRF BGP neighbor %s",
+	Summary: "VRF BGP neighbor %s",
+	// password is only returned in the stored form, so only its presence is compared
+	Getter: func(_ string, value *dozer.SpecVRFBGPNeighbor) any {
+		masked := *value
+		masked.Password = maskSecret(value.Password)
+
+		return &masked
+	},
 	Path:         "/neighbors/neighbor[neighbor-address=%s]",
 	UpdateWeight: ActionWeightVRFBGPNeighborUpdate,
 	DeleteWeight: ActionWeightVRFBGPNeighborDelete,
@@ -427,6 +481,28 @@ var specVRFBGPNeighborEnforcer = &DefaultValueEnforcer[string, *dozer.SpecVRFBGP
 			}
 		}
 
+		var ipv4Unicast *oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Neighbors_Neighbor_AfiSafis_AfiSafi_Ipv4Unicast
+		if value.IPv4UnicastMaxPrefixes != nil {
+			ipv4Unicast = &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Neighbors_Neighbor_AfiSafis_AfiSafi_Ipv4Unicast{
+				PrefixLimit: &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Neighbors_Neighbor_AfiSafis_AfiSafi_Ipv4Unicast_PrefixLimit{
+					Config: &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Neighbors_Neighbor_AfiSafis_AfiSafi_Ipv4Unicast_PrefixLimit_Config{
+						MaxPrefixes:     value.IPv4UnicastMaxPrefixes,
+						PreventTeardown: value.IPv4UnicastMaxPrefixesWarningOnly,
+					},
+				},
+			}
+		}
+
+		var timers *oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Neighbors_Neighbor_Timers
+		if value.KeepaliveInterval != nil || value.HoldTime != nil {
+			timers = &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Neighbors_Neighbor_Timers{
+				Config: &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Neighbors_Neighbor_Timers_Config{
+					KeepaliveInterval: value.KeepaliveInterval,
+					HoldTime:          value.HoldTime,
+				},
+			}
+		}
+
 		bgpNeigh := &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Neighbors{
 			Neighbor: map[string]*oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Neighbors_Neighbor{
 				name: {
@@ -439,6 +515,7 @@ var specVRFBGPNeighborEnforcer = &DefaultValueEnforcer[string, *dozer.SpecVRFBGP
 						PeerType:                       peerType,
 						DisableEbgpConnectedRouteCheck: value.DisableConnectedCheck,
 						CapabilityExtendedNexthop:      value.ExtendedNexthop,
+						AuthPassword:                   value.Password,
 					},
 					AfiSafis: &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Neighbors_Neighbor_AfiSafis{
 						AfiSafi: map[oc.E_OpenconfigBgpTypes_AFI_SAFI_TYPE]*oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Neighbors_Neighbor_AfiSafis_AfiSafi{ //nolint:exhaustive,nolintlint
@@ -450,6 +527,7 @@ var specVRFBGPNeighborEnforcer = &DefaultValueEnforcer[string, *dozer.SpecVRFBGP
 									AsOverride:  value.IPv4ASOverride,
 								},
 								ApplyPolicy: ipApplyPolicy,
+								Ipv4Unicast: ipv4Unicast,
 							},
 							oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_L2VPN_EVPN: {
 								AfiSafiName: oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_L2VPN_EVPN,
@@ -463,6 +541,7 @@ var specVRFBGPNeighborEnforcer = &DefaultValueEnforcer[string, *dozer.SpecVRFBGP
 						},
 					},
 					EnableBfd: bfd,
+					Timers:    timers,
 				},
 			},
 		}
@@ -564,6 +643,67 @@ var specVRFImportPolicyEnforcer = &DefaultValueEnforcer[string, *dozer.SpecVRFBG
 	},
 }
 
+var specVRFImportVrfIPv6Enforcer = &DefaultValueEnforcer[string, *dozer.SpecVRFBGP]{
+	Summary: "VRF BGP IPv6 import VRF %s",
+	Getter: func(name string, value *dozer.SpecVRFBGP) any {
+		return []any{specVRFBGPBaseEnforcerGetter(name, value), value.IPv6Unicast.ImportVRFs}
+	},
+	MutateDesired: func(_ string, desired *dozer.SpecVRFBGP) *dozer.SpecVRFBGP {
+		if desired != nil && (desired.IPv6Unicast == nil || len(desired.IPv6Unicast.ImportVRFs) == 0) {
+			return nil
+		}
+
+		return desired
+	},
+	MutateActual: func(_ string, actual *dozer.SpecVRFBGP) *dozer.SpecVRFBGP {
+		if actual != nil && (actual.IPv6Unicast == nil || len(actual.IPv6Unicast.ImportVRFs) == 0) {
+			return nil
+		}
+
+		return actual
+	},
+	Path:         "/global/afi-safis/afi-safi[afi-safi-name=IPV6_UNICAST]/import-network-instance/config/name",
+	UpdateWeight: ActionWeightVRFBGPImportVRFUpdate,
+	DeleteWeight: ActionWeightVRFBGPImportVRFDelete,
+	Marshal: func(_ string, value *dozer.SpecVRFBGP) (ygot.ValidatedGoStruct, error) {
+		imports := lo.Keys(value.IPv6Unicast.ImportVRFs)
+		sort.Strings(imports)
+
+		return &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Global_AfiSafis_AfiSafi_ImportNetworkInstance_Config{
+			Name: imports,
+		}, nil
+	},
+}
+
+var specVRFImportPolicyIPv6Enforcer = &DefaultValueEnforcer[string, *dozer.SpecVRFBGP]{
+	Summary: "VRF BGP IPv6 import policy %s",
+	Getter: func(name string, value *dozer.SpecVRFBGP) any {
+		return []any{specVRFBGPBaseEnforcerGetter(name, value), value.IPv6Unicast.ImportPolicy}
+	},
+	MutateDesired: func(_ string, desired *dozer.SpecVRFBGP) *dozer.SpecVRFBGP {
+		if desired != nil && (desired.IPv6Unicast == nil || desired.IPv6Unicast.ImportPolicy == nil) {
+			return nil
+		}
+
+		return desired
+	},
+	MutateActual: func(_ string, actual *dozer.SpecVRFBGP) *dozer.SpecVRFBGP {
+		if actual != nil && (actual.IPv6Unicast == nil || actual.IPv6Unicast.ImportPolicy == nil) {
+			return nil
+		}
+
+		return actual
+	},
+	Path:         "/global/afi-safis/afi-safi[afi-safi-name=IPV6_UNICAST]/import-network-instance/config/policy-name",
+	UpdateWeight: ActionWeightVRFBGPImportVRFPolicyUpdate,
+	DeleteWeight: ActionWeightVRFBGPImportVRFPolicyDelete,
+	Marshal: func(_ string, value *dozer.SpecVRFBGP) (ygot.ValidatedGoStruct, error) {
+		return &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_Protocols_Protocol_Bgp_Global_AfiSafis_AfiSafi_ImportNetworkInstance_Config{
+			PolicyName: value.IPv6Unicast.ImportPolicy,
+		}, nil
+	},
+}
+
 var specVRFTableConnectionsEnforcer = &DefaultMapEnforcer[string, *dozer.SpecVRFTableConnection]{
 	Summary:      "VRF table connections",
 	ValueHandler: specVRFTableConnectionEnforcer,
@@ -578,9 +718,13 @@ var specVRFTableConnectionEnforcer = &DefaultValueEnforcer[string, *dozer.SpecVR
 	DeleteWeight: ActionWrightVRFTableConnectionDelete,
 	Marshal: func(key string, value *dozer.SpecVRFTableConnection) (ygot.ValidatedGoStruct, error) {
 		var proto oc.E_OpenconfigPolicyTypes_INSTALL_PROTOCOL_TYPE
+		af := oc.OpenconfigTypes_ADDRESS_FAMILY_IPV4
 
 		if key == dozer.SpecVRFBGPTableConnectionConnected {
 			proto = oc.OpenconfigPolicyTypes_INSTALL_PROTOCOL_TYPE_DIRECTLY_CONNECTED
+		} else if key == dozer.SpecVRFBGPTableConnectionConnectedIPv6 {
+			proto = oc.OpenconfigPolicyTypes_INSTALL_PROTOCOL_TYPE_DIRECTLY_CONNECTED
+			af = oc.OpenconfigTypes_ADDRESS_FAMILY_IPV6
 		} else if key == dozer.SpecVRFBGPTableConnectionStatic {
 			proto = oc.OpenconfigPolicyTypes_INSTALL_PROTOCOL_TYPE_STATIC
 		} else if key == dozer.SpecVRFBGPTableConnectionAttachedHost {
@@ -594,13 +738,13 @@ var specVRFTableConnectionEnforcer = &DefaultValueEnforcer[string, *dozer.SpecVR
 				{
 					SrcProtocol:   proto,
 					DstProtocol:   oc.OpenconfigPolicyTypes_INSTALL_PROTOCOL_TYPE_BGP,
-					AddressFamily: oc.OpenconfigTypes_ADDRESS_FAMILY_IPV4,
+					AddressFamily: af,
 				}: {
-					AddressFamily: oc.OpenconfigTypes_ADDRESS_FAMILY_IPV4,
+					AddressFamily: af,
 					SrcProtocol:   proto,
 					DstProtocol:   oc.OpenconfigPolicyTypes_INSTALL_PROTOCOL_TYPE_BGP,
 					Config: &oc.OpenconfigNetworkInstance_NetworkInstances_NetworkInstance_TableConnections_TableConnection_Config{
-						AddressFamily: oc.OpenconfigTypes_ADDRESS_FAMILY_IPV4,
+						AddressFamily: af,
 						DstProtocol:   oc.OpenconfigPolicyTypes_INSTALL_PROTOCOL_TYPE_BGP,
 						SrcProtocol:   proto,
 						ImportPolicy:  value.ImportPolicies,
@@ -808,6 +952,25 @@ func unmarshalOCVRFs(ocVal *oc.OpenconfigNetworkInstance_NetworkInstances) (map[
 							}
 						}
 
+						ipv6Unicast := bgpConfig.Global.AfiSafis.AfiSafi[oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_IPV6_UNICAST]
+						if ipv6Unicast != nil {
+							bgp.IPv6Unicast = &dozer.SpecVRFBGPIPv6Unicast{
+								Enabled:    true,
+								ImportVRFs: map[string]*dozer.SpecVRFBGPImportVRF{},
+							}
+							if ipv6Unicast.ImportNetworkInstance != nil && ipv6Unicast.ImportNetworkInstance.Config != nil {
+								bgp.IPv6Unicast.ImportPolicy = ipv6Unicast.ImportNetworkInstance.Config.PolicyName
+								for _, name := range ipv6Unicast.ImportNetworkInstance.Config.Name {
+									bgp.IPv6Unicast.ImportVRFs[name] = &dozer.SpecVRFBGPImportVRF{}
+								}
+							}
+							if ipv6Unicast.UseMultiplePaths != nil && ipv6Unicast.UseMultiplePaths.Ebgp != nil && ipv6Unicast.UseMultiplePaths.Ebgp.Config != nil {
+								if ipv6Unicast.UseMultiplePaths.Ebgp.Config.MaximumPaths != nil && *ipv6Unicast.UseMultiplePaths.Ebgp.Config.MaximumPaths != 1 {
+									bgp.IPv6Unicast.MaxPaths = ipv6Unicast.UseMultiplePaths.Ebgp.Config.MaximumPaths
+								}
+							}
+						}
+
 						if bgpConfig.Global.AfiSafis.AfiSafi[oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_L2VPN_EVPN] != nil {
 							l2vpnEVPN := bgpConfig.Global.AfiSafis.AfiSafi[oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_L2VPN_EVPN].L2VpnEvpn
 							if l2vpnEVPN != nil {
@@ -818,11 +981,15 @@ func unmarshalOCVRFs(ocVal *oc.OpenconfigNetworkInstance_NetworkInstances) (map[
 								}
 								if l2vpnEVPN.RouteAdvertise != nil {
 									for _, route := range l2vpnEVPN.RouteAdvertise.RouteAdvertiseList {
-										if route.Config != nil && route.Config.AdvertiseAfiSafi == oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_IPV4_UNICAST {
+										if route.Config == nil {
+											continue
+										}
+										if route.Config.AdvertiseAfiSafi == oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_IPV4_UNICAST {
 											bgp.L2VPNEVPN.AdvertiseIPv4Unicast = pointer.To(true)
 											bgp.L2VPNEVPN.AdvertiseIPv4UnicastRouteMaps = route.Config.RouteMap
-
-											break
+										} else if route.Config.AdvertiseAfiSafi == oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_IPV6_UNICAST {
+											bgp.L2VPNEVPN.AdvertiseIPv6Unicast = pointer.To(true)
+											bgp.L2VPNEVPN.AdvertiseIPv6UnicastRouteMaps = route.Config.RouteMap
 										}
 									}
 								}
@@ -843,6 +1010,8 @@ func unmarshalOCVRFs(ocVal *oc.OpenconfigNetworkInstance_NetworkInstances) (map[
 						var l2vpnEVPN *bool
 						var l2ImportPolicies []string
 						var l2VPNEVPNAllowOwnAS *bool
+						var ipv4MaxPrefixes *uint32
+						var ipv4MaxPrefixesWarningOnly *bool
 						if neighbor.AfiSafis != nil && neighbor.AfiSafis.AfiSafi != nil {
 							ocIPv4Unicast := neighbor.AfiSafis.AfiSafi[oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_IPV4_UNICAST]
 							if ocIPv4Unicast != nil && ocIPv4Unicast.Config != nil {
@@ -853,6 +1022,10 @@ func unmarshalOCVRFs(ocVal *oc.OpenconfigNetworkInstance_NetworkInstances) (map[
 								}
 								ipv4ASOverride = ocIPv4Unicast.Config.AsOverride
 							}
+							if ocIPv4Unicast != nil && ocIPv4Unicast.Ipv4Unicast != nil && ocIPv4Unicast.Ipv4Unicast.PrefixLimit != nil && ocIPv4Unicast.Ipv4Unicast.PrefixLimit.Config != nil {
+								ipv4MaxPrefixes = ocIPv4Unicast.Ipv4Unicast.PrefixLimit.Config.MaxPrefixes
+								ipv4MaxPrefixesWarningOnly = ocIPv4Unicast.Ipv4Unicast.PrefixLimit.Config.PreventTeardown
+							}
 
 							ocL2VPNEVPN := neighbor.AfiSafis.AfiSafi[oc.OpenconfigBgpTypes_AFI_SAFI_TYPE_L2VPN_EVPN]
 							if ocL2VPNEVPN != nil {
@@ -893,24 +1066,31 @@ func unmarshalOCVRFs(ocVal *oc.OpenconfigNetworkInstance_NetworkInstances) (map[
 						}
 
 						bgp.Neighbors[neighborName] = &dozer.SpecVRFBGPNeighbor{
-							Enabled:                   neighbor.Config.Enabled,
-							Description:               neighbor.Config.Description,
-							RemoteAS:                  remoteAS,
-							PeerType:                  peerType,
-							IPv4Unicast:               ipv4Unicast,
-							IPv4UnicastImportPolicies: ipv4ImportPolicies,
-							IPv4UnicastExportPolicies: ipv4ExportPolicies,
-							IPv4ASOverride:            ipv4ASOverride,
-							L2VPNEVPN:                 l2vpnEVPN,
-							L2VPNEVPNImportPolicies:   l2ImportPolicies,
-							L2VPNEVPNAllowOwnAS:       l2VPNEVPNAllowOwnAS,
-							BFDProfile:                bfdProfile,
-							DisableConnectedCheck:     neighbor.Config.DisableEbgpConnectedRouteCheck,
-							ExtendedNexthop:           neighbor.Config.CapabilityExtendedNexthop,
+							Enabled:                           neighbor.Config.Enabled,
+							Description:                       neighbor.Config.Description,
+							RemoteAS:                          remoteAS,
+							PeerType:                          peerType,
+							IPv4Unicast:                       ipv4Unicast,
+							IPv4UnicastImportPolicies:         ipv4ImportPolicies,
+							IPv4UnicastExportPolicies:         ipv4ExportPolicies,
+							IPv4ASOverride:                    ipv4ASOverride,
+							L2VPNEVPN:                         l2vpnEVPN,
+							L2VPNEVPNImportPolicies:           l2ImportPolicies,
+							L2VPNEVPNAllowOwnAS:               l2VPNEVPNAllowOwnAS,
+							BFDProfile:                        bfdProfile,
+							DisableConnectedCheck:             neighbor.Config.DisableEbgpConnectedRouteCheck,
+							ExtendedNexthop:                   neighbor.Config.CapabilityExtendedNexthop,
+							Password:                          neighbor.Config.AuthPassword,
+							IPv4UnicastMaxPrefixes:            ipv4MaxPrefixes,
+							IPv4UnicastMaxPrefixesWarningOnly: ipv4MaxPrefixesWarningOnly,
 						}
 						if neighbor.Transport != nil && neighbor.Transport.Config != nil {
 							bgp.Neighbors[neighborName].UpdateSource = neighbor.Transport.Config.LocalAddress
 						}
+						if neighbor.Timers != nil && neighbor.Timers.Config != nil {
+							bgp.Neighbors[neighborName].KeepaliveInterval = neighbor.Timers.Config.KeepaliveInterval
+							bgp.Neighbors[neighborName].HoldTime = neighbor.Timers.Config.HoldTime
+						}
 					}
 				}
 			}
@@ -923,12 +1103,21 @@ func unmarshalOCVRFs(ocVal *oc.OpenconfigNetworkInstance_NetworkInstances) (map[
 				if key.DstProtocol != oc.OpenconfigPolicyTypes_INSTALL_PROTOCOL_TYPE_BGP {
 					continue
 				}
-				if key.AddressFamily != oc.OpenconfigTypes_ADDRESS_FAMILY_IPV4 {
+				if key.AddressFamily != oc.OpenconfigTypes_ADDRESS_FAMILY_IPV4 && key.AddressFamily != oc.OpenconfigTypes_ADDRESS_FAMILY_IPV6 {
 					continue
 				}
 				if tableConnection.Config == nil {
 					continue
 				}
+				if key.AddressFamily == oc.OpenconfigTypes_ADDRESS_FAMILY_IPV6 {
+					if key.SrcProtocol == oc.OpenconfigPolicyTypes_INSTALL_PROTOCOL_TYPE_DIRECTLY_CONNECTED {
+						tableConns[dozer.SpecVRFBGPTableConnectionConnectedIPv6] = &dozer.SpecVRFTableConnection{
+							ImportPolicies: tableConnection.Config.ImportPolicy,
+						}
+					}
+
+					continue
+				}
 
 				name := ""
 				switch key.SrcProtocol { //nolint:exhaustive
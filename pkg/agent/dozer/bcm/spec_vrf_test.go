@@ -0,0 +1,49 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package bcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.githedgehog.com/fabric/pkg/agent/dozer"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+func TestSpecVRFBGPNeighborPasswordEnforcer(t *testing.T) {
+	const neighbor = "172.30.0.1"
+
+	for _, tt := range []struct {
+		name        string
+		actual      *dozer.SpecVRFBGPNeighbor
+		desired     *dozer.SpecVRFBGPNeighbor
+		wantActions int
+	}{
+		{
+			name:        "password in stored form",
+			actual:      &dozer.SpecVRFBGPNeighbor{RemoteAS: pointer.To(uint32(65100)), Password: pointer.To("U2FsdGVkX1+stored")},
+			desired:     &dozer.SpecVRFBGPNeighbor{RemoteAS: pointer.To(uint32(65100)), Password: pointer.To("secret")},
+			wantActions: 0,
+		},
+		{
+			name:        "password added",
+			actual:      &dozer.SpecVRFBGPNeighbor{RemoteAS: pointer.To(uint32(65100))},
+			desired:     &dozer.SpecVRFBGPNeighbor{RemoteAS: pointer.To(uint32(65100)), Password: pointer.To("secret")},
+			wantActions: 1,
+		},
+		{
+			name:        "other field changed",
+			actual:      &dozer.SpecVRFBGPNeighbor{RemoteAS: pointer.To(uint32(65100)), Password: pointer.To("U2FsdGVkX1+stored")},
+			desired:     &dozer.SpecVRFBGPNeighbor{RemoteAS: pointer.To(uint32(65101)), Password: pointer.To("secret")},
+			wantActions: 1,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actions := &ActionQueue{}
+			err := specVRFBGPNeighborEnforcer.Handle("", neighbor, tt.actual, tt.desired, actions)
+			require.NoError(t, err)
+			require.Len(t, actions.actions, tt.wantActions)
+		})
+	}
+}
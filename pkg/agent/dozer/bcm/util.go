@@ -103,3 +103,13 @@ func UnmarshalPortBreakout(mode string) string {
 
 	return mode
 }
+
+// maskSecret is used to compare secrets that the NOS only returns in the stored (encrypted) form, so only their
+// presence is compared and changing the value alone doesn't trigger an update
+func maskSecret(secret *string) *string {
+	if secret == nil || *secret == "" {
+		return nil
+	}
+
+	return pointer.To("******")
+}
@@ -16,6 +16,7 @@ package dozer
 
 import (
 	"context"
+	"encoding/json"
 	"slices"
 	"sort"
 	"strings"
@@ -33,7 +34,8 @@ type Processor interface {
 	LoadActualState(ctx context.Context, agent *agentapi.Agent) (*Spec, error)
 	PlanDesiredState(ctx context.Context, agent *agentapi.Agent) (*Spec, error)
 	CalculateActions(ctx context.Context, actual, desired *Spec) ([]Action, error)
-	ApplyActions(ctx context.Context, actions []Action) ([]string, error) // warnings
+	// ApplyActions applies actions and if previous is set, rolls back to it in case of failure
+	ApplyActions(ctx context.Context, agent *agentapi.Agent, previous *Spec, actions []Action) ([]string, error) // warnings
 	UpdateSwitchState(ctx context.Context, agent *agentapi.Agent, reg *switchstate.Registry) error
 	Reboot(ctx context.Context, force bool) error
 	Reinstall(ctx context.Context) error
@@ -42,6 +44,13 @@ type Processor interface {
 	SetRoCE(ctx context.Context, enable bool) error
 }
 
+var (
+	// ErrRolledBack is returned by ApplyActions if applying failed and the previous state was restored
+	ErrRolledBack = errors.New("failed to apply actions, rolled back")
+	// ErrRollbackFailed is returned by ApplyActions if applying failed and the previous state wasn't restored
+	ErrRollbackFailed = errors.New("failed to apply actions, rollback failed")
+)
+
 type Action interface {
 	Summary() string
 }
@@ -53,7 +62,12 @@ type Spec struct {
 	LLDPInterfaces       map[string]*SpecLLDPInterface     `json:"lldpInterfaces,omitempty"`
 	NTP                  *SpecNTP                          `json:"ntp,omitempty"`
 	NTPServers           map[string]*SpecNTPServer         `json:"ntpServers,omitempty"`
+	DNS                  *SpecDNS                          `json:"dns,omitempty"`
+	Timezone             *SpecTimezone                     `json:"timezone,omitempty"`
+	SyslogServers        map[string]*SpecSyslogServer      `json:"syslogServers,omitempty"`
 	Users                map[string]*SpecUser              `json:"users,omitempty"`
+	AAA                  *SpecAAA                          `json:"aaa,omitempty"`
+	SNMP                 *SpecSNMP                         `json:"snmp,omitempty"`
 	PortGroups           map[string]*SpecPortGroup         `json:"portGroupSpeeds,omitempty"`
 	PortBreakouts        map[string]*SpecPortBreakout      `json:"portBreakouts,omitempty"`
 	Interfaces           map[string]*SpecInterface         `json:"interfaces,omitempty"`
@@ -78,6 +92,9 @@ type Spec struct {
 	ErrDisableGlobal     *SpecErrDisableGlobal             `json:"errDisableGlobal,omitempty"`
 	ErrDisableInterfaces map[string]*SpecErrDisable        `json:"errDisableInterfaces,omitempty"`
 	NeighborGlobal       *SpecNeighborGlobal               `json:"neighborGlobal,omitempty"`
+	StormControls        map[string]*SpecStormControl      `json:"stormControls,omitempty"`
+	MirrorSessions       map[string]*SpecMirrorSession     `json:"mirrorSessions,omitempty"`
+	SFlow                *SpecSFlow                        `json:"sflow,omitempty"`
 }
 
 type SpecLLDP struct {
@@ -94,18 +111,66 @@ type SpecLLDPInterface struct {
 
 type SpecNTP struct {
 	SourceInterface []string `json:"sourceInterface,omitempty"`
+	VRF             *string  `json:"vrf,omitempty"`
 }
 
 type SpecNTPServer struct {
 	Prefer *bool `json:"prefer,omitempty"`
 }
 
+type SpecDNS struct {
+	SearchDomain *string                   `json:"searchDomain,omitempty"`
+	Servers      map[string]*SpecDNSServer `json:"servers,omitempty"`
+}
+
+type SpecDNSServer struct{}
+
+type SpecTimezone struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type SpecSyslogServer struct {
+	Port            *uint16 `json:"port,omitempty"`
+	Protocol        *string `json:"protocol,omitempty"`
+	VRF             *string `json:"vrf,omitempty"`
+	Severity        *string `json:"severity,omitempty"`
+	SourceInterface *string `json:"sourceInterface,omitempty"`
+}
+
 type SpecUser struct {
 	Password       string   `json:"password,omitempty"`
 	Role           string   `json:"role,omitempty"`
 	AuthorizedKeys []string `json:"authorizedKeys,omitempty"`
 }
 
+type SpecAAA struct {
+	LoginMethods   []string                  `json:"loginMethods,omitempty"`
+	Failthrough    *bool                     `json:"failthrough,omitempty"`
+	AdminPrivLevel *uint8                    `json:"adminPrivLevel,omitempty"`
+	TACACSServers  map[string]*SpecAAAServer `json:"tacacsServers,omitempty"`
+	RADIUSServers  map[string]*SpecAAAServer `json:"radiusServers,omitempty"`
+}
+
+type SpecAAAServer struct {
+	Port     *uint16 `json:"port,omitempty"`
+	Secret   *string `json:"secret,omitempty"`
+	Priority *uint8  `json:"priority,omitempty"`
+	VRF      *string `json:"vrf,omitempty"`
+}
+
+type SpecSNMP struct {
+	Location *string                  `json:"location,omitempty"`
+	Contact  *string                  `json:"contact,omitempty"`
+	Users    map[string]*SpecSNMPUser `json:"users,omitempty"`
+}
+
+type SpecSNMPUser struct {
+	AuthType *string `json:"authType,omitempty"`
+	AuthKey  *string `json:"authKey,omitempty"`
+	PrivType *string `json:"privType,omitempty"`
+	PrivKey  *string `json:"privKey,omitempty"`
+}
+
 type SpecPortGroup struct {
 	Speed *string
 }
@@ -124,20 +189,21 @@ type SpecStaticARP struct {
 }
 
 type SpecInterface struct {
-	Description        *string                      `json:"description,omitempty"`
-	Enabled            *bool                        `json:"enabled,omitempty"`
-	PortChannel        *string                      `json:"portChannel,omitempty"`
-	AccessVLAN         *uint16                      `json:"accessVLAN,omitempty"`
-	TrunkVLANs         []string                     `json:"trunkVLANs,omitempty"`
-	MTU                *uint16                      `json:"mtu,omitempty"`
-	Speed              *string                      `json:"speed,omitempty"`
-	AutoNegotiate      *bool                        `json:"autoNegotiate,omitempty"`
-	FEC                *string                      `json:"fec,omitempty"`
-	VLANIPs            map[string]*SpecInterfaceIP  `json:"vlanIPs,omitempty"`
-	VLANAnycastGateway []string                     `json:"vlanAnycastGateway,omitempty"`
-	Subinterfaces      map[uint32]*SpecSubinterface `json:"subinterfaces,omitempty"`
-	ProxyARP           *SpecProxyARP                `json:"proxyARP,omitempty"`
-	StaticARPs         map[string]*SpecStaticARP    `json:"staticARPs,omitempty"`
+	Description            *string                      `json:"description,omitempty"`
+	Enabled                *bool                        `json:"enabled,omitempty"`
+	PortChannel            *string                      `json:"portChannel,omitempty"`
+	AccessVLAN             *uint16                      `json:"accessVLAN,omitempty"`
+	TrunkVLANs             []string                     `json:"trunkVLANs,omitempty"`
+	MTU                    *uint16                      `json:"mtu,omitempty"`
+	Speed                  *string                      `json:"speed,omitempty"`
+	AutoNegotiate          *bool                        `json:"autoNegotiate,omitempty"`
+	FEC                    *string                      `json:"fec,omitempty"`
+	VLANIPs                map[string]*SpecInterfaceIP  `json:"vlanIPs,omitempty"`
+	VLANAnycastGateway     []string                     `json:"vlanAnycastGateway,omitempty"`
+	VLANAnycastGatewayIPv6 []string                     `json:"vlanAnycastGatewayIPv6,omitempty"`
+	Subinterfaces          map[uint32]*SpecSubinterface `json:"subinterfaces,omitempty"`
+	ProxyARP               *SpecProxyARP                `json:"proxyARP,omitempty"`
+	StaticARPs             map[string]*SpecStaticARP    `json:"staticARPs,omitempty"`
 }
 
 type SpecInterfaceIP struct {
@@ -178,6 +244,7 @@ type SpecVRFBGP struct {
 	RouterID           *string                        `json:"routerID,omitempty"`
 	NetworkImportCheck *bool                          `json:"networkImportCheck,omitempty"`
 	IPv4Unicast        SpecVRFBGPIPv4Unicast          `json:"ipv4Unicast,omitempty"`
+	IPv6Unicast        *SpecVRFBGPIPv6Unicast         `json:"ipv6Unicast,omitempty"`
 	L2VPNEVPN          SpecVRFBGPL2VPNEVPN            `json:"l2vpnEvpn,omitempty"`
 	Neighbors          map[string]*SpecVRFBGPNeighbor `json:"neighbors,omitempty"`
 }
@@ -192,32 +259,46 @@ type SpecVRFBGPIPv4Unicast struct {
 	TableMap     *string                         `json:"tableMap,omitempty"`
 }
 
+type SpecVRFBGPIPv6Unicast struct {
+	Enabled      bool                            `json:"enable,omitempty"`
+	MaxPaths     *uint32                         `json:"maxPaths,omitempty"`
+	ImportVRFs   map[string]*SpecVRFBGPImportVRF `json:"importVRFs,omitempty"`
+	ImportPolicy *string                         `json:"importPolicy,omitempty"`
+}
+
 type SpecVRFBGPL2VPNEVPN struct {
 	Enabled                       bool     `json:"enable,omitempty"`
 	AdvertiseAllVNI               *bool    `json:"advertiseAllVnis,omitempty"`
 	AdvertiseIPv4Unicast          *bool    `json:"advertiseIPv4Unicast,omitempty"`
 	AdvertiseIPv4UnicastRouteMaps []string `json:"advertiseIPv4UnicastRouteMaps,omitempty"`
+	AdvertiseIPv6Unicast          *bool    `json:"advertiseIPv6Unicast,omitempty"`
+	AdvertiseIPv6UnicastRouteMaps []string `json:"advertiseIPv6UnicastRouteMaps,omitempty"`
 	AdvertiseDefaultGw            *bool    `json:"advertiseDefaultGw,omitempty"`
 }
 
 type SpecVRFBGPNetwork struct{}
 
 type SpecVRFBGPNeighbor struct {
-	Enabled                   *bool    `json:"enabled,omitempty"`
-	Description               *string  `json:"description,omitempty"`
-	RemoteAS                  *uint32  `json:"remoteAS,omitempty"`
-	PeerType                  *string  `json:"peerType,omitempty"`
-	IPv4Unicast               *bool    `json:"ipv4Unicast,omitempty"`
-	IPv4UnicastImportPolicies []string `json:"ipv4UnicastImportPolicies,omitempty"`
-	IPv4UnicastExportPolicies []string `json:"ipv4UnicastExportPolicies,omitempty"`
-	IPv4ASOverride            *bool    `json:"ipv4ASOverride,omitempty"`
-	L2VPNEVPN                 *bool    `json:"l2vpnEvpn,omitempty"`
-	L2VPNEVPNImportPolicies   []string `json:"l2vpnEvpnImportPolicies,omitempty"`
-	L2VPNEVPNAllowOwnAS       *bool    `json:"l2vpnEvpnAllowOwnAS,omitempty"`
-	BFDProfile                *string  `json:"bfdProfile,omitempty"`
-	DisableConnectedCheck     *bool    `json:"disableConnectedCheck,omitempty"`
-	UpdateSource              *string  `json:"updateSource,omitempty"`
-	ExtendedNexthop           *bool    `json:"extendedNexthop,omitempty"`
+	Enabled                           *bool    `json:"enabled,omitempty"`
+	Description                       *string  `json:"description,omitempty"`
+	RemoteAS                          *uint32  `json:"remoteAS,omitempty"`
+	PeerType                          *string  `json:"peerType,omitempty"`
+	IPv4Unicast                       *bool    `json:"ipv4Unicast,omitempty"`
+	IPv4UnicastImportPolicies         []string `json:"ipv4UnicastImportPolicies,omitempty"`
+	IPv4UnicastExportPolicies         []string `json:"ipv4UnicastExportPolicies,omitempty"`
+	IPv4ASOverride                    *bool    `json:"ipv4ASOverride,omitempty"`
+	L2VPNEVPN                         *bool    `json:"l2vpnEvpn,omitempty"`
+	L2VPNEVPNImportPolicies           []string `json:"l2vpnEvpnImportPolicies,omitempty"`
+	L2VPNEVPNAllowOwnAS               *bool    `json:"l2vpnEvpnAllowOwnAS,omitempty"`
+	BFDProfile                        *string  `json:"bfdProfile,omitempty"`
+	DisableConnectedCheck             *bool    `json:"disableConnectedCheck,omitempty"`
+	UpdateSource                      *string  `json:"updateSource,omitempty"`
+	ExtendedNexthop                   *bool    `json:"extendedNexthop,omitempty"`
+	Password                          *string  `json:"password,omitempty"`
+	KeepaliveInterval                 *uint16  `json:"keepaliveInterval,omitempty"`
+	HoldTime                          *uint16  `json:"holdTime,omitempty"`
+	IPv4UnicastMaxPrefixes            *uint32  `json:"ipv4UnicastMaxPrefixes,omitempty"`
+	IPv4UnicastMaxPrefixesWarningOnly *bool    `json:"ipv4UnicastMaxPrefixesWarningOnly,omitempty"`
 }
 
 const (
@@ -281,6 +362,7 @@ const (
 )
 
 type SpecPrefixList struct {
+	IPv6     bool                            `json:"ipv6,omitempty"`
 	Prefixes map[uint32]*SpecPrefixListEntry `json:"prefixes,omitempty"`
 }
 
@@ -312,9 +394,10 @@ const (
 )
 
 const (
-	SpecVRFBGPTableConnectionConnected    = "connected"
-	SpecVRFBGPTableConnectionStatic       = "static"
-	SpecVRFBGPTableConnectionAttachedHost = "attachedhost"
+	SpecVRFBGPTableConnectionConnected     = "connected"
+	SpecVRFBGPTableConnectionConnectedIPv6 = "connected-ipv6"
+	SpecVRFBGPTableConnectionStatic        = "static"
+	SpecVRFBGPTableConnectionAttachedHost  = "attachedhost"
 )
 
 type SpecVRFBGPImportVRF struct{}
@@ -336,6 +419,46 @@ type SpecErrDisable struct {
 	RecoveryInterval *uint32 `json:"recoveryInterval,omitempty"`
 }
 
+type SpecStormControl struct {
+	Broadcast        *SpecStormControlThreshold `json:"broadcast,omitempty"`
+	UnknownUnicast   *SpecStormControlThreshold `json:"unknownUnicast,omitempty"`
+	UnknownMulticast *SpecStormControlThreshold `json:"unknownMulticast,omitempty"`
+}
+
+type SpecStormControlThreshold struct {
+	KBPS *uint64 `json:"kbps,omitempty"`
+	PPS  *uint64 `json:"pps,omitempty"`
+}
+
+type SpecMirrorSession struct {
+	SourcePorts     []string `json:"sourcePorts,omitempty"`
+	Direction       *string  `json:"direction,omitempty"`
+	DestinationPort *string  `json:"destinationPort,omitempty"` // SPAN
+	SourceIP        *string  `json:"sourceIP,omitempty"`        // ERSPAN
+	DestinationIP   *string  `json:"destinationIP,omitempty"`   // ERSPAN
+	VRF             *string  `json:"vrf,omitempty"`             // ERSPAN
+	DSCP            *uint8   `json:"dscp,omitempty"`            // ERSPAN
+	TTL             *uint8   `json:"ttl,omitempty"`             // ERSPAN
+}
+
+type SpecSFlow struct {
+	Enabled         *bool                          `json:"enabled,omitempty"`
+	PollingInterval *uint16                        `json:"pollingInterval,omitempty"`
+	Collectors      map[string]*SpecSFlowCollector `json:"collectors,omitempty"`
+	Interfaces      map[string]*SpecSFlowInterface `json:"interfaces,omitempty"`
+}
+
+type SpecSFlowCollector struct {
+	Address *string `json:"address,omitempty"`
+	Port    *uint16 `json:"port,omitempty"`
+	VRF     *string `json:"vrf,omitempty"`
+}
+
+type SpecSFlowInterface struct {
+	Enabled    *bool   `json:"enabled,omitempty"`
+	SampleRate *uint32 `json:"sampleRate,omitempty"`
+}
+
 type SpecNeighborGlobal struct {
 	IPv4DropNeighborAgingTime *uint16 `json:"ipv4DropNeighborAgingTime,omitempty"`
 }
@@ -350,6 +473,7 @@ type SpecDHCPRelay struct {
 
 type SpecACL struct {
 	Description *string                  `json:"description,omitempty"`
+	IPv6        bool                     `json:"ipv6,omitempty"` // ACL_IPV6 instead of ACL_IPV4
 	Entries     map[uint32]*SpecACLEntry `json:"entries,omitempty"`
 }
 
@@ -371,11 +495,12 @@ type SpecACLEntry struct {
 type SpecACLEntryProtocol string
 
 const (
-	SpecACLEntryProtocolUnset SpecACLEntryProtocol = ""
-	SpecACLEntryProtocolIP    SpecACLEntryProtocol = "IP"
-	SpecACLEntryProtocolTCP   SpecACLEntryProtocol = "TCP"
-	SpecACLEntryProtocolUDP   SpecACLEntryProtocol = "UDP"
-	SpecACLEntryProtocolICMP  SpecACLEntryProtocol = "ICMP"
+	SpecACLEntryProtocolUnset  SpecACLEntryProtocol = ""
+	SpecACLEntryProtocolIP     SpecACLEntryProtocol = "IP"
+	SpecACLEntryProtocolTCP    SpecACLEntryProtocol = "TCP"
+	SpecACLEntryProtocolUDP    SpecACLEntryProtocol = "UDP"
+	SpecACLEntryProtocolICMP   SpecACLEntryProtocol = "ICMP"
+	SpecACLEntryProtocolICMPv6 SpecACLEntryProtocol = "ICMPV6" // ACL_IPV6 only
 )
 
 type SpecACLEntryTCPFlag string
@@ -405,8 +530,10 @@ const (
 )
 
 type SpecACLInterface struct {
-	Ingress *string `json:"ingress,omitempty"`
-	Egress  *string `json:"egress,omitempty"`
+	Ingress     *string `json:"ingress,omitempty"`
+	Egress      *string `json:"egress,omitempty"`
+	IngressIPv6 *string `json:"ingressIPv6,omitempty"`
+	EgressIPv6  *string `json:"egressIPv6,omitempty"`
 }
 
 type SpecVXLANTunnel struct {
@@ -432,8 +559,11 @@ type SpecVRFVNIEntry struct {
 type SpecSuppressVLANNeigh struct{}
 
 type SpecPortChannelConfig struct {
-	SystemMAC *string `json:"systemMAC,omitempty"`
-	Fallback  *bool   `json:"fallback,omitempty"`
+	SystemMAC      *string `json:"systemMAC,omitempty"`
+	Fallback       *bool   `json:"fallback,omitempty"`
+	FastRate       *bool   `json:"fastRate,omitempty"`
+	MinLinks       *uint16 `json:"minLinks,omitempty"`
+	SystemPriority *uint16 `json:"systemPriority,omitempty"`
 }
 
 type SpecLSTGroup struct {
@@ -482,7 +612,7 @@ func (s *Spec) Normalize() {
 
 	// Normalize ACL entry protocols: SpecACLEntryProtocolUnset ("") and
 	// SpecACLEntryProtocolIP ("IP") are semantically identical for ACL_IPV4
-	// entries (both mean "match any IP protocol"). Normalize to IP so that
+	// and ACL_IPV6 entries (both mean "match any IP protocol"). Normalize to IP so that
 	// actual state read from the switch (which returns no protocol field for
 	// match-any entries) compares equal to desired state entries that
 	// explicitly set Protocol: IP.
@@ -495,6 +625,21 @@ func (s *Spec) Normalize() {
 	}
 }
 
+// Clone returns a deep copy of the spec, e.g. to snapshot the actual state before applying actions
+func (s *Spec) Clone() (*Spec, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal spec")
+	}
+
+	clone := &Spec{}
+	if err := json.Unmarshal(data, clone); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal spec")
+	}
+
+	return clone, nil
+}
+
 func (s *Spec) CleanupSensetive() {
 	users := map[string]*SpecUser{}
 	for name, user := range s.Users {
@@ -503,6 +648,60 @@ func (s *Spec) CleanupSensetive() {
 		}
 	}
 	s.Users = users
+
+	if s.AAA != nil {
+		aaa := *s.AAA
+		aaa.TACACSServers = cleanupAAAServers(aaa.TACACSServers)
+		aaa.RADIUSServers = cleanupAAAServers(aaa.RADIUSServers)
+		s.AAA = &aaa
+	}
+
+	if s.SNMP != nil {
+		snmp := *s.SNMP
+		snmp.Users = map[string]*SpecSNMPUser{}
+		for name, user := range s.SNMP.Users {
+			if user == nil {
+				continue
+			}
+			snmp.Users[name] = &SpecSNMPUser{
+				AuthType: user.AuthType,
+				PrivType: user.PrivType,
+			}
+		}
+		s.SNMP = &snmp
+	}
+
+	for _, vrf := range s.VRFs {
+		if vrf == nil || vrf.BGP == nil {
+			continue
+		}
+		for name, neigh := range vrf.BGP.Neighbors {
+			if neigh == nil || neigh.Password == nil {
+				continue
+			}
+			cleaned := *neigh
+			cleaned.Password = nil
+			vrf.BGP.Neighbors[name] = &cleaned
+		}
+	}
+}
+
+func cleanupAAAServers(servers map[string]*SpecAAAServer) map[string]*SpecAAAServer {
+	if servers == nil {
+		return nil
+	}
+
+	cleaned := map[string]*SpecAAAServer{}
+	for name, server := range servers {
+		if server == nil {
+			continue
+		}
+		cleanedServer := *server
+		cleanedServer.Secret = nil
+		cleaned[name] = &cleanedServer
+	}
+
+	return cleaned
 }
 
 func (s *Spec) MarshalYAML() ([]byte, error) {
@@ -543,7 +742,15 @@ var (
 	_ SpecPart = (*SpecLLDPInterface)(nil)
 	_ SpecPart = (*SpecNTP)(nil)
 	_ SpecPart = (*SpecNTPServer)(nil)
+	_ SpecPart = (*SpecDNS)(nil)
+	_ SpecPart = (*SpecDNSServer)(nil)
+	_ SpecPart = (*SpecTimezone)(nil)
+	_ SpecPart = (*SpecSyslogServer)(nil)
 	_ SpecPart = (*SpecUser)(nil)
+	_ SpecPart = (*SpecAAA)(nil)
+	_ SpecPart = (*SpecAAAServer)(nil)
+	_ SpecPart = (*SpecSNMP)(nil)
+	_ SpecPart = (*SpecSNMPUser)(nil)
 	_ SpecPart = (*SpecPortGroup)(nil)
 	_ SpecPart = (*SpecPortBreakout)(nil)
 	_ SpecPart = (*SpecInterface)(nil)
@@ -583,6 +790,12 @@ var (
 	_ SpecPart = (*SpecErrDisableGlobal)(nil)
 	_ SpecPart = (*SpecErrDisable)(nil)
 	_ SpecPart = (*SpecNeighborGlobal)(nil)
+	_ SpecPart = (*SpecStormControl)(nil)
+	_ SpecPart = (*SpecStormControlThreshold)(nil)
+	_ SpecPart = (*SpecMirrorSession)(nil)
+	_ SpecPart = (*SpecSFlow)(nil)
+	_ SpecPart = (*SpecSFlowCollector)(nil)
+	_ SpecPart = (*SpecSFlowInterface)(nil)
 )
 
 func (s *Spec) IsNil() bool {
@@ -605,10 +818,42 @@ func (s *SpecNTPServer) IsNil() bool {
 	return s == nil
 }
 
+func (s *SpecDNS) IsNil() bool {
+	return s == nil
+}
+
+func (s *SpecDNSServer) IsNil() bool {
+	return s == nil
+}
+
+func (s *SpecTimezone) IsNil() bool {
+	return s == nil
+}
+
+func (s *SpecSyslogServer) IsNil() bool {
+	return s == nil
+}
+
 func (s *SpecUser) IsNil() bool {
 	return s == nil
 }
 
+func (s *SpecAAA) IsNil() bool {
+	return s == nil
+}
+
+func (s *SpecAAAServer) IsNil() bool {
+	return s == nil
+}
+
+func (s *SpecSNMP) IsNil() bool {
+	return s == nil
+}
+
+func (s *SpecSNMPUser) IsNil() bool {
+	return s == nil
+}
+
 func (s *SpecPortGroup) IsNil() bool {
 	return s == nil
 }
@@ -765,6 +1010,30 @@ func (s *SpecNeighborGlobal) IsNil() bool {
 	return s == nil
 }
 
+func (s *SpecStormControl) IsNil() bool {
+	return s == nil
+}
+
+func (s *SpecStormControlThreshold) IsNil() bool {
+	return s == nil
+}
+
+func (s *SpecMirrorSession) IsNil() bool {
+	return s == nil
+}
+
+func (s *SpecSFlow) IsNil() bool {
+	return s == nil
+}
+
+func (s *SpecSFlowCollector) IsNil() bool {
+	return s == nil
+}
+
+func (s *SpecSFlowInterface) IsNil() bool {
+	return s == nil
+}
+
 func (s *SpecInterfaceIPv6) IsNil() bool {
 	return s == nil
 }
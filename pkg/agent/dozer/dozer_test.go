@@ -0,0 +1,76 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package dozer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
+)
+
+func TestSpecClone(t *testing.T) {
+	spec := &Spec{
+		Hostname: pointer.To("leaf-01"),
+		Users: map[string]*SpecUser{
+			"admin": {Password: "secret", Role: "admin"},
+		},
+		AAA: &SpecAAA{
+			TACACSServers: map[string]*SpecAAAServer{
+				"10.0.0.10": {Port: pointer.To(uint16(49)), Secret: pointer.To("aaa-secret")},
+			},
+		},
+		VRFs: map[string]*SpecVRF{
+			"VrfV1": {
+				Enabled: pointer.To(true),
+				BGP: &SpecVRFBGP{
+					Neighbors: map[string]*SpecVRFBGPNeighbor{
+						"10.0.0.1": {RemoteAS: pointer.To(uint32(65101)), Password: pointer.To("bgp-secret")},
+					},
+				},
+			},
+		},
+	}
+
+	clone, err := spec.Clone()
+	require.NoError(t, err)
+	require.Equal(t, spec, clone)
+
+	// cleaning up the original must not affect the snapshot
+	spec.CleanupSensetive()
+	*spec.Hostname = "leaf-02"
+
+	require.Equal(t, "leaf-01", *clone.Hostname)
+	require.Equal(t, "secret", clone.Users["admin"].Password)
+	require.Equal(t, "bgp-secret", *clone.VRFs["VrfV1"].BGP.Neighbors["10.0.0.1"].Password)
+	require.Equal(t, "aaa-secret", *clone.AAA.TACACSServers["10.0.0.10"].Secret)
+}
+
+func TestSpecCleanupSensetiveAAA(t *testing.T) {
+	spec := &Spec{
+		AAA: &SpecAAA{
+			LoginMethods: []string{"tacacs+", "local"},
+			TACACSServers: map[string]*SpecAAAServer{
+				"10.0.0.10": {Port: pointer.To(uint16(49)), Secret: pointer.To("tacacs-secret")},
+			},
+			RADIUSServers: map[string]*SpecAAAServer{
+				"10.0.0.20": {Port: pointer.To(uint16(1812)), Secret: pointer.To("radius-secret")},
+			},
+		},
+	}
+	aaa := spec.AAA
+
+	spec.CleanupSensetive()
+
+	require.Equal(t, &SpecAAA{
+		LoginMethods: []string{"tacacs+", "local"},
+		TACACSServers: map[string]*SpecAAAServer{
+			"10.0.0.10": {Port: pointer.To(uint16(49))},
+		},
+		RADIUSServers: map[string]*SpecAAAServer{
+			"10.0.0.20": {Port: pointer.To(uint16(1812))},
+		},
+	}, spec.AAA)
+	require.Equal(t, "tacacs-secret", *aaa.TACACSServers["10.0.0.10"].Secret, "original AAA must not be modified")
+}
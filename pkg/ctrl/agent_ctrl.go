@@ -16,6 +16,7 @@ package ctrl
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"encoding/base64"
 	"fmt"
@@ -37,14 +38,20 @@ import (
 	"go.githedgehog.com/libmeta/pkg/alloy"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	kapierrors "k8s.io/apimachinery/pkg/api/errors"
 	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	ktypes "k8s.io/apimachinery/pkg/types"
 	kctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	kctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -102,19 +109,203 @@ func SetupAgentReconsilerWith(mgr kctrl.Manager, cfg *fmeta.FabricConfig, libMng
 	}
 
 	// TODO only enqueue switches when related VPC/VPCAttach/VPCPeering changes
-	return errors.Wrapf(kctrl.NewControllerManagedBy(mgr).
+	if err := kctrl.NewControllerManagedBy(mgr).
 		Named("Agent").
-		For(&wiringapi.Switch{}).
+		// switch status is mirrored from the agent heartbeats and doesn't affect agent config, labels and annotations do
+		For(&wiringapi.Switch{}, builder.WithPredicates(predicate.Or(
+			predicate.GenerationChangedPredicate{},
+			predicate.LabelChangedPredicate{},
+			predicate.AnnotationChangedPredicate{},
+		))).
 		Watches(&wiringapi.Connection{}, handler.EnqueueRequestsFromMapFunc(r.enqueueBySwitchListLabelsAndSpines)).
 		Watches(&wiringapi.SwitchProfile{}, handler.EnqueueRequestsFromMapFunc(r.enqueueBySwitchProfileLabel)).
-		Watches(&vpcapi.VPC{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAllSwitches)).
+		Watches(&wiringapi.SwitchGroup{}, handler.EnqueueRequestsFromMapFunc(r.enqueueBySwitchGroupLabel)).
+		Watches(&wiringapi.MirrorSession{}, handler.EnqueueRequestsFromMapFunc(r.enqueueByMirrorSessionSwitch)).
+		// VPC status is updated by the VPC controller and doesn't affect agent config
+		Watches(&vpcapi.VPC{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAllSwitches), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Watches(&vpcapi.VPCAttachment{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAllSwitches)).
 		Watches(&vpcapi.VPCPeering{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAllSwitches)).
+		Watches(&vpcapi.SecurityPolicy{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAllSwitches)).
 		Watches(&vpcapi.External{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAllSwitches)).
 		Watches(&vpcapi.ExternalAttachment{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAllSwitches)).
 		Watches(&vpcapi.ExternalPeering{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAllSwitches)).
 		Watches(&vpcapi.IPv4Namespace{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAllSwitches)).
-		Complete(r), "failed to setup agent controller")
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.enqueueBySecret)).
+		Complete(r); err != nil {
+		return errors.Wrapf(err, "failed to setup agent controller")
+	}
+
+	// Switch status is mirrored from the agent status which is updated on each heartbeat, so it's handled separately
+	// to avoid re-generating the whole agent spec each time
+	return errors.Wrapf(kctrl.NewControllerManagedBy(mgr).
+		Named("SwitchStatus").
+		For(&agentapi.Agent{}, builder.WithPredicates(agentSwitchStatusChangedPredicate)).
+		Complete(reconcile.Func(r.reconcileSwitchStatus)), "failed to setup switch status controller")
+}
+
+// agentSwitchStatusChangedPredicate only passes agent updates that change the status mirrored into the switch
+var agentSwitchStatusChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldAgent, okOld := e.ObjectOld.(*agentapi.Agent)
+		newAgent, okNew := e.ObjectNew.(*agentapi.Agent)
+		if !okOld || !okNew {
+			return true
+		}
+
+		return !equality.Semantic.DeepEqual(switchStatusFromAgent(oldAgent), switchStatusFromAgent(newAgent))
+	},
+}
+
+// reconcileSwitchStatus mirrors the status of the agent into the switch with the same name
+func (r *AgentReconciler) reconcileSwitchStatus(ctx context.Context, req kctrl.Request) (kctrl.Result, error) {
+	sw := &wiringapi.Switch{}
+	if err := r.Get(ctx, req.NamespacedName, sw); err != nil {
+		if kapierrors.IsNotFound(err) {
+			return kctrl.Result{}, nil
+		}
+
+		return kctrl.Result{}, errors.Wrapf(err, "error getting switch")
+	}
+
+	status := wiringapi.SwitchStatus{}
+	agent := &agentapi.Agent{}
+	if err := r.Get(ctx, req.NamespacedName, agent); err != nil {
+		if !kapierrors.IsNotFound(err) {
+			return kctrl.Result{}, errors.Wrapf(err, "error getting agent")
+		}
+	} else {
+		status = switchStatusFromAgent(agent)
+	}
+
+	if equality.Semantic.DeepEqual(sw.Status, status) {
+		return kctrl.Result{}, nil
+	}
+
+	sw.Status = status
+	if err := r.Status().Update(ctx, sw); err != nil {
+		return kctrl.Result{}, errors.Wrapf(err, "error updating switch status")
+	}
+
+	return kctrl.Result{}, nil
+}
+
+// switchStatusFromAgent builds the switch status from the agent generation and status reported by the agent
+func switchStatusFromAgent(agent *agentapi.Agent) wiringapi.SwitchStatus {
+	status := wiringapi.SwitchStatus{
+		LastHeartbeat:   agent.Status.LastHeartbeat,
+		LastAppliedTime: agent.Status.LastAppliedTime,
+		AppliedGen:      agent.Status.LastAppliedGen,
+		DesiredGen:      agent.Generation,
+		NOSVersion:      agent.Status.State.NOS.SoftwareVersion,
+		RebootRequired:  agent.Status.RebootRequired,
+	}
+
+	for name, iface := range agent.Status.State.Interfaces {
+		// only data ports are counted, management port and port channels are skipped
+		if !strings.HasPrefix(name, wiringapi.DataPortPrefix) {
+			continue
+		}
+
+		switch {
+		case iface.ErrDisabled:
+			status.Ports.ErrDisabled++
+		case iface.OperStatus == agentapi.OperStatusUp:
+			status.Ports.Up++
+		case iface.AdminStatus == agentapi.AdminStatusUp:
+			status.Ports.Down++
+		}
+	}
+
+	return status
+}
+
+// enqueueBySecret enqueues all switches only if the secret is used by any of the external attachments, AAA or SNMP
+func (r *AgentReconciler) enqueueBySecret(ctx context.Context, obj kclient.Object) []reconcile.Request {
+	if r.cfg.AAA.IsEnabled() && r.cfg.AAA.SecretName == obj.GetName() {
+		return r.enqueueAllSwitches(ctx, obj)
+	}
+	for _, user := range r.cfg.SNMP.Users {
+		if user.SecretName == obj.GetName() {
+			return r.enqueueAllSwitches(ctx, obj)
+		}
+	}
+
+	attaches := &vpcapi.ExternalAttachmentList{}
+	if err := r.List(ctx, attaches, kclient.InNamespace(obj.GetNamespace())); err != nil {
+		kctrllog.FromContext(ctx).Error(err, "error listing external attachments to reconcile by secret")
+
+		return []reconcile.Request{}
+	}
+
+	for _, attach := range attaches.Items {
+		if attach.Spec.Neighbor.PasswordSecret == obj.GetName() {
+			return r.enqueueAllSwitches(ctx, obj)
+		}
+	}
+
+	return []reconcile.Request{}
+}
+
+func (r *AgentReconciler) getExternalAttachmentPassword(ctx context.Context, ns, secretName string) (string, error) {
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, ktypes.NamespacedName{Namespace: ns, Name: secretName}, secret); err != nil {
+		return "", errors.Wrapf(err, "error getting secret %s", secretName)
+	}
+
+	password, exists := secret.Data[vpcapi.ExternalAttachmentPasswordSecretKey]
+	if !exists || len(password) == 0 {
+		return "", errors.Errorf("secret %s has no %s key", secretName, vpcapi.ExternalAttachmentPasswordSecretKey)
+	}
+
+	return string(password), nil
+}
+
+func (r *AgentReconciler) getAAASecret(ctx context.Context, ns, secretName string) (string, error) {
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, ktypes.NamespacedName{Namespace: ns, Name: secretName}, secret); err != nil {
+		return "", errors.Wrapf(err, "error getting secret %s", secretName)
+	}
+
+	value, exists := secret.Data[fmeta.AAASecretKey]
+	if !exists || len(value) == 0 {
+		return "", errors.Errorf("secret %s has no %s key", secretName, fmeta.AAASecretKey)
+	}
+	if err := fmeta.ValidateAAASecret(string(value)); err != nil {
+		return "", errors.Wrapf(err, "invalid secret %s", secretName)
+	}
+
+	return string(value), nil
+}
+
+func (r *AgentReconciler) getSNMPUserKeys(ctx context.Context, ns, secretName string) (agentapi.SNMPUserKeys, error) {
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, ktypes.NamespacedName{Namespace: ns, Name: secretName}, secret); err != nil {
+		return agentapi.SNMPUserKeys{}, errors.Wrapf(err, "error getting secret %s", secretName)
+	}
+
+	auth, err := getSNMPKey(secret, fmeta.SNMPAuthKeySecretKey)
+	if err != nil {
+		return agentapi.SNMPUserKeys{}, err
+	}
+
+	priv, err := getSNMPKey(secret, fmeta.SNMPPrivKeySecretKey)
+	if err != nil {
+		return agentapi.SNMPUserKeys{}, err
+	}
+
+	return agentapi.SNMPUserKeys{Auth: auth, Priv: priv}, nil
+}
+
+func getSNMPKey(secret *corev1.Secret, key string) (string, error) {
+	value, exists := secret.Data[key]
+	if !exists || len(value) == 0 {
+		return "", errors.Errorf("secret %s has no %s key", secret.Name, key)
+	}
+	if err := fmeta.ValidateSNMPKey(string(value)); err != nil {
+		return "", errors.Wrapf(err, "invalid %s in secret %s", key, secret.Name)
+	}
+
+	return string(value), nil
 }
 
 func (r *AgentReconciler) enqueueBySwitchListLabelsAndSpines(ctx context.Context, obj kclient.Object) []reconcile.Request {
@@ -202,6 +393,41 @@ func (r *AgentReconciler) enqueueBySwitchProfileLabel(ctx context.Context, obj k
 	return res
 }
 
+func (r *AgentReconciler) enqueueByMirrorSessionSwitch(_ context.Context, obj kclient.Object) []reconcile.Request {
+	session, ok := obj.(*wiringapi.MirrorSession)
+	if !ok || session.Spec.Switch == "" {
+		return []reconcile.Request{}
+	}
+
+	return []reconcile.Request{{NamespacedName: ktypes.NamespacedName{
+		Namespace: session.Namespace,
+		Name:      session.Spec.Switch,
+	}}}
+}
+
+func (r *AgentReconciler) enqueueBySwitchGroupLabel(ctx context.Context, obj kclient.Object) []reconcile.Request {
+	res := []reconcile.Request{}
+
+	sws := &wiringapi.SwitchList{}
+	err := r.List(ctx, sws, kclient.InNamespace(obj.GetNamespace()), kclient.MatchingLabels{
+		wiringapi.ListLabelSwitchGroup(obj.GetName()): wiringapi.ListLabelValue,
+	})
+	if err != nil {
+		kctrllog.FromContext(ctx).Error(err, "error listing switches to reconcile by group")
+
+		return res
+	}
+
+	for _, sw := range sws.Items {
+		res = append(res, reconcile.Request{NamespacedName: ktypes.NamespacedName{
+			Namespace: sw.Namespace,
+			Name:      sw.Name,
+		}})
+	}
+
+	return res
+}
+
 func (r *AgentReconciler) enqueueAllSwitches(ctx context.Context, obj kclient.Object) []reconcile.Request {
 	res := []reconcile.Request{}
 
@@ -245,6 +471,9 @@ func (r *AgentReconciler) enqueueAllSwitches(ctx context.Context, obj kclient.Ob
 //+kubebuilder:rbac:groups=wiring.githedgehog.com,resources=vlannamespaces,verbs=get;list;watch
 //+kubebuilder:rbac:groups=wiring.githedgehog.com,resources=vlannamespaces/status,verbs=get;update;patch
 
+//+kubebuilder:rbac:groups=wiring.githedgehog.com,resources=mirrorsessions,verbs=get;list;watch
+//+kubebuilder:rbac:groups=wiring.githedgehog.com,resources=mirrorsessions/status,verbs=get;update;patch
+
 //+kubebuilder:rbac:groups=vpc.githedgehog.com,resources=vpcs,verbs=get;list;watch
 //+kubebuilder:rbac:groups=vpc.githedgehog.com,resources=vpcs/status,verbs=get;update;patch
 
@@ -254,9 +483,15 @@ func (r *AgentReconciler) enqueueAllSwitches(ctx context.Context, obj kclient.Ob
 //+kubebuilder:rbac:groups=vpc.githedgehog.com,resources=vpcpeerings,verbs=get;list;watch
 //+kubebuilder:rbac:groups=vpc.githedgehog.com,resources=vpcpeerings/status,verbs=get;update;patch
 
+//+kubebuilder:rbac:groups=vpc.githedgehog.com,resources=securitypolicies,verbs=get;list;watch
+//+kubebuilder:rbac:groups=vpc.githedgehog.com,resources=securitypolicies/status,verbs=get;update;patch
+
 //+kubebuilder:rbac:groups=vpc.githedgehog.com,resources=ipv4namespaces,verbs=get;list;watch
 //+kubebuilder:rbac:groups=vpc.githedgehog.com,resources=ipv4namespaces/status,verbs=get;update;patch
 
+//+kubebuilder:rbac:groups=vpc.githedgehog.com,resources=ipv6namespaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups=vpc.githedgehog.com,resources=ipv6namespaces/status,verbs=get;update;patch
+
 //+kubebuilder:rbac:groups=vpc.githedgehog.com,resources=externals,verbs=get;list;watch
 //+kubebuilder:rbac:groups=vpc.githedgehog.com,resources=externals/status,verbs=get;update;patch
 
@@ -286,8 +521,7 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 		return kctrl.Result{}, errors.Wrapf(err, "error getting switch")
 	}
 
-	// TODO impl
-	statusUpdates := appendUpdate(nil, sw)
+	statusUpdates := appendUpdate(nil, r.Scheme(), sw)
 
 	switchNsName := kmetav1.ObjectMeta{Name: sw.Name, Namespace: sw.Namespace}
 	res, err := r.prepareAgentInfra(ctx, switchNsName)
@@ -382,6 +616,7 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 
 			attachedVPCs[attach.Spec.VPCName()] = true
 			configuredSubnets[attach.Spec.Subnet] = true
+			statusUpdates = appendUpdate(statusUpdates, r.Scheme(), &attach)
 		}
 	}
 
@@ -447,12 +682,13 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 			peerings[peer.Name] = peer.Spec
 			peeredVPCs[vpc1] = true
 			peeredVPCs[vpc2] = true
+			statusUpdates = appendUpdate(statusUpdates, r.Scheme(), &peer)
 		}
 	}
 
 	attachedExternals := map[string]bool{}
 	proxyStaticExtAttachments := map[string]bool{}
-	externalAttaches := map[string]vpcapi.ExternalAttachmentSpec{}
+	externalAttaches := map[string]agentapi.ExternalAttachmentSpecCreds{}
 	externalAttachList := &vpcapi.ExternalAttachmentList{}
 	err = r.List(ctx, externalAttachList, kclient.InNamespace(sw.Namespace))
 	if err != nil {
@@ -463,8 +699,21 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 			continue
 		}
 
+		attachCreds := agentapi.ExternalAttachmentSpecCreds{
+			ExternalAttachmentSpec: attach.Spec,
+		}
+		if attach.Spec.Neighbor.PasswordSecret != "" {
+			password, err := r.getExternalAttachmentPassword(ctx, attach.Namespace, attach.Spec.Neighbor.PasswordSecret)
+			if err != nil {
+				// the neighbor can't be configured without its password, don't block the rest of the switch config
+				l.Error(err, "Skipping external attachment as its neighbor password is unavailable", "attachment", attach.Name)
+
+				continue
+			}
+			attachCreds.NeighborPassword = password
+		}
 		attachedExternals[attach.Spec.External] = true
-		externalAttaches[attach.Name] = attach.Spec
+		externalAttaches[attach.Name] = attachCreds
 
 		if attach.Spec.Static != nil && attach.Spec.Static.Proxy {
 			proxyStaticExtAttachments[attach.Name] = true
@@ -502,6 +751,7 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 		peeredVPCs[peering.Spec.Permit.VPC.Name] = true
 
 		externalPeerings[peering.Name] = peering.Spec
+		statusUpdates = appendUpdate(statusUpdates, r.Scheme(), &peering)
 	}
 
 	for _, vpc := range vpcList.Items {
@@ -510,6 +760,52 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 		}
 	}
 
+	securityPolicies := map[string]vpcapi.SecurityPolicySpec{}
+	securityPolicyList := &vpcapi.SecurityPolicyList{}
+	err = r.List(ctx, securityPolicyList, kclient.InNamespace(sw.Namespace))
+	if err != nil {
+		return kctrl.Result{}, errors.Wrapf(err, "error listing security policies")
+	}
+	for _, policy := range securityPolicyList.Items {
+		if _, exists := vpcs[policy.Spec.VPC]; !exists {
+			continue
+		}
+
+		securityPolicies[policy.Name] = policy.Spec
+	}
+
+	// expired mirror sessions are skipped and the switch is re-reconciled when the next one expires
+	now := time.Now()
+	requeueAfter := time.Duration(0)
+	mirrorSessions := map[string]wiringapi.MirrorSessionSpec{}
+	mirrorSessionList := &wiringapi.MirrorSessionList{}
+	err = r.List(ctx, mirrorSessionList, kclient.InNamespace(sw.Namespace), kclient.MatchingLabels{
+		wiringapi.LabelSwitch: sw.Name,
+	})
+	if err != nil {
+		return kctrl.Result{}, errors.Wrapf(err, "error listing mirror sessions")
+	}
+	for _, session := range mirrorSessionList.Items {
+		if session.Spec.Switch != sw.Name || session.Spec.IsExpired(now) {
+			continue
+		}
+		if session.Spec.ERSPAN != nil && session.Spec.ERSPAN.VPC != "" {
+			if _, exists := vpcs[session.Spec.ERSPAN.VPC]; !exists {
+				l.Info("Skipping mirror session as its VPC isn't present on the switch", "session", session.Name, "vpc", session.Spec.ERSPAN.VPC)
+
+				continue
+			}
+		}
+
+		mirrorSessions[session.Name] = session.Spec
+
+		if session.Spec.ExpiresAt != nil {
+			if left := session.Spec.ExpiresAt.Sub(now); requeueAfter == 0 || left < requeueAfter {
+				requeueAfter = left
+			}
+		}
+	}
+
 	for name, vpc := range vpcs {
 		if !slices.Contains(sw.Spec.VLANNamespaces, vpc.VLANNamespace) {
 			return kctrl.Result{}, errors.Errorf("switch %s doesn't have vlan namespace %s while gets vpc %s", sw.Name, vpc.VLANNamespace, name)
@@ -549,7 +845,7 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 
 	portChanConns := map[string]bool{}
 	for name, conn := range conns {
-		if conn.Bundled == nil && conn.ESLAG == nil {
+		if conn.Bundled == nil && conn.ESLAG == nil && (conn.External == nil || !conn.External.IsBundled()) {
 			continue
 		}
 
@@ -577,7 +873,7 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 		}
 
 		for _, conn := range connList.Items {
-			if conn.Spec.Bundled == nil && conn.Spec.ESLAG == nil {
+			if conn.Spec.Bundled == nil && conn.Spec.ESLAG == nil && (conn.Spec.External == nil || !conn.Spec.External.IsBundled()) {
 				continue
 			}
 
@@ -676,6 +972,25 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 		}
 	}
 
+	groups := []wiringapi.SwitchGroup{}
+	for _, group := range sw.Spec.Groups {
+		sg := &wiringapi.SwitchGroup{}
+		if err := r.Get(ctx, ktypes.NamespacedName{Namespace: sw.Namespace, Name: group}, sg); err != nil {
+			if kapierrors.IsNotFound(err) {
+				l.Info("Switch group not found, skipping its defaults", "group", group)
+
+				continue
+			}
+
+			return kctrl.Result{}, errors.Wrapf(err, "error getting switch group %s", group)
+		}
+
+		groups = append(groups, *sg)
+	}
+
+	swSpec := sw.Spec.DeepCopy()
+	swSpec.ApplyGroupDefaults(groups)
+
 	th5WorkaroundReqs := map[string]bool{}
 	var spSpec *wiringapi.SwitchProfileSpec
 
@@ -774,6 +1089,39 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 		}
 	}
 
+	var aaa *agentapi.AAAConfigCreds
+	if r.cfg.AAA.IsEnabled() {
+		secret, err := r.getAAASecret(ctx, sw.Namespace, r.cfg.AAA.SecretName)
+		if err != nil {
+			// switch falls back to the local authentication only
+			l.Error(err, "Skipping AAA config as its secret is unavailable", "secret", r.cfg.AAA.SecretName)
+		} else {
+			aaa = &agentapi.AAAConfigCreds{
+				AAAConfig: *r.cfg.AAA.DeepCopy(),
+				Secret:    secret,
+			}
+		}
+	}
+
+	var snmp *agentapi.SNMPConfigCreds
+	if r.cfg.SNMP.IsEnabled() {
+		snmp = &agentapi.SNMPConfigCreds{
+			SNMPConfig: *r.cfg.SNMP.DeepCopy(),
+			UserKeys:   map[string]agentapi.SNMPUserKeys{},
+		}
+		snmp.Users = nil
+		for _, user := range r.cfg.SNMP.Users {
+			keys, err := r.getSNMPUserKeys(ctx, sw.Namespace, user.SecretName)
+			if err != nil {
+				l.Error(err, "Skipping SNMP user as its keys are unavailable", "user", user.Name, "secret", user.SecretName)
+
+				continue
+			}
+			snmp.Users = append(snmp.Users, user)
+			snmp.UserKeys[user.Name] = keys
+		}
+	}
+
 	swAnns := map[string]string{}
 	for k, v := range sw.Annotations {
 		if !strings.Contains(k, "githedgehog.com/") {
@@ -790,7 +1138,7 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 		agent.Spec.Role = sw.Spec.Role
 		agent.Spec.Description = sw.Spec.Description
 
-		agent.Spec.Switch = sw.Spec
+		agent.Spec.Switch = *swSpec
 		agent.Spec.SwitchProfile = spSpec
 		agent.Spec.Switches = switches
 		agent.Spec.RedundancyGroupPeers = rgPeers
@@ -798,6 +1146,8 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 		agent.Spec.VPCs = vpcs
 		agent.Spec.VPCAttachments = attaches
 		agent.Spec.VPCPeerings = peerings
+		agent.Spec.SecurityPolicies = securityPolicies
+		agent.Spec.MirrorSessions = mirrorSessions
 		agent.Spec.IPv4Namespaces = ipv4Namespaces
 		agent.Spec.VLANNamespaces = vlanNamespaces
 		agent.Spec.Externals = externals
@@ -819,7 +1169,7 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 
 		agent.Spec.Catalog = *cat
 
-		agent.Spec.StatusUpdates = statusUpdates
+		agent.Spec.StatusUpdates = sortUpdates(statusUpdates)
 
 		agent.Spec.Config = agentapi.AgentSpecConfig{
 			DeploymentID:          r.cfg.DeploymentID,
@@ -842,6 +1192,11 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 			GatewayBFD:            r.cfg.GatewayBFD,
 			Alloy:                 alloyCfg,
 			GatewayCommunities:    map[string]string{},
+			SFlow:                 r.cfg.Observability.SFlow,
+			SyslogServers:         r.cfg.Observability.SyslogServers,
+			AAA:                   aaa,
+			SNMP:                  snmp,
+			System:                r.cfg.System,
 		}
 		if r.cfg.FabricMode == fmeta.FabricModeSpineLeaf {
 			agent.Spec.Config.SpineLeaf = &agentapi.AgentSpecConfigSpineLeaf{}
@@ -858,7 +1213,7 @@ func (r *AgentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kct
 
 	l.Info("agent reconciled")
 
-	return kctrl.Result{}, nil
+	return kctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *AgentReconciler) prepareAgentInfra(ctx context.Context, ag kmetav1.ObjectMeta) (*kctrl.Result, error) {
@@ -1007,12 +1362,34 @@ func (r *AgentReconciler) genKubeconfig(secret *corev1.Secret) (string, error) {
 	return buf.String(), nil
 }
 
-func appendUpdate(statusUpdates []agentapi.ApplyStatusUpdate, obj kclient.Object) []agentapi.ApplyStatusUpdate {
+// appendUpdate adds the object to the list of objects to report back as applied by the agent, objects returned by the
+// client usually have empty TypeMeta, so GVK is looked up in the scheme
+func appendUpdate(statusUpdates []agentapi.ApplyStatusUpdate, scheme *runtime.Scheme, obj kclient.Object) []agentapi.ApplyStatusUpdate {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() {
+		if schemeGVK, err := apiutil.GVKForObject(obj, scheme); err == nil {
+			gvk = schemeGVK
+		}
+	}
+
 	return append(statusUpdates, agentapi.ApplyStatusUpdate{
-		APIVersion: obj.GetObjectKind().GroupVersionKind().GroupVersion().String(),
-		Kind:       obj.GetObjectKind().GroupVersionKind().Kind,
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
 		Name:       obj.GetName(),
 		Namespace:  obj.GetNamespace(),
 		Generation: obj.GetGeneration(),
 	})
 }
+
+// sortUpdates makes the list of status updates stable to avoid agent generation changes on each reconcile
+func sortUpdates(statusUpdates []agentapi.ApplyStatusUpdate) []agentapi.ApplyStatusUpdate {
+	slices.SortFunc(statusUpdates, func(a, b agentapi.ApplyStatusUpdate) int {
+		return cmp.Or(
+			strings.Compare(a.Kind, b.Kind),
+			strings.Compare(a.Namespace, b.Namespace),
+			strings.Compare(a.Name, b.Name),
+		)
+	})
+
+	return statusUpdates
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package ctrl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSwitchStatusFromAgent(t *testing.T) {
+	heartbeat := kmetav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	applied := kmetav1.NewTime(time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC))
+
+	for _, tt := range []struct {
+		name     string
+		agent    *agentapi.Agent
+		expected wiringapi.SwitchStatus
+	}{
+		{
+			name:  "empty",
+			agent: &agentapi.Agent{},
+		},
+		{
+			name: "applied",
+			agent: &agentapi.Agent{
+				ObjectMeta: kmetav1.ObjectMeta{Generation: 5},
+				Status: agentapi.AgentStatus{
+					LastHeartbeat:   heartbeat,
+					LastAppliedTime: applied,
+					LastAppliedGen:  4,
+					RebootRequired:  true,
+					State: agentapi.SwitchState{
+						NOS: agentapi.SwitchStateNOS{
+							SoftwareVersion: "4.5.0-Enterprise_Base",
+						},
+					},
+				},
+			},
+			expected: wiringapi.SwitchStatus{
+				LastHeartbeat:   heartbeat,
+				LastAppliedTime: applied,
+				AppliedGen:      4,
+				DesiredGen:      5,
+				NOSVersion:      "4.5.0-Enterprise_Base",
+				RebootRequired:  true,
+			},
+		},
+		{
+			name: "ports",
+			agent: &agentapi.Agent{
+				Status: agentapi.AgentStatus{
+					State: agentapi.SwitchState{
+						Interfaces: map[string]agentapi.SwitchStateInterface{
+							"M1":           {AdminStatus: agentapi.AdminStatusUp, OperStatus: agentapi.OperStatusDown},
+							"E1/1":         {AdminStatus: agentapi.AdminStatusUp, OperStatus: agentapi.OperStatusUp},
+							"E1/2":         {AdminStatus: agentapi.AdminStatusUp, OperStatus: agentapi.OperStatusUp},
+							"E1/3":         {AdminStatus: agentapi.AdminStatusUp, OperStatus: agentapi.OperStatusDown},
+							"E1/4":         {AdminStatus: agentapi.AdminStatusDown, OperStatus: agentapi.OperStatusDown},
+							"E1/5":         {AdminStatus: agentapi.AdminStatusUp, OperStatus: agentapi.OperStatusDown, ErrDisabled: true},
+							"E1/55/1":      {AdminStatus: agentapi.AdminStatusUp, OperStatus: agentapi.OperStatusLowerLayerDown},
+							"PortChannel1": {AdminStatus: agentapi.AdminStatusUp, OperStatus: agentapi.OperStatusDown},
+						},
+					},
+				},
+			},
+			expected: wiringapi.SwitchStatus{
+				Ports: wiringapi.SwitchStatusPorts{
+					Up:          2,
+					Down:        2,
+					ErrDisabled: 1,
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, switchStatusFromAgent(tt.agent))
+		})
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	vpcapi "go.githedgehog.com/fabric/api/vpc/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// +kubebuilder:rbac:groups=vpc.githedgehog.com,resources=vpcattachments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vpc.githedgehog.com,resources=vpcattachments/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vpc.githedgehog.com,resources=vpcpeerings,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vpc.githedgehog.com,resources=vpcpeerings/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=vpc.githedgehog.com,resources=externalpeerings,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vpc.githedgehog.com,resources=externalpeerings/status,verbs=get;update;patch
+
+// +kubebuilder:rbac:groups=agent.githedgehog.com,resources=agents,verbs=get;list;watch
+
+// ApplyStatusReconciler folds the per-agent apply reports (StatusUpdates) back into the status of the objects of a
+// single kind, so it's possible to wait for the object to be applied on all switches it's configured on
+type ApplyStatusReconciler struct {
+	kclient.Client
+	kind   string
+	newObj func() kclient.Object
+}
+
+func SetupApplyStatusReconcilerWith(mgr kctrl.Manager) error {
+	for kind, newObj := range map[string]func() kclient.Object{
+		vpcapi.KindVPCAttachment:   func() kclient.Object { return &vpcapi.VPCAttachment{} },
+		vpcapi.KindVPCPeering:      func() kclient.Object { return &vpcapi.VPCPeering{} },
+		vpcapi.KindExternalPeering: func() kclient.Object { return &vpcapi.ExternalPeering{} },
+	} {
+		r := &ApplyStatusReconciler{
+			Client: mgr.GetClient(),
+			kind:   kind,
+			newObj: newObj,
+		}
+
+		if err := kctrl.NewControllerManagedBy(mgr).
+			Named(kind+"ApplyStatus").
+			For(newObj(), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+			Watches(&agentapi.Agent{}, enqueueOldAndNew(r.enqueueByStatusUpdates), builder.WithPredicates(statusUpdatesChangedPredicate)).
+			Complete(r); err != nil {
+			return fmt.Errorf("setting up %s apply status controller: %w", kind, err)
+		}
+	}
+
+	return nil
+}
+
+// statusUpdatesChangedPredicate filters out agent heartbeats and only passes changes of the objects to apply or applied
+var statusUpdatesChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldAgent, okOld := e.ObjectOld.(*agentapi.Agent)
+		newAgent, okNew := e.ObjectNew.(*agentapi.Agent)
+		if !okOld || !okNew {
+			return true
+		}
+
+		return !slices.Equal(oldAgent.Spec.StatusUpdates, newAgent.Spec.StatusUpdates) ||
+			!slices.Equal(oldAgent.Status.StatusUpdates, newAgent.Status.StatusUpdates)
+	},
+}
+
+func (r *ApplyStatusReconciler) enqueueByStatusUpdates(obj kclient.Object) []reconcile.Request {
+	res := []reconcile.Request{}
+
+	agent, ok := obj.(*agentapi.Agent)
+	if !ok {
+		return res
+	}
+
+	for _, update := range slices.Concat(agent.Spec.StatusUpdates, agent.Status.StatusUpdates) {
+		if update.Kind != r.kind {
+			continue
+		}
+
+		res = append(res, reconcile.Request{
+			NamespacedName: kclient.ObjectKey{Name: update.Name, Namespace: update.Namespace},
+		})
+	}
+
+	return res
+}
+
+func (r *ApplyStatusReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kctrl.Result, error) {
+	obj := r.newObj()
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if kapierrors.IsNotFound(err) {
+			return kctrl.Result{}, nil
+		}
+
+		return kctrl.Result{}, fmt.Errorf("getting %s: %w", r.kind, err)
+	}
+
+	if obj.GetDeletionTimestamp() != nil {
+		return kctrl.Result{}, nil
+	}
+
+	switches, conditions, ok := applyStatusFields(obj)
+	if !ok {
+		return kctrl.Result{}, fmt.Errorf("unsupported kind %s", r.kind) //nolint:goerr113
+	}
+
+	agents := &agentapi.AgentList{}
+	if err := r.List(ctx, agents, kclient.InNamespace(req.Namespace)); err != nil {
+		return kctrl.Result{}, fmt.Errorf("listing agents: %w", err)
+	}
+
+	newSwitches := []vpcapi.SwitchApplyStatus{}
+	for _, agent := range agents.Items {
+		if !slices.ContainsFunc(agent.Spec.StatusUpdates, r.matches(req.NamespacedName)) {
+			continue
+		}
+
+		applied := int64(0)
+		if idx := slices.IndexFunc(agent.Status.StatusUpdates, r.matches(req.NamespacedName)); idx >= 0 {
+			applied = agent.Status.StatusUpdates[idx].Generation
+		}
+
+		newSwitches = append(newSwitches, vpcapi.SwitchApplyStatus{
+			Switch:     agent.Name,
+			Generation: applied,
+		})
+	}
+	slices.SortFunc(newSwitches, func(a, b vpcapi.SwitchApplyStatus) int {
+		return strings.Compare(a.Switch, b.Switch)
+	})
+
+	newConditions := slices.Clone(*conditions)
+	setAppliedCondition(&newConditions, obj.GetGeneration(), newSwitches)
+
+	if equality.Semantic.DeepEqual(*switches, newSwitches) && equality.Semantic.DeepEqual(*conditions, newConditions) {
+		return kctrl.Result{}, nil
+	}
+
+	*switches = newSwitches
+	*conditions = newConditions
+	if err := r.Status().Update(ctx, obj); err != nil {
+		return kctrl.Result{}, fmt.Errorf("updating %s status: %w", r.kind, err)
+	}
+
+	return kctrl.Result{}, nil
+}
+
+func (r *ApplyStatusReconciler) matches(key kclient.ObjectKey) func(agentapi.ApplyStatusUpdate) bool {
+	return func(update agentapi.ApplyStatusUpdate) bool {
+		return update.Kind == r.kind && update.Name == key.Name && update.Namespace == key.Namespace
+	}
+}
+
+func applyStatusFields(obj kclient.Object) (*[]vpcapi.SwitchApplyStatus, *[]kmetav1.Condition, bool) {
+	switch obj := obj.(type) {
+	case *vpcapi.VPCAttachment:
+		return &obj.Status.Switches, &obj.Status.Conditions, true
+	case *vpcapi.VPCPeering:
+		return &obj.Status.Switches, &obj.Status.Conditions, true
+	case *vpcapi.ExternalPeering:
+		return &obj.Status.Switches, &obj.Status.Conditions, true
+	}
+
+	return nil, nil, false
+}
+
+func setAppliedCondition(conditions *[]kmetav1.Condition, generation int64, switches []vpcapi.SwitchApplyStatus) {
+	applied := kmetav1.Condition{
+		Type:               vpcapi.ConditionApplied,
+		Status:             kmetav1.ConditionTrue,
+		Reason:             "Applied",
+		ObservedGeneration: generation,
+	}
+
+	names := []string{}
+	pending := []string{}
+	for _, sw := range switches {
+		names = append(names, sw.Switch)
+		if sw.Generation != generation {
+			pending = append(pending, sw.Switch)
+		}
+	}
+
+	switch {
+	case len(switches) == 0:
+		applied.Status = kmetav1.ConditionFalse
+		applied.Reason = "NoSwitches"
+		applied.Message = "Not configured on any switch"
+	case len(pending) > 0:
+		applied.Status = kmetav1.ConditionFalse
+		applied.Reason = "ApplyPending"
+		applied.Message = fmt.Sprintf("Generation %d not yet applied on switches: %s", generation, strings.Join(pending, ", "))
+	default:
+		applied.Message = fmt.Sprintf("Generation %d applied on switches: %s", generation, strings.Join(names, ", "))
+	}
+
+	kmeta.SetStatusCondition(conditions, applied)
+}
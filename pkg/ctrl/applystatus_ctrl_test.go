@@ -0,0 +1,128 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package ctrl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	vpcapi "go.githedgehog.com/fabric/api/vpc/v1beta1"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestSetAppliedCondition(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		gen      int64
+		switches []vpcapi.SwitchApplyStatus
+		status   kmetav1.ConditionStatus
+		reason   string
+	}{
+		{
+			name:   "no-switches",
+			gen:    1,
+			status: kmetav1.ConditionFalse,
+			reason: "NoSwitches",
+		},
+		{
+			name:     "all-applied",
+			gen:      2,
+			switches: []vpcapi.SwitchApplyStatus{{Switch: "leaf-1", Generation: 2}, {Switch: "leaf-2", Generation: 2}},
+			status:   kmetav1.ConditionTrue,
+			reason:   "Applied",
+		},
+		{
+			name:     "old-generation",
+			gen:      3,
+			switches: []vpcapi.SwitchApplyStatus{{Switch: "leaf-1", Generation: 3}, {Switch: "leaf-2", Generation: 2}},
+			status:   kmetav1.ConditionFalse,
+			reason:   "ApplyPending",
+		},
+		{
+			name:     "not-applied",
+			gen:      1,
+			switches: []vpcapi.SwitchApplyStatus{{Switch: "leaf-1"}},
+			status:   kmetav1.ConditionFalse,
+			reason:   "ApplyPending",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions := []kmetav1.Condition{}
+			setAppliedCondition(&conditions, tt.gen, tt.switches)
+
+			applied := kmeta.FindStatusCondition(conditions, vpcapi.ConditionApplied)
+			require.NotNil(t, applied)
+			require.Equal(t, tt.status, applied.Status)
+			require.Equal(t, tt.reason, applied.Reason)
+			require.Equal(t, tt.gen, applied.ObservedGeneration)
+		})
+	}
+}
+
+func TestApplyStatusRemovedFromAgent(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, agentapi.AddToScheme(scheme))
+	require.NoError(t, vpcapi.AddToScheme(scheme))
+
+	ns := kmetav1.NamespaceDefault
+	attach := &vpcapi.VPCAttachment{
+		ObjectMeta: kmetav1.ObjectMeta{Name: "attach-1", Namespace: ns, Generation: 1},
+		Status: vpcapi.VPCAttachmentStatus{
+			Switches: []vpcapi.SwitchApplyStatus{{Switch: "leaf-1", Generation: 1}, {Switch: "leaf-2", Generation: 1}},
+		},
+	}
+	update := agentapi.ApplyStatusUpdate{Kind: vpcapi.KindVPCAttachment, Name: "attach-1", Namespace: ns, Generation: 1}
+
+	oldAgent := &agentapi.Agent{
+		ObjectMeta: kmetav1.ObjectMeta{Name: "leaf-1", Namespace: ns},
+		Spec:       agentapi.AgentSpec{StatusUpdates: []agentapi.ApplyStatusUpdate{update}},
+		Status:     agentapi.AgentStatus{StatusUpdates: []agentapi.ApplyStatusUpdate{update}},
+	}
+	newAgent := oldAgent.DeepCopy()
+	newAgent.Spec.StatusUpdates = nil
+	newAgent.Status.StatusUpdates = nil
+	otherAgent := &agentapi.Agent{
+		ObjectMeta: kmetav1.ObjectMeta{Name: "leaf-2", Namespace: ns},
+		Spec:       agentapi.AgentSpec{StatusUpdates: []agentapi.ApplyStatusUpdate{update}},
+		Status:     agentapi.AgentStatus{StatusUpdates: []agentapi.ApplyStatusUpdate{update}},
+	}
+
+	kube := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(attach, newAgent, otherAgent).
+		WithStatusSubresource(&vpcapi.VPCAttachment{}).
+		Build()
+	r := &ApplyStatusReconciler{
+		Client: kube,
+		kind:   vpcapi.KindVPCAttachment,
+		newObj: func() kclient.Object { return &vpcapi.VPCAttachment{} },
+	}
+
+	// the attachment is only referenced by the old agent object, but it should still be reconciled
+	q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer q.ShutDown()
+	enqueueOldAndNew(r.enqueueByStatusUpdates).Update(t.Context(), event.UpdateEvent{ObjectOld: oldAgent, ObjectNew: newAgent}, q)
+	require.Equal(t, 1, q.Len())
+
+	req, _ := q.Get()
+	require.Equal(t, kclient.ObjectKeyFromObject(attach), req.NamespacedName)
+
+	_, err := r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+
+	updated := &vpcapi.VPCAttachment{}
+	require.NoError(t, kube.Get(t.Context(), kclient.ObjectKeyFromObject(attach), updated))
+	require.Equal(t, []vpcapi.SwitchApplyStatus{{Switch: "leaf-2", Generation: 1}}, updated.Status.Switches)
+
+	applied := kmeta.FindStatusCondition(updated.Status.Conditions, vpcapi.ConditionApplied)
+	require.NotNil(t, applied)
+	require.Equal(t, kmetav1.ConditionTrue, applied.Status)
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	"go.githedgehog.com/fabric/pkg/util/apiutil"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	kctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	kctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// +kubebuilder:rbac:groups=wiring.githedgehog.com,resources=connections,verbs=get;list;watch
+// +kubebuilder:rbac:groups=wiring.githedgehog.com,resources=connections/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=wiring.githedgehog.com,resources=switches,verbs=get;list;watch
+// +kubebuilder:rbac:groups=wiring.githedgehog.com,resources=switchprofiles,verbs=get;list;watch
+
+// +kubebuilder:rbac:groups=agent.githedgehog.com,resources=agents,verbs=get;list;watch
+
+// CablingReconciler continuously compares the links declared in the connections with the LLDP neighbors reported by
+// the switch agents and writes the per-link verification status into the connections status. It reconciles a single
+// switch at a time and only updates the links observed from its ports, so links between switches are reported from
+// both ends by the corresponding switches.
+type CablingReconciler struct {
+	kclient.Client
+}
+
+func SetupCablingReconcilerWith(mgr kctrl.Manager) error {
+	r := &CablingReconciler{
+		Client: mgr.GetClient(),
+	}
+
+	if err := kctrl.NewControllerManagedBy(mgr).
+		Named("Cabling").
+		For(&agentapi.Agent{}, builder.WithPredicates(agentLLDPNeighborsChangedPredicate)).
+		Watches(&wiringapi.Connection{}, handler.EnqueueRequestsFromMapFunc(r.enqueueByConnection), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r); err != nil {
+		return fmt.Errorf("setting up cabling controller: %w", err)
+	}
+
+	return nil
+}
+
+// agentLLDPNeighborsChangedPredicate only passes agent updates that change the reported LLDP neighbors on any port
+var agentLLDPNeighborsChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldAgent, okOld := e.ObjectOld.(*agentapi.Agent)
+		newAgent, okNew := e.ObjectNew.(*agentapi.Agent)
+		if !okOld || !okNew {
+			return true
+		}
+
+		if len(oldAgent.Status.State.Interfaces) != len(newAgent.Status.State.Interfaces) {
+			return true
+		}
+
+		for name, iface := range newAgent.Status.State.Interfaces {
+			oldIface, exists := oldAgent.Status.State.Interfaces[name]
+			if !exists || !slices.Equal(oldIface.LLDPNeighbors, iface.LLDPNeighbors) {
+				return true
+			}
+		}
+
+		return false
+	},
+}
+
+func (r *CablingReconciler) enqueueByConnection(ctx context.Context, obj kclient.Object) []reconcile.Request {
+	res := []reconcile.Request{}
+
+	conn, ok := obj.(*wiringapi.Connection)
+	if !ok {
+		return res
+	}
+
+	switches, _, _, _, err := conn.Spec.Endpoints()
+	if err != nil {
+		kctrllog.FromContext(ctx).Error(err, "error getting connection endpoints to reconcile cabling", "connection", conn.Name)
+
+		return res
+	}
+
+	for _, switchName := range switches {
+		res = append(res, reconcile.Request{
+			NamespacedName: kclient.ObjectKey{Name: switchName, Namespace: conn.Namespace},
+		})
+	}
+
+	return res
+}
+
+func (r *CablingReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kctrl.Result, error) {
+	sw := &wiringapi.Switch{}
+	if err := r.Get(ctx, req.NamespacedName, sw); err != nil {
+		if kapierrors.IsNotFound(err) {
+			return kctrl.Result{}, nil
+		}
+
+		return kctrl.Result{}, fmt.Errorf("getting switch: %w", err)
+	}
+
+	neighbors, err := apiutil.GetLLDPNeighbors(ctx, r.Client, sw)
+	if err != nil {
+		if kapierrors.IsNotFound(err) {
+			// agent isn't created yet, so there is nothing to verify
+			return kctrl.Result{}, nil
+		}
+
+		return kctrl.Result{}, fmt.Errorf("getting lldp neighbors: %w", err)
+	}
+
+	links := map[string][]wiringapi.ConnectionLinkStatus{}
+	for port, neighbor := range neighbors {
+		if neighbor.ConnectionName == "" {
+			continue
+		}
+
+		links[neighbor.ConnectionName] = append(links[neighbor.ConnectionName], neighbor.LinkStatus(sw.Name+"/"+port))
+	}
+
+	conns := &wiringapi.ConnectionList{}
+	if err := r.List(ctx, conns, kclient.InNamespace(sw.Namespace), wiringapi.MatchingLabelsForListLabelSwitch(sw.Name)); err != nil {
+		return kctrl.Result{}, fmt.Errorf("listing connections: %w", err)
+	}
+
+	for _, conn := range conns.Items {
+		switches, _, _, _, err := conn.Spec.Endpoints()
+		if err != nil {
+			return kctrl.Result{}, fmt.Errorf("getting connection %s endpoints: %w", conn.Name, err)
+		}
+
+		newLinks := cablingLinksStatus(sw.Name, switches, conn.Status.Links, links[conn.Name])
+		if equality.Semantic.DeepEqual(conn.Status.Links, newLinks) {
+			continue
+		}
+
+		conn.Status.Links = newLinks
+		if err := r.Status().Update(ctx, &conn); err != nil {
+			return kctrl.Result{}, fmt.Errorf("updating connection %s status: %w", conn.Name, err)
+		}
+	}
+
+	return kctrl.Result{}, nil
+}
+
+// cablingLinksStatus replaces the links observed from the specified switch in the current connection links status and
+// drops the links observed from the switches that aren't part of the connection anymore
+func cablingLinksStatus(switchName string, switches []string, current, observed []wiringapi.ConnectionLinkStatus) []wiringapi.ConnectionLinkStatus {
+	res := []wiringapi.ConnectionLinkStatus{}
+	for _, link := range current {
+		linkSwitch, _, _ := strings.Cut(link.Port, "/")
+		if linkSwitch != switchName && slices.Contains(switches, linkSwitch) {
+			res = append(res, link)
+		}
+	}
+	res = append(res, observed...)
+
+	slices.SortFunc(res, func(a, b wiringapi.ConnectionLinkStatus) int {
+		return strings.Compare(a.Port, b.Port)
+	})
+
+	if len(res) == 0 {
+		return nil
+	}
+
+	return res
+}
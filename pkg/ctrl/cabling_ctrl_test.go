@@ -0,0 +1,193 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package ctrl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	"go.githedgehog.com/fabric/pkg/util/apiutil"
+)
+
+func TestCablingLinkStatus(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		neighbor apiutil.LLDPNeighborStatus
+		expected wiringapi.ConnectionLinkStatus
+	}{
+		{
+			name: "no-neighbor",
+			neighbor: apiutil.LLDPNeighborStatus{
+				Expected: apiutil.LLDPNeighbor{Name: "server-01", Port: "enp2s1"},
+			},
+			expected: wiringapi.ConnectionLinkStatus{
+				Port:     "leaf-01/E1/1",
+				State:    wiringapi.ConnectionLinkStateNoNeighbor,
+				Expected: "server-01/enp2s1",
+			},
+		},
+		{
+			name: "verified",
+			neighbor: apiutil.LLDPNeighborStatus{
+				Expected: apiutil.LLDPNeighbor{Name: "server-01", Port: "enp2s1"},
+				Actual: []apiutil.LLDPNeighbor{
+					{Name: "server-01", Port: "enp2s1"},
+				},
+			},
+			expected: wiringapi.ConnectionLinkStatus{
+				Port:     "leaf-01/E1/1",
+				State:    wiringapi.ConnectionLinkStateVerified,
+				Expected: "server-01/enp2s1",
+				Observed: "server-01/enp2s1",
+			},
+		},
+		{
+			name: "verified-second-neighbor",
+			neighbor: apiutil.LLDPNeighborStatus{
+				Expected: apiutil.LLDPNeighbor{Name: "server-01", Port: "enp2s1"},
+				Actual: []apiutil.LLDPNeighbor{
+					{Name: "bmc-01", Port: "eth0"},
+					{Name: "server-01", Port: "enp2s1"},
+				},
+			},
+			expected: wiringapi.ConnectionLinkStatus{
+				Port:     "leaf-01/E1/1",
+				State:    wiringapi.ConnectionLinkStateVerified,
+				Expected: "server-01/enp2s1",
+				Observed: "server-01/enp2s1",
+			},
+		},
+		{
+			name: "miscabled-port",
+			neighbor: apiutil.LLDPNeighborStatus{
+				Expected: apiutil.LLDPNeighbor{Name: "server-01", Port: "enp2s1"},
+				Actual: []apiutil.LLDPNeighbor{
+					{Name: "server-01", Port: "enp2s2"},
+				},
+			},
+			expected: wiringapi.ConnectionLinkStatus{
+				Port:     "leaf-01/E1/1",
+				State:    wiringapi.ConnectionLinkStateMiscabled,
+				Expected: "server-01/enp2s1",
+				Observed: "server-01/enp2s2",
+			},
+		},
+		{
+			name: "miscabled-device",
+			neighbor: apiutil.LLDPNeighborStatus{
+				Expected: apiutil.LLDPNeighbor{Name: "server-01", Port: "enp2s1"},
+				Actual: []apiutil.LLDPNeighbor{
+					{Name: "server-02", Port: "enp2s1"},
+					{Name: "server-03", Port: "enp2s1"},
+				},
+			},
+			expected: wiringapi.ConnectionLinkStatus{
+				Port:     "leaf-01/E1/1",
+				State:    wiringapi.ConnectionLinkStateMiscabled,
+				Expected: "server-01/enp2s1",
+				Observed: "server-02/enp2s1",
+			},
+		},
+		{
+			name: "external-any-neighbor",
+			neighbor: apiutil.LLDPNeighborStatus{
+				Actual: []apiutil.LLDPNeighbor{
+					{Name: "edge-router", Port: "xe-0/0/1"},
+				},
+			},
+			expected: wiringapi.ConnectionLinkStatus{
+				Port:     "leaf-01/E1/1",
+				State:    wiringapi.ConnectionLinkStateVerified,
+				Observed: "edge-router/xe-0/0/1",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.neighbor.LinkStatus("leaf-01/E1/1"))
+		})
+	}
+}
+
+func TestCablingLinksStatus(t *testing.T) {
+	verified := func(port, remote string) wiringapi.ConnectionLinkStatus {
+		return wiringapi.ConnectionLinkStatus{
+			Port:     port,
+			State:    wiringapi.ConnectionLinkStateVerified,
+			Expected: remote,
+			Observed: remote,
+		}
+	}
+	noNeighbor := func(port, remote string) wiringapi.ConnectionLinkStatus {
+		return wiringapi.ConnectionLinkStatus{
+			Port:     port,
+			State:    wiringapi.ConnectionLinkStateNoNeighbor,
+			Expected: remote,
+		}
+	}
+
+	for _, tt := range []struct {
+		name     string
+		switches []string
+		current  []wiringapi.ConnectionLinkStatus
+		observed []wiringapi.ConnectionLinkStatus
+		expected []wiringapi.ConnectionLinkStatus
+	}{
+		{
+			name:     "empty",
+			switches: []string{"leaf-01"},
+		},
+		{
+			name:     "new",
+			switches: []string{"leaf-01"},
+			observed: []wiringapi.ConnectionLinkStatus{
+				noNeighbor("leaf-01/E1/2", "server-01/enp2s2"),
+				verified("leaf-01/E1/1", "server-01/enp2s1"),
+			},
+			expected: []wiringapi.ConnectionLinkStatus{
+				verified("leaf-01/E1/1", "server-01/enp2s1"),
+				noNeighbor("leaf-01/E1/2", "server-01/enp2s2"),
+			},
+		},
+		{
+			name:     "replace-own-keep-other-end",
+			switches: []string{"leaf-01", "spine-01"},
+			current: []wiringapi.ConnectionLinkStatus{
+				noNeighbor("leaf-01/E1/1", "spine-01/E1/1"),
+				verified("spine-01/E1/1", "leaf-01/E1/1"),
+			},
+			observed: []wiringapi.ConnectionLinkStatus{
+				verified("leaf-01/E1/1", "spine-01/E1/1"),
+			},
+			expected: []wiringapi.ConnectionLinkStatus{
+				verified("leaf-01/E1/1", "spine-01/E1/1"),
+				verified("spine-01/E1/1", "leaf-01/E1/1"),
+			},
+		},
+		{
+			name:     "drop-removed-switch",
+			switches: []string{"leaf-01"},
+			current: []wiringapi.ConnectionLinkStatus{
+				verified("leaf-02/E1/1", "server-01/enp2s1"),
+			},
+			observed: []wiringapi.ConnectionLinkStatus{
+				verified("leaf-01/E1/1", "server-01/enp2s1"),
+			},
+			expected: []wiringapi.ConnectionLinkStatus{
+				verified("leaf-01/E1/1", "server-01/enp2s1"),
+			},
+		},
+		{
+			name:     "all-removed",
+			switches: []string{"leaf-01"},
+			current: []wiringapi.ConnectionLinkStatus{
+				verified("leaf-01/E1/1", "server-01/enp2s1"),
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, cablingLinksStatus("leaf-01", tt.switches, tt.current, tt.observed))
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package ctrl
+
+import (
+	"context"
+	"fmt"
+
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	vpcapi "go.githedgehog.com/fabric/api/vpc/v1beta1"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	kctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// bgpAFISafiIPv4Unicast is the key of the IPv4 unicast prefixes in the agent BGP neighbor state
+const bgpAFISafiIPv4Unicast = "IPV4_UNICAST"
+
+// +kubebuilder:rbac:groups=vpc.githedgehog.com,resources=externalattachments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vpc.githedgehog.com,resources=externalattachments/status,verbs=get;update;patch
+
+// +kubebuilder:rbac:groups=wiring.githedgehog.com,resources=connections,verbs=get;list;watch
+// +kubebuilder:rbac:groups=agent.githedgehog.com,resources=agents,verbs=get;list;watch
+
+// ExternalAttachmentReconciler projects the BGP neighbor state reported by the switch agents into the status of the
+// corresponding external attachments
+type ExternalAttachmentReconciler struct {
+	kclient.Client
+}
+
+func SetupExternalAttachmentReconcilerWith(mgr kctrl.Manager) error {
+	r := &ExternalAttachmentReconciler{
+		Client: mgr.GetClient(),
+	}
+
+	if err := kctrl.NewControllerManagedBy(mgr).
+		Named("ExternalAttachment").
+		For(&vpcapi.ExternalAttachment{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&agentapi.Agent{}, handler.EnqueueRequestsFromMapFunc(r.enqueueByAgent), builder.WithPredicates(agentBGPNeighborsChangedPredicate)).
+		Complete(r); err != nil {
+		return fmt.Errorf("setting up external attachment controller: %w", err)
+	}
+
+	return nil
+}
+
+// agentBGPNeighborsChangedPredicate only passes agent updates that change the reported BGP neighbors state
+var agentBGPNeighborsChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldAgent, okOld := e.ObjectOld.(*agentapi.Agent)
+		newAgent, okNew := e.ObjectNew.(*agentapi.Agent)
+		if !okOld || !okNew {
+			return true
+		}
+
+		return !equality.Semantic.DeepEqual(oldAgent.Status.State.BGPNeighbors, newAgent.Status.State.BGPNeighbors)
+	},
+}
+
+func (r *ExternalAttachmentReconciler) enqueueByAgent(ctx context.Context, obj kclient.Object) []reconcile.Request {
+	res := []reconcile.Request{}
+
+	conns := &wiringapi.ConnectionList{}
+	if err := r.List(ctx, conns, kclient.InNamespace(obj.GetNamespace()), wiringapi.MatchingLabelsForListLabelSwitch(obj.GetName()),
+		kclient.MatchingLabels{wiringapi.LabelConnectionType: wiringapi.ConnectionTypeExternal}); err != nil {
+		kctrllog.FromContext(ctx).Error(err, "error listing external connections to reconcile external attachments")
+
+		return res
+	}
+
+	for _, conn := range conns.Items {
+		attaches := &vpcapi.ExternalAttachmentList{}
+		if err := r.List(ctx, attaches, kclient.InNamespace(obj.GetNamespace()), kclient.MatchingLabels{
+			wiringapi.LabelConnection: conn.Name,
+		}); err != nil {
+			kctrllog.FromContext(ctx).Error(err, "error listing external attachments to reconcile", "connection", conn.Name)
+
+			return res
+		}
+
+		for _, attach := range attaches.Items {
+			res = append(res, reconcile.Request{
+				NamespacedName: kclient.ObjectKey{Name: attach.Name, Namespace: attach.Namespace},
+			})
+		}
+	}
+
+	return res
+}
+
+func (r *ExternalAttachmentReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kctrl.Result, error) {
+	attach := &vpcapi.ExternalAttachment{}
+	if err := r.Get(ctx, req.NamespacedName, attach); err != nil {
+		if kapierrors.IsNotFound(err) {
+			return kctrl.Result{}, nil
+		}
+
+		return kctrl.Result{}, fmt.Errorf("getting external attachment: %w", err)
+	}
+
+	if attach.DeletionTimestamp != nil {
+		return kctrl.Result{}, nil
+	}
+
+	newStatus := attach.Status.DeepCopy()
+	if attach.Spec.Static != nil {
+		newStatus.BGP = nil
+		kmeta.RemoveStatusCondition(&newStatus.Conditions, vpcapi.ExternalAttachmentConditionBGPEstablished)
+	} else {
+		conn := &wiringapi.Connection{}
+		if err := r.Get(ctx, kclient.ObjectKey{Name: attach.Spec.Connection, Namespace: attach.Namespace}, conn); err != nil {
+			if !kapierrors.IsNotFound(err) {
+				return kctrl.Result{}, fmt.Errorf("getting connection %s: %w", attach.Spec.Connection, err)
+			}
+			conn = nil
+		}
+
+		var neighbor *agentapi.SwitchStateBGPNeighbor
+		switchName := ""
+		if conn != nil && conn.Spec.External != nil {
+			switchName = conn.Spec.External.SwitchName()
+
+			agent := &agentapi.Agent{}
+			if err := r.Get(ctx, kclient.ObjectKey{Name: switchName, Namespace: attach.Namespace}, agent); err != nil {
+				if !kapierrors.IsNotFound(err) {
+					return kctrl.Result{}, fmt.Errorf("getting agent %s: %w", switchName, err)
+				}
+			} else {
+				// TODO dedup with agent code
+				vrf := "VrfE" + attach.Spec.External
+				if neigh, ok := agent.Status.State.BGPNeighbors[vrf][attach.Spec.Neighbor.IP]; ok {
+					neighbor = &neigh
+				}
+			}
+		}
+
+		newStatus.BGP = externalAttachmentBGPStatus(switchName, neighbor)
+		setBGPEstablishedCondition(&newStatus.Conditions, attach.Generation, newStatus.BGP, neighbor != nil)
+	}
+
+	if equality.Semantic.DeepEqual(&attach.Status, newStatus) {
+		return kctrl.Result{}, nil
+	}
+
+	attach.Status = *newStatus
+	if err := r.Status().Update(ctx, attach); err != nil {
+		return kctrl.Result{}, fmt.Errorf("updating external attachment status: %w", err)
+	}
+
+	return kctrl.Result{}, nil
+}
+
+func externalAttachmentBGPStatus(switchName string, neighbor *agentapi.SwitchStateBGPNeighbor) *vpcapi.ExternalAttachmentBGPStatus {
+	status := &vpcapi.ExternalAttachmentBGPStatus{
+		Switch: switchName,
+	}
+
+	if neighbor == nil {
+		return status
+	}
+
+	status.SessionState = string(neighbor.SessionState)
+	status.LastResetReason = neighbor.LastResetReason
+	if neighbor.SessionState == agentapi.BGPNeighborSessionStateEstablished && !neighbor.LastEstablished.IsZero() {
+		status.EstablishedSince = neighbor.LastEstablished.DeepCopy()
+	}
+	if !neighbor.LastResetTime.IsZero() {
+		status.LastResetTime = neighbor.LastResetTime.DeepCopy()
+	}
+
+	if prefixes, ok := neighbor.Prefixes[bgpAFISafiIPv4Unicast]; ok {
+		// pre-policy counter is only tracked with soft reconfiguration inbound enabled, so it could be lower
+		status.PrefixesReceived = max(prefixes.ReceivedPrePolicy, prefixes.Received)
+		status.PrefixesAccepted = prefixes.Received
+	}
+
+	return status
+}
+
+func setBGPEstablishedCondition(conditions *[]kmetav1.Condition, generation int64, status *vpcapi.ExternalAttachmentBGPStatus, reported bool) {
+	established := kmetav1.Condition{
+		Type:               vpcapi.ExternalAttachmentConditionBGPEstablished,
+		Status:             kmetav1.ConditionFalse,
+		ObservedGeneration: generation,
+	}
+
+	switch {
+	case status.Switch == "":
+		established.Reason = "NoSwitch"
+		established.Message = "Connection not found or isn't an external connection"
+	case !reported:
+		established.Reason = "NoState"
+		established.Message = fmt.Sprintf("BGP neighbor state not reported by switch %s", status.Switch)
+	case status.SessionState != string(agentapi.BGPNeighborSessionStateEstablished):
+		established.Reason = "NotEstablished"
+		established.Message = fmt.Sprintf("BGP session on switch %s is %s", status.Switch, status.SessionState)
+		if status.LastResetReason != "" {
+			established.Message += fmt.Sprintf(", last reset reason: %s", status.LastResetReason)
+		}
+	default:
+		established.Status = kmetav1.ConditionTrue
+		established.Reason = "Established"
+		established.Message = fmt.Sprintf("BGP session on switch %s is established", status.Switch)
+	}
+
+	kmeta.SetStatusCondition(conditions, established)
+}
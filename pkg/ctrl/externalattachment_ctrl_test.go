@@ -0,0 +1,120 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package ctrl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	vpcapi "go.githedgehog.com/fabric/api/vpc/v1beta1"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExternalAttachmentBGPStatus(t *testing.T) {
+	established := kmetav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	reset := kmetav1.NewTime(time.Date(2026, 1, 1, 3, 4, 5, 0, time.UTC))
+
+	for _, tt := range []struct {
+		name     string
+		switchN  string
+		neighbor *agentapi.SwitchStateBGPNeighbor
+		expected *vpcapi.ExternalAttachmentBGPStatus
+		status   kmetav1.ConditionStatus
+		reason   string
+	}{
+		{
+			name:     "no-switch",
+			expected: &vpcapi.ExternalAttachmentBGPStatus{},
+			status:   kmetav1.ConditionFalse,
+			reason:   "NoSwitch",
+		},
+		{
+			name:     "no-state",
+			switchN:  "leaf-1",
+			expected: &vpcapi.ExternalAttachmentBGPStatus{Switch: "leaf-1"},
+			status:   kmetav1.ConditionFalse,
+			reason:   "NoState",
+		},
+		{
+			name:    "established",
+			switchN: "leaf-1",
+			neighbor: &agentapi.SwitchStateBGPNeighbor{
+				SessionState:    agentapi.BGPNeighborSessionStateEstablished,
+				LastEstablished: established,
+				LastResetReason: "Hold Timer Expired",
+				LastResetTime:   reset,
+				Prefixes: map[string]agentapi.SwitchStateBGPNeighborPrefixes{
+					bgpAFISafiIPv4Unicast: {Received: 10, ReceivedPrePolicy: 12, Sent: 5},
+					"L2VPN_EVPN":          {Received: 100},
+				},
+			},
+			expected: &vpcapi.ExternalAttachmentBGPStatus{
+				Switch:           "leaf-1",
+				SessionState:     "established",
+				EstablishedSince: &established,
+				PrefixesReceived: 12,
+				PrefixesAccepted: 10,
+				LastResetReason:  "Hold Timer Expired",
+				LastResetTime:    &reset,
+			},
+			status: kmetav1.ConditionTrue,
+			reason: "Established",
+		},
+		{
+			name:    "established-no-pre-policy",
+			switchN: "leaf-1",
+			neighbor: &agentapi.SwitchStateBGPNeighbor{
+				SessionState:    agentapi.BGPNeighborSessionStateEstablished,
+				LastEstablished: established,
+				Prefixes: map[string]agentapi.SwitchStateBGPNeighborPrefixes{
+					bgpAFISafiIPv4Unicast: {Received: 10},
+				},
+			},
+			expected: &vpcapi.ExternalAttachmentBGPStatus{
+				Switch:           "leaf-1",
+				SessionState:     "established",
+				EstablishedSince: &established,
+				PrefixesReceived: 10,
+				PrefixesAccepted: 10,
+			},
+			status: kmetav1.ConditionTrue,
+			reason: "Established",
+		},
+		{
+			name:    "active",
+			switchN: "leaf-1",
+			neighbor: &agentapi.SwitchStateBGPNeighbor{
+				SessionState:    agentapi.BGPNeighborSessionStateActive,
+				LastEstablished: established,
+				LastResetReason: "Peer closed the session",
+				LastResetTime:   reset,
+			},
+			expected: &vpcapi.ExternalAttachmentBGPStatus{
+				Switch:          "leaf-1",
+				SessionState:    "active",
+				LastResetReason: "Peer closed the session",
+				LastResetTime:   &reset,
+			},
+			status: kmetav1.ConditionFalse,
+			reason: "NotEstablished",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			status := externalAttachmentBGPStatus(tt.switchN, tt.neighbor)
+			require.Equal(t, tt.expected, status)
+
+			conditions := []kmetav1.Condition{}
+			setBGPEstablishedCondition(&conditions, 3, status, tt.neighbor != nil)
+
+			cond := kmeta.FindStatusCondition(conditions, vpcapi.ExternalAttachmentConditionBGPEstablished)
+			require.NotNil(t, cond)
+			require.Equal(t, tt.status, cond.Status)
+			require.Equal(t, tt.reason, cond.Reason)
+			require.Equal(t, int64(3), cond.ObservedGeneration)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package ctrl
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/pkg/errors"
+	"go.githedgehog.com/fabric/api/meta"
+	vpcapi "go.githedgehog.com/fabric/api/vpc/v1beta1"
+	"go.githedgehog.com/fabric/pkg/util/iputil"
+	"k8s.io/apimachinery/pkg/runtime"
+	kctrl "sigs.k8s.io/controller-runtime"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+type IPv6NamespaceWebhook struct {
+	kclient.Client
+	Scheme     *runtime.Scheme
+	KubeClient kclient.Reader
+	Cfg        *meta.FabricConfig
+}
+
+func SetupIPv6NamespaceWebhookWith(mgr kctrl.Manager, cfg *meta.FabricConfig) error {
+	w := &IPv6NamespaceWebhook{
+		Client:     mgr.GetClient(),
+		Scheme:     mgr.GetScheme(),
+		KubeClient: mgr.GetClient(),
+		Cfg:        cfg,
+	}
+
+	return errors.Wrapf(kctrl.NewWebhookManagedBy(mgr, &vpcapi.IPv6Namespace{}).
+		WithDefaulter(w).
+		WithValidator(w).
+		Complete(), "failed to setup ipv6namespace webhook")
+}
+
+//+kubebuilder:webhook:path=/mutate-vpc-githedgehog-com-v1beta1-ipv6namespace,mutating=true,failurePolicy=fail,sideEffects=None,groups=vpc.githedgehog.com,resources=ipv6namespaces,verbs=create;update,versions=v1beta1,name=mipv6namespace.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-vpc-githedgehog-com-v1beta1-ipv6namespace,mutating=false,failurePolicy=fail,sideEffects=None,groups=vpc.githedgehog.com,resources=ipv6namespaces,verbs=create;update;delete,versions=v1beta1,name=vipv6namespace.kb.io,admissionReviewVersions=v1
+
+func (w *IPv6NamespaceWebhook) Default(_ context.Context, ns *vpcapi.IPv6Namespace) error {
+	ns.Default()
+
+	return nil
+}
+
+func (w *IPv6NamespaceWebhook) ValidateCreate(ctx context.Context, ns *vpcapi.IPv6Namespace) (admission.Warnings, error) {
+	warns, err := ns.Validate(ctx, w.KubeClient, w.Cfg)
+	if err != nil {
+		return warns, errors.Wrapf(err, "failed to validate ipv6namespace")
+	}
+
+	return warns, nil
+}
+
+func (w *IPv6NamespaceWebhook) ValidateUpdate(ctx context.Context, _ *vpcapi.IPv6Namespace, newNs *vpcapi.IPv6Namespace) (admission.Warnings, error) {
+	if warn, err := newNs.Validate(ctx, w.Client, w.Cfg); err != nil {
+		return warn, errors.Wrapf(err, "failed to validate ipv6namespace")
+	}
+
+	nsSubnets := []netip.Prefix{}
+	for _, subnet := range newNs.Spec.Subnets {
+		ipNet, err := netip.ParsePrefix(subnet)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse cidr %s", subnet)
+		}
+
+		nsSubnets = append(nsSubnets, ipNet)
+	}
+
+	vpcs := &vpcapi.VPCList{}
+	if err := w.Client.List(ctx, vpcs, kclient.MatchingLabels{
+		vpcapi.LabelIPv6NS: newNs.Name,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "error listing vpcs") // TODO hide internal error
+	}
+
+	for _, vpc := range vpcs.Items {
+		for subnetName, subnetCfg := range vpc.Spec.Subnets {
+			if subnetCfg.SubnetIPv6 == "" {
+				continue
+			}
+
+			vpcSubnet, err := netip.ParsePrefix(subnetCfg.SubnetIPv6)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse vpc IPv6 subnet %s", subnetCfg.SubnetIPv6)
+			}
+
+			ok := false
+			for _, nsSubnet := range nsSubnets {
+				if iputil.IsSubset(vpcSubnet, nsSubnet) {
+					ok = true
+
+					break
+				}
+			}
+
+			if !ok {
+				return nil, errors.Errorf("existing vpc %s subnet %s (IPv6 cidr %s) doesn't fit updated IPv6 namespace", vpc.Name, subnetName, subnetCfg.SubnetIPv6)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func (w *IPv6NamespaceWebhook) ValidateDelete(ctx context.Context, ns *vpcapi.IPv6Namespace) (admission.Warnings, error) {
+	vpcs := &vpcapi.VPCList{}
+	if err := w.Client.List(ctx, vpcs, kclient.MatchingLabels{
+		vpcapi.LabelIPv6NS: ns.Name,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "error listing vpcs") // TODO hide internal error
+	}
+	if len(vpcs.Items) > 0 {
+		return nil, errors.Errorf("IPv6Namespace has VPCs")
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package ctrl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.githedgehog.com/fabric/api/meta"
+	vpcapi "go.githedgehog.com/fabric/api/vpc/v1beta1"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ktypes "k8s.io/apimachinery/pkg/types"
+	kctrl "sigs.k8s.io/controller-runtime"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+type MirrorSessionWebhook struct {
+	kclient.Client
+	Scheme     *runtime.Scheme
+	KubeClient kclient.Reader
+	Cfg        *meta.FabricConfig
+}
+
+func SetupMirrorSessionWebhookWith(mgr kctrl.Manager, cfg *meta.FabricConfig) error {
+	w := &MirrorSessionWebhook{
+		Client:     mgr.GetClient(),
+		Scheme:     mgr.GetScheme(),
+		KubeClient: mgr.GetClient(),
+		Cfg:        cfg,
+	}
+
+	return errors.Wrapf(kctrl.NewWebhookManagedBy(mgr, &wiringapi.MirrorSession{}).
+		WithDefaulter(w).
+		WithValidator(w).
+		Complete(), "failed to setup mirror session webhook")
+}
+
+//+kubebuilder:webhook:path=/mutate-wiring-githedgehog-com-v1beta1-mirrorsession,mutating=true,failurePolicy=fail,sideEffects=None,groups=wiring.githedgehog.com,resources=mirrorsessions,verbs=create;update,versions=v1beta1,name=mmirrorsession.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-wiring-githedgehog-com-v1beta1-mirrorsession,mutating=false,failurePolicy=fail,sideEffects=None,groups=wiring.githedgehog.com,resources=mirrorsessions,verbs=create;update;delete,versions=v1beta1,name=vmirrorsession.kb.io,admissionReviewVersions=v1
+
+func (w *MirrorSessionWebhook) Default(_ context.Context, session *wiringapi.MirrorSession) error {
+	session.Default()
+
+	return nil
+}
+
+func (w *MirrorSessionWebhook) ValidateCreate(ctx context.Context, session *wiringapi.MirrorSession) (admission.Warnings, error) {
+	return w.validate(ctx, session)
+}
+
+func (w *MirrorSessionWebhook) ValidateUpdate(ctx context.Context, _ *wiringapi.MirrorSession, newSession *wiringapi.MirrorSession) (admission.Warnings, error) {
+	return w.validate(ctx, newSession)
+}
+
+func (w *MirrorSessionWebhook) ValidateDelete(_ context.Context, _ *wiringapi.MirrorSession) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (w *MirrorSessionWebhook) validate(ctx context.Context, session *wiringapi.MirrorSession) (admission.Warnings, error) {
+	warns, err := session.Validate(ctx, w.KubeClient, w.Cfg)
+	if err != nil {
+		return warns, errors.Wrapf(err, "failed to validate mirror session")
+	}
+
+	// wiring API can't reference VPC API, so VPC is checked here
+	if session.Spec.ERSPAN != nil && session.Spec.ERSPAN.VPC != "" {
+		vpc := &vpcapi.VPC{}
+		if err := w.KubeClient.Get(ctx, ktypes.NamespacedName{Name: session.Spec.ERSPAN.VPC, Namespace: session.Namespace}, vpc); err != nil {
+			if kapierrors.IsNotFound(err) {
+				return warns, errors.Errorf("erspan vpc %s not found", session.Spec.ERSPAN.VPC)
+			}
+
+			return warns, errors.Wrapf(err, "failed to get erspan vpc %s", session.Spec.ERSPAN.VPC) // TODO replace with some internal error to not expose to the user
+		}
+	}
+
+	return warns, nil
+}
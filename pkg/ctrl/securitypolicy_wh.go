@@ -0,0 +1,68 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package ctrl
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.githedgehog.com/fabric/api/meta"
+	vpcapi "go.githedgehog.com/fabric/api/vpc/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kctrl "sigs.k8s.io/controller-runtime"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+type SecurityPolicyWebhook struct {
+	kclient.Client
+	Scheme     *runtime.Scheme
+	KubeClient kclient.Reader
+	Cfg        *meta.FabricConfig
+}
+
+func SetupSecurityPolicyWebhookWith(mgr kctrl.Manager, cfg *meta.FabricConfig) error {
+	w := &SecurityPolicyWebhook{
+		Client:     mgr.GetClient(),
+		Scheme:     mgr.GetScheme(),
+		KubeClient: mgr.GetClient(),
+		Cfg:        cfg,
+	}
+
+	return errors.Wrapf(kctrl.NewWebhookManagedBy(mgr, &vpcapi.SecurityPolicy{}).
+		WithDefaulter(w).
+		WithValidator(w).
+		Complete(), "failed to setup security policy webhook")
+}
+
+//+kubebuilder:webhook:path=/mutate-vpc-githedgehog-com-v1beta1-securitypolicy,mutating=true,failurePolicy=fail,sideEffects=None,groups=vpc.githedgehog.com,resources=securitypolicies,verbs=create;update,versions=v1beta1,name=msecuritypolicy.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-vpc-githedgehog-com-v1beta1-securitypolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=vpc.githedgehog.com,resources=securitypolicies,verbs=create;update;delete,versions=v1beta1,name=vsecuritypolicy.kb.io,admissionReviewVersions=v1
+
+func (w *SecurityPolicyWebhook) Default(_ context.Context, policy *vpcapi.SecurityPolicy) error {
+	policy.Default()
+
+	return nil
+}
+
+func (w *SecurityPolicyWebhook) ValidateCreate(ctx context.Context, policy *vpcapi.SecurityPolicy) (admission.Warnings, error) {
+	warns, err := policy.Validate(ctx, w.KubeClient, w.Cfg)
+	if err != nil {
+		return warns, errors.Wrapf(err, "failed to validate security policy")
+	}
+
+	return warns, nil
+}
+
+func (w *SecurityPolicyWebhook) ValidateUpdate(ctx context.Context, _ *vpcapi.SecurityPolicy, newPolicy *vpcapi.SecurityPolicy) (admission.Warnings, error) {
+	warns, err := newPolicy.Validate(ctx, w.KubeClient, w.Cfg)
+	if err != nil {
+		return warns, errors.Wrapf(err, "failed to validate security policy")
+	}
+
+	return warns, nil
+}
+
+func (w *SecurityPolicyWebhook) ValidateDelete(_ context.Context, _ *vpcapi.SecurityPolicy) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,338 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package ctrl
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	dhcpapi "go.githedgehog.com/fabric/api/dhcp/v1beta1"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	kctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	kctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// +kubebuilder:rbac:groups=wiring.githedgehog.com,resources=servers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=wiring.githedgehog.com,resources=servers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=wiring.githedgehog.com,resources=connections,verbs=get;list;watch
+// +kubebuilder:rbac:groups=wiring.githedgehog.com,resources=switches,verbs=get;list;watch
+// +kubebuilder:rbac:groups=wiring.githedgehog.com,resources=switchprofiles,verbs=get;list;watch
+
+// +kubebuilder:rbac:groups=agent.githedgehog.com,resources=agents,verbs=get;list;watch
+// +kubebuilder:rbac:groups=dhcp.githedgehog.com,resources=dhcpsubnets,verbs=get;list;watch
+
+// ServerReconciler discovers the servers connected to the fabric by collecting the LLDP neighbors reported by the
+// switch agents on the server-facing ports and the DHCP leases allocated to the observed MAC addresses into the
+// servers status
+type ServerReconciler struct {
+	kclient.Client
+}
+
+func SetupServerReconcilerWith(mgr kctrl.Manager) error {
+	r := &ServerReconciler{
+		Client: mgr.GetClient(),
+	}
+
+	if err := kctrl.NewControllerManagedBy(mgr).
+		Named("Server").
+		For(&wiringapi.Server{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&wiringapi.Connection{}, handler.EnqueueRequestsFromMapFunc(r.enqueueByConnection), builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&agentapi.Agent{}, handler.EnqueueRequestsFromMapFunc(r.enqueueByAgent), builder.WithPredicates(agentLLDPNeighborsChangedPredicate)).
+		Watches(&dhcpapi.DHCPSubnet{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAllServers), builder.WithPredicates(dhcpSubnetAllocatedChangedPredicate)).
+		Complete(r); err != nil {
+		return fmt.Errorf("setting up server controller: %w", err)
+	}
+
+	return nil
+}
+
+// dhcpSubnetAllocatedChangedPredicate only passes DHCP subnet updates that change the allocated IPs
+var dhcpSubnetAllocatedChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldSubnet, okOld := e.ObjectOld.(*dhcpapi.DHCPSubnet)
+		newSubnet, okNew := e.ObjectNew.(*dhcpapi.DHCPSubnet)
+		if !okOld || !okNew {
+			return true
+		}
+
+		if len(oldSubnet.Status.Allocated) != len(newSubnet.Status.Allocated) {
+			return true
+		}
+
+		for mac, alloc := range newSubnet.Status.Allocated {
+			oldAlloc, exists := oldSubnet.Status.Allocated[mac]
+			if !exists || oldAlloc.IP != alloc.IP || oldAlloc.Hostname != alloc.Hostname || oldAlloc.Discover != alloc.Discover {
+				return true
+			}
+		}
+
+		return false
+	},
+}
+
+func (r *ServerReconciler) enqueueByConnection(_ context.Context, obj kclient.Object) []reconcile.Request {
+	conn, ok := obj.(*wiringapi.Connection)
+	if !ok {
+		return nil
+	}
+
+	return serverRequests(conn)
+}
+
+func (r *ServerReconciler) enqueueByAgent(ctx context.Context, obj kclient.Object) []reconcile.Request {
+	res := []reconcile.Request{}
+
+	conns := &wiringapi.ConnectionList{}
+	if err := r.List(ctx, conns, kclient.InNamespace(obj.GetNamespace()), wiringapi.MatchingLabelsForListLabelSwitch(obj.GetName())); err != nil {
+		kctrllog.FromContext(ctx).Error(err, "error listing connections to reconcile servers")
+
+		return res
+	}
+
+	for _, conn := range conns.Items {
+		res = append(res, serverRequests(&conn)...)
+	}
+
+	return res
+}
+
+func (r *ServerReconciler) enqueueAllServers(ctx context.Context, obj kclient.Object) []reconcile.Request {
+	res := []reconcile.Request{}
+
+	servers := &wiringapi.ServerList{}
+	if err := r.List(ctx, servers, kclient.InNamespace(obj.GetNamespace())); err != nil {
+		kctrllog.FromContext(ctx).Error(err, "error listing servers to reconcile")
+
+		return res
+	}
+
+	for _, server := range servers.Items {
+		res = append(res, reconcile.Request{
+			NamespacedName: kclient.ObjectKey{Name: server.Name, Namespace: server.Namespace},
+		})
+	}
+
+	return res
+}
+
+func serverRequests(conn *wiringapi.Connection) []reconcile.Request {
+	res := []reconcile.Request{}
+
+	servers := map[string]bool{}
+	for _, link := range serverConnectionLinks(&conn.Spec) {
+		servers[link.Server.DeviceName()] = true
+	}
+
+	for server := range servers {
+		res = append(res, reconcile.Request{
+			NamespacedName: kclient.ObjectKey{Name: server, Namespace: conn.Namespace},
+		})
+	}
+
+	return res
+}
+
+// serverConnectionLinks returns the server-to-switch links of the server-facing connection
+func serverConnectionLinks(conn *wiringapi.ConnectionSpec) []wiringapi.ServerToSwitchLink {
+	switch {
+	case conn.Unbundled != nil:
+		return []wiringapi.ServerToSwitchLink{conn.Unbundled.Link}
+	case conn.Bundled != nil:
+		return conn.Bundled.Links
+	case conn.MCLAG != nil:
+		return conn.MCLAG.Links
+	case conn.ESLAG != nil:
+		return conn.ESLAG.Links
+	}
+
+	return nil
+}
+
+func (r *ServerReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kctrl.Result, error) {
+	server := &wiringapi.Server{}
+	if err := r.Get(ctx, req.NamespacedName, server); err != nil {
+		if kapierrors.IsNotFound(err) {
+			return kctrl.Result{}, nil
+		}
+
+		return kctrl.Result{}, fmt.Errorf("getting server: %w", err)
+	}
+
+	conns := &wiringapi.ConnectionList{}
+	if err := r.List(ctx, conns, kclient.InNamespace(server.Namespace), wiringapi.MatchingLabelsForListLabelServer(server.Name)); err != nil {
+		return kctrl.Result{}, fmt.Errorf("listing connections: %w", err)
+	}
+
+	switches := map[string]*serverSwitchState{}
+	ports := []wiringapi.ServerStatusPort{}
+	for _, conn := range conns.Items {
+		for _, link := range serverConnectionLinks(&conn.Spec) {
+			if link.Server.DeviceName() != server.Name {
+				continue
+			}
+
+			switchName := link.Switch.DeviceName()
+			swState, ok := switches[switchName]
+			if !ok {
+				var err error
+				swState, err = r.getSwitchState(ctx, server.Namespace, switchName)
+				if err != nil {
+					return kctrl.Result{}, err
+				}
+				switches[switchName] = swState
+			}
+			if swState == nil {
+				continue
+			}
+
+			port, err := swState.profile.Spec.NormalizePortName(link.Switch.PortName())
+			if err != nil {
+				return kctrl.Result{}, fmt.Errorf("normalizing port name %s: %w", link.Switch.PortName(), err)
+			}
+
+			for _, neighbor := range swState.agent.Status.State.Interfaces[port].LLDPNeighbors {
+				ports = append(ports, serverStatusPort(switchName+"/"+port, conn.Name, neighbor))
+			}
+		}
+	}
+
+	subnets := &dhcpapi.DHCPSubnetList{}
+	if err := r.List(ctx, subnets, kclient.InNamespace(server.Namespace)); err != nil {
+		return kctrl.Result{}, fmt.Errorf("listing dhcp subnets: %w", err)
+	}
+
+	status := serverStatus(ports, subnets.Items)
+	if equality.Semantic.DeepEqual(server.Status, status) {
+		return kctrl.Result{}, nil
+	}
+
+	server.Status = status
+	if err := r.Status().Update(ctx, server); err != nil {
+		return kctrl.Result{}, fmt.Errorf("updating server status: %w", err)
+	}
+
+	return kctrl.Result{}, nil
+}
+
+type serverSwitchState struct {
+	agent   *agentapi.Agent
+	profile *wiringapi.SwitchProfile
+}
+
+// getSwitchState returns the agent and switch profile of the switch or nil if any of them doesn't exist yet
+func (r *ServerReconciler) getSwitchState(ctx context.Context, ns, switchName string) (*serverSwitchState, error) {
+	sw := &wiringapi.Switch{}
+	if err := r.Get(ctx, kclient.ObjectKey{Name: switchName, Namespace: ns}, sw); err != nil {
+		if kapierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("getting switch %s: %w", switchName, err)
+	}
+
+	sp := &wiringapi.SwitchProfile{}
+	if err := r.Get(ctx, kclient.ObjectKey{Name: sw.Spec.Profile, Namespace: ns}, sp); err != nil {
+		if kapierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("getting switch profile %s: %w", sw.Spec.Profile, err)
+	}
+
+	agent := &agentapi.Agent{}
+	if err := r.Get(ctx, kclient.ObjectKey{Name: switchName, Namespace: ns}, agent); err != nil {
+		if kapierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("getting agent %s: %w", switchName, err)
+	}
+
+	return &serverSwitchState{agent: agent, profile: sp}, nil
+}
+
+func serverStatusPort(switchPort, connName string, neighbor agentapi.SwitchStateLLDPNeighbor) wiringapi.ServerStatusPort {
+	port := wiringapi.ServerStatusPort{
+		Switch:     switchPort,
+		Connection: connName,
+		SystemName: neighbor.SystemName,
+		Port:       neighbor.PortDescription,
+	}
+	if port.Port == "" {
+		port.Port = neighbor.PortID
+	}
+
+	// port ID is usually the port MAC address while chassis ID is the MAC address of one of the server ports
+	if mac, err := net.ParseMAC(neighbor.PortID); err == nil {
+		port.MAC = mac.String()
+	} else if mac, err := net.ParseMAC(neighbor.ChassisID); err == nil {
+		port.MAC = mac.String()
+	}
+
+	return port
+}
+
+// serverStatus builds the server status from the observed LLDP neighbors and the IPs allocated to their MAC addresses
+func serverStatus(ports []wiringapi.ServerStatusPort, subnets []dhcpapi.DHCPSubnet) wiringapi.ServerStatus {
+	status := wiringapi.ServerStatus{}
+	if len(ports) == 0 {
+		return status
+	}
+
+	slices.SortFunc(ports, func(a, b wiringapi.ServerStatusPort) int {
+		return cmp.Or(
+			strings.Compare(a.Switch, b.Switch),
+			strings.Compare(a.SystemName, b.SystemName),
+			strings.Compare(a.Port, b.Port),
+		)
+	})
+	status.Ports = ports
+
+	for _, port := range ports {
+		if status.SystemName == "" {
+			status.SystemName = port.SystemName
+		}
+		if port.MAC != "" && !slices.Contains(status.MACs, port.MAC) {
+			status.MACs = append(status.MACs, port.MAC)
+		}
+	}
+	slices.Sort(status.MACs)
+
+	for _, subnet := range subnets {
+		for _, mac := range status.MACs {
+			alloc, exists := subnet.Status.Allocated[mac]
+			if !exists || alloc.Discover {
+				continue
+			}
+
+			status.IPs = append(status.IPs, wiringapi.ServerStatusIP{
+				IP:       alloc.IP,
+				MAC:      mac,
+				Subnet:   subnet.Spec.Subnet,
+				Hostname: alloc.Hostname,
+			})
+		}
+	}
+	slices.SortFunc(status.IPs, func(a, b wiringapi.ServerStatusIP) int {
+		return cmp.Or(
+			strings.Compare(a.Subnet, b.Subnet),
+			strings.Compare(a.IP, b.IP),
+		)
+	})
+
+	return status
+}
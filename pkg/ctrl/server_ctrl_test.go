@@ -0,0 +1,153 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package ctrl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	dhcpapi "go.githedgehog.com/fabric/api/dhcp/v1beta1"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+)
+
+func TestServerStatusPort(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		neighbor agentapi.SwitchStateLLDPNeighbor
+		expected wiringapi.ServerStatusPort
+	}{
+		{
+			name: "port-id-mac",
+			neighbor: agentapi.SwitchStateLLDPNeighbor{
+				SystemName:      "server-01",
+				ChassisID:       "0C:20:12:FE:01:00",
+				PortID:          "0C:20:12:FE:01:01",
+				PortDescription: "enp2s1",
+			},
+			expected: wiringapi.ServerStatusPort{
+				Switch:     "leaf-01/E1/1",
+				Connection: "server-01--unbundled--leaf-01",
+				SystemName: "server-01",
+				Port:       "enp2s1",
+				MAC:        "0c:20:12:fe:01:01",
+			},
+		},
+		{
+			name: "chassis-id-mac",
+			neighbor: agentapi.SwitchStateLLDPNeighbor{
+				SystemName: "server-01",
+				ChassisID:  "0c:20:12:fe:01:00",
+				PortID:     "enp2s1",
+			},
+			expected: wiringapi.ServerStatusPort{
+				Switch:     "leaf-01/E1/1",
+				Connection: "server-01--unbundled--leaf-01",
+				SystemName: "server-01",
+				Port:       "enp2s1",
+				MAC:        "0c:20:12:fe:01:00",
+			},
+		},
+		{
+			name: "no-mac",
+			neighbor: agentapi.SwitchStateLLDPNeighbor{
+				SystemName: "server-01",
+				ChassisID:  "server-01.local",
+				PortID:     "enp2s1",
+			},
+			expected: wiringapi.ServerStatusPort{
+				Switch:     "leaf-01/E1/1",
+				Connection: "server-01--unbundled--leaf-01",
+				SystemName: "server-01",
+				Port:       "enp2s1",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, serverStatusPort("leaf-01/E1/1", "server-01--unbundled--leaf-01", tt.neighbor))
+		})
+	}
+}
+
+func TestServerStatus(t *testing.T) {
+	port := func(sw, mac string) wiringapi.ServerStatusPort {
+		return wiringapi.ServerStatusPort{
+			Switch:     sw,
+			Connection: "server-01--eslag--leaf-01--leaf-02",
+			SystemName: "server-01",
+			Port:       "enp2s1",
+			MAC:        mac,
+		}
+	}
+
+	subnets := []dhcpapi.DHCPSubnet{
+		{
+			Spec: dhcpapi.DHCPSubnetSpec{Subnet: "vpc-2/default"},
+			Status: dhcpapi.DHCPSubnetStatus{
+				Allocated: map[string]dhcpapi.DHCPAllocated{
+					"0c:20:12:fe:01:01": {IP: "10.0.2.10", Hostname: "server-01"},
+					"0c:20:12:fe:02:01": {IP: "10.0.2.11", Hostname: "server-02"},
+				},
+			},
+		},
+		{
+			Spec: dhcpapi.DHCPSubnetSpec{Subnet: "vpc-1/default"},
+			Status: dhcpapi.DHCPSubnetStatus{
+				Allocated: map[string]dhcpapi.DHCPAllocated{
+					"0c:20:12:fe:01:01": {IP: "10.0.1.10", Hostname: "server-01"},
+					"0c:20:12:fe:01:02": {IP: "10.0.1.11", Discover: true},
+				},
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		ports    []wiringapi.ServerStatusPort
+		expected wiringapi.ServerStatus
+	}{
+		{
+			name:     "no-ports",
+			expected: wiringapi.ServerStatus{},
+		},
+		{
+			name: "ports",
+			ports: []wiringapi.ServerStatusPort{
+				port("leaf-02/E1/1", "0c:20:12:fe:01:02"),
+				port("leaf-01/E1/1", "0c:20:12:fe:01:01"),
+			},
+			expected: wiringapi.ServerStatus{
+				SystemName: "server-01",
+				MACs:       []string{"0c:20:12:fe:01:01", "0c:20:12:fe:01:02"},
+				IPs: []wiringapi.ServerStatusIP{
+					{IP: "10.0.1.10", MAC: "0c:20:12:fe:01:01", Subnet: "vpc-1/default", Hostname: "server-01"},
+					{IP: "10.0.2.10", MAC: "0c:20:12:fe:01:01", Subnet: "vpc-2/default", Hostname: "server-01"},
+				},
+				Ports: []wiringapi.ServerStatusPort{
+					port("leaf-01/E1/1", "0c:20:12:fe:01:01"),
+					port("leaf-02/E1/1", "0c:20:12:fe:01:02"),
+				},
+			},
+		},
+		{
+			name: "same-mac-no-lease",
+			ports: []wiringapi.ServerStatusPort{
+				port("leaf-01/E1/1", "0c:20:12:fe:03:01"),
+				port("leaf-02/E1/1", "0c:20:12:fe:03:01"),
+			},
+			expected: wiringapi.ServerStatus{
+				SystemName: "server-01",
+				MACs:       []string{"0c:20:12:fe:03:01"},
+				Ports: []wiringapi.ServerStatusPort{
+					port("leaf-01/E1/1", "0c:20:12:fe:03:01"),
+					port("leaf-02/E1/1", "0c:20:12:fe:03:01"),
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, serverStatus(tt.ports, subnets))
+		})
+	}
+}
@@ -74,7 +74,7 @@ func (w *SwitchWebhook) ValidateUpdate(ctx context.Context, oldSw *wiringapi.Swi
 		return warns, errors.Wrapf(err, "error validating switch")
 	}
 
-	if (oldSw.Spec.RoCE || newSw.Spec.RoCE) && !reflect.DeepEqual(oldSw.Spec.PortBreakouts, newSw.Spec.PortBreakouts) {
+	if (oldSw.Spec.IsRoCEEnabled() || newSw.Spec.IsRoCEEnabled()) && !reflect.DeepEqual(oldSw.Spec.PortBreakouts, newSw.Spec.PortBreakouts) {
 		return warns, errors.New("port breakouts cannot be changed when RoCEv2 is enabled")
 	}
 
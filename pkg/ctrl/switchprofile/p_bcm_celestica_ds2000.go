@@ -29,14 +29,16 @@ var CelesticaDS2000 = wiringapi.SwitchProfile{
 		OtherNames:    []string{"Celestica Questone 2a"},
 		SwitchSilicon: SiliconBroadcomTD3_X5,
 		Features: wiringapi.SwitchProfileFeatures{
-			Subinterfaces: true,
-			ACLs:          true,
-			L2VNI:         true,
-			L3VNI:         true,
-			RoCE:          false,
-			MCLAG:         true,
-			ESLAG:         true,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            true,
+			PortChannelSubinterfaces: true,
+			ACLs:                     true,
+			L2VNI:                    true,
+			L3VNI:                    true,
+			RoCE:                     false,
+			MCLAG:                    true,
+			ESLAG:                    true,
+			ECMPRoCEQPN:              false,
+			SFlow:                    true,
 		},
 		NOSType:  meta.NOSTypeSONiCBCMBase,
 		Platform: "x86_64-cel_ds2000-r0",
@@ -18,14 +18,16 @@ var CelesticaDS3000 = wiringapi.SwitchProfile{
 		OtherNames:    []string{"Celestica Seastone2"},
 		SwitchSilicon: SiliconBroadcomTD3_X7_3_2T,
 		Features: wiringapi.SwitchProfileFeatures{
-			Subinterfaces: true,
-			ACLs:          true,
-			L2VNI:         true,
-			L3VNI:         true,
-			RoCE:          true,
-			MCLAG:         true,
-			ESLAG:         true,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            true,
+			PortChannelSubinterfaces: true,
+			ACLs:                     true,
+			L2VNI:                    true,
+			L3VNI:                    true,
+			RoCE:                     true,
+			MCLAG:                    true,
+			ESLAG:                    true,
+			ECMPRoCEQPN:              false,
+			SFlow:                    true,
 		},
 		NOSType:  meta.NOSTypeSONiCBCMBase,
 		Platform: "x86_64-cel_seastone_2-r0",
@@ -18,14 +18,16 @@ var CelesticaDS4000 = wiringapi.SwitchProfile{
 		OtherNames:    []string{"Celestica Silverstone2"},
 		SwitchSilicon: SiliconBroadcomTH3,
 		Features: wiringapi.SwitchProfileFeatures{
-			Subinterfaces: false,
-			ACLs:          true,
-			L2VNI:         false,
-			L3VNI:         false,
-			RoCE:          true,
-			MCLAG:         false,
-			ESLAG:         false,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            false,
+			PortChannelSubinterfaces: false,
+			ACLs:                     true,
+			L2VNI:                    false,
+			L3VNI:                    false,
+			RoCE:                     true,
+			MCLAG:                    false,
+			ESLAG:                    false,
+			ECMPRoCEQPN:              false,
+			SFlow:                    true,
 		},
 		NOSType:  meta.NOSTypeSONiCBCMBase,
 		Platform: "x86_64-cel_silverstone-r0",
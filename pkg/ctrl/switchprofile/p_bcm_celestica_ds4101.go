@@ -18,14 +18,16 @@ var CelesticaDS4101 = wiringapi.SwitchProfile{
 		OtherNames:    []string{"Celestica Greystone"},
 		SwitchSilicon: SiliconBroadcomTH4G,
 		Features: wiringapi.SwitchProfileFeatures{
-			Subinterfaces: false,
-			ACLs:          true,
-			L2VNI:         false,
-			L3VNI:         false,
-			RoCE:          true,
-			MCLAG:         false,
-			ESLAG:         false,
-			ECMPRoCEQPN:   true,
+			Subinterfaces:            false,
+			PortChannelSubinterfaces: false,
+			ACLs:                     true,
+			L2VNI:                    false,
+			L3VNI:                    false,
+			RoCE:                     true,
+			MCLAG:                    false,
+			ESLAG:                    false,
+			ECMPRoCEQPN:              true,
+			SFlow:                    true,
 		},
 		NOSType:  meta.NOSTypeSONiCBCMBase,
 		Platform: "x86_64-cel_ds4101-r0",
@@ -18,14 +18,16 @@ var CelesticaDS5000 = wiringapi.SwitchProfile{
 		OtherNames:    []string{"Celestica Moonstone"},
 		SwitchSilicon: SiliconBroadcomTH5,
 		Features: wiringapi.SwitchProfileFeatures{
-			Subinterfaces: true,
-			ACLs:          true,
-			L2VNI:         false,
-			L3VNI:         true,
-			RoCE:          true,
-			MCLAG:         false,
-			ESLAG:         false,
-			ECMPRoCEQPN:   true,
+			Subinterfaces:            true,
+			PortChannelSubinterfaces: true,
+			ACLs:                     true,
+			L2VNI:                    false,
+			L3VNI:                    true,
+			RoCE:                     true,
+			MCLAG:                    false,
+			ESLAG:                    false,
+			ECMPRoCEQPN:              true,
+			SFlow:                    true,
 		},
 		Notes:    "Doesn't support non-L3 VPC modes due to the lack of L2VNI support.",
 		NOSType:  meta.NOSTypeSONiCBCMBase,
@@ -17,14 +17,16 @@ var DellS5232FON = wiringapi.SwitchProfile{
 		DisplayName:   "Dell S5232F-ON",
 		SwitchSilicon: SiliconBroadcomTD3_X7_3_2T,
 		Features: wiringapi.SwitchProfileFeatures{
-			Subinterfaces: true,
-			ACLs:          true,
-			L2VNI:         true,
-			L3VNI:         true,
-			RoCE:          true,
-			MCLAG:         true,
-			ESLAG:         true,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            true,
+			PortChannelSubinterfaces: true,
+			ACLs:                     true,
+			L2VNI:                    true,
+			L3VNI:                    true,
+			RoCE:                     true,
+			MCLAG:                    true,
+			ESLAG:                    true,
+			ECMPRoCEQPN:              false,
+			SFlow:                    true,
 		},
 		NOSType:  meta.NOSTypeSONiCBCMBase,
 		Platform: "x86_64-dellemc_s5232f_c3538-r0",
@@ -17,14 +17,16 @@ var DellZ9332FON = wiringapi.SwitchProfile{
 		DisplayName:   "Dell Z9332F-ON",
 		SwitchSilicon: SiliconBroadcomTH3,
 		Features: wiringapi.SwitchProfileFeatures{
-			Subinterfaces: false,
-			ACLs:          true,
-			L2VNI:         false,
-			L3VNI:         false,
-			RoCE:          true,
-			MCLAG:         false,
-			ESLAG:         false,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            false,
+			PortChannelSubinterfaces: false,
+			ACLs:                     true,
+			L2VNI:                    false,
+			L3VNI:                    false,
+			RoCE:                     true,
+			MCLAG:                    false,
+			ESLAG:                    false,
+			ECMPRoCEQPN:              false,
+			SFlow:                    true,
 		},
 		NOSType:  meta.NOSTypeSONiCBCMBase,
 		Platform: "x86_64-dellemc_z9332f_d1508-r0",
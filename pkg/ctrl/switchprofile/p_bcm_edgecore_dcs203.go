@@ -18,14 +18,16 @@ var EdgecoreDCS203 = wiringapi.SwitchProfile{
 		OtherNames:    []string{"Edgecore AS7326-56X"},
 		SwitchSilicon: SiliconBroadcomTD3_X7_2_0T,
 		Features: wiringapi.SwitchProfileFeatures{
-			Subinterfaces: true,
-			ACLs:          true,
-			L2VNI:         true,
-			L3VNI:         true,
-			RoCE:          true,
-			MCLAG:         true,
-			ESLAG:         true,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            true,
+			PortChannelSubinterfaces: true,
+			ACLs:                     true,
+			L2VNI:                    true,
+			L3VNI:                    true,
+			RoCE:                     true,
+			MCLAG:                    true,
+			ESLAG:                    true,
+			ECMPRoCEQPN:              false,
+			SFlow:                    true,
 		},
 		NOSType:  meta.NOSTypeSONiCBCMBase,
 		Platform: "x86_64-accton_as7326_56x-r0",
@@ -18,14 +18,16 @@ var EdgecoreDCS240 = wiringapi.SwitchProfile{
 		OtherNames:    []string{"Edgecore AS9726"},
 		SwitchSilicon: SiliconBroadcomTD4,
 		Features: wiringapi.SwitchProfileFeatures{
-			Subinterfaces: true,
-			ACLs:          true,
-			L2VNI:         true,
-			L3VNI:         true,
-			RoCE:          true,
-			MCLAG:         false,
-			ESLAG:         true,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            true,
+			PortChannelSubinterfaces: true,
+			ACLs:                     true,
+			L2VNI:                    true,
+			L3VNI:                    true,
+			RoCE:                     true,
+			MCLAG:                    false,
+			ESLAG:                    true,
+			ECMPRoCEQPN:              false,
+			SFlow:                    true,
 		},
 		Notes:    "Upper 16 ports supply maximum of 24W, lower 16 ports supply maximum of 14W",
 		NOSType:  meta.NOSTypeSONiCBCMBase,
@@ -18,14 +18,16 @@ var EdgecoreDCS501 = wiringapi.SwitchProfile{
 		OtherNames:    []string{"Edgecore AS7712-32X"},
 		SwitchSilicon: SiliconBroadcomTH,
 		Features: wiringapi.SwitchProfileFeatures{
-			Subinterfaces: false,
-			ACLs:          true,
-			L2VNI:         false,
-			L3VNI:         false,
-			RoCE:          false,
-			MCLAG:         false,
-			ESLAG:         false,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            false,
+			PortChannelSubinterfaces: false,
+			ACLs:                     true,
+			L2VNI:                    false,
+			L3VNI:                    false,
+			RoCE:                     false,
+			MCLAG:                    false,
+			ESLAG:                    false,
+			ECMPRoCEQPN:              false,
+			SFlow:                    true,
 		},
 		NOSType:  meta.NOSTypeSONiCBCMBase,
 		Platform: "x86_64-accton_as7712_32x-r0",
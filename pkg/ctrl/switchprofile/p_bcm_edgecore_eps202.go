@@ -18,14 +18,16 @@ var EdgecoreEPS202 = wiringapi.SwitchProfile{
 		OtherNames:    []string{"Edgecore AS4630-54PE"},
 		SwitchSilicon: SiliconBroadcomTD3_X3,
 		Features: wiringapi.SwitchProfileFeatures{
-			Subinterfaces: false,
-			ACLs:          true,
-			L2VNI:         true,
-			L3VNI:         true,
-			RoCE:          false,
-			MCLAG:         false,
-			ESLAG:         true,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            false,
+			PortChannelSubinterfaces: false,
+			ACLs:                     true,
+			L2VNI:                    true,
+			L3VNI:                    true,
+			RoCE:                     false,
+			MCLAG:                    false,
+			ESLAG:                    true,
+			ECMPRoCEQPN:              false,
+			SFlow:                    true,
 		},
 		Notes:    "Doesn't support StaticExternals and ExternalAttachments with VLANs due to the lack of subinterfaces support.",
 		NOSType:  meta.NOSTypeSONiCBCMCampus,
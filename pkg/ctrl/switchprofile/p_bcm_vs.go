@@ -18,14 +18,15 @@ var VS = wiringapi.SwitchProfile{
 		DisplayName:   "Virtual Switch",
 		SwitchSilicon: SiliconVS,
 		Features: wiringapi.SwitchProfileFeatures{
-			Subinterfaces: true,
-			ACLs:          false,
-			L2VNI:         true,
-			L3VNI:         true,
-			RoCE:          true,
-			MCLAG:         true,
-			ESLAG:         true,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            true,
+			PortChannelSubinterfaces: true,
+			ACLs:                     false,
+			L2VNI:                    true,
+			L3VNI:                    true,
+			RoCE:                     true,
+			MCLAG:                    true,
+			ESLAG:                    true,
+			ECMPRoCEQPN:              false,
 		},
 		NOSType:  meta.NOSTypeSONiCBCMVS,
 		Platform: "x86_64-kvm_x86_64-r0",
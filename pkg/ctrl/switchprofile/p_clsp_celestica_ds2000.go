@@ -29,14 +29,15 @@ var CLSPCelesticaDS2000 = wiringapi.SwitchProfile{
 		OtherNames:    []string{"Celestica Questone 2a"},
 		SwitchSilicon: SiliconBroadcomTD3_X5,
 		Features: wiringapi.SwitchProfileFeatures{ // TODO update
-			Subinterfaces: true,
-			ACLs:          true,
-			L2VNI:         true,
-			L3VNI:         true,
-			RoCE:          false,
-			MCLAG:         true,
-			ESLAG:         true,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            true,
+			PortChannelSubinterfaces: true,
+			ACLs:                     true,
+			L2VNI:                    true,
+			L3VNI:                    true,
+			RoCE:                     false,
+			MCLAG:                    true,
+			ESLAG:                    true,
+			ECMPRoCEQPN:              false,
 		},
 		NOSType:  meta.NOSTypeSONiCCLSPlusBroadcom,
 		Platform: "x86_64-cel_ds2000-r0", // TODO get rid of or update
@@ -18,14 +18,15 @@ var CLSPCelesticaDS3000 = wiringapi.SwitchProfile{
 		OtherNames:    []string{"Celestica Seastone2"},
 		SwitchSilicon: SiliconBroadcomTD3_X7_3_2T,
 		Features: wiringapi.SwitchProfileFeatures{ // TODO update
-			Subinterfaces: true,
-			ACLs:          true,
-			L2VNI:         true,
-			L3VNI:         true,
-			RoCE:          true,
-			MCLAG:         true,
-			ESLAG:         true,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            true,
+			PortChannelSubinterfaces: true,
+			ACLs:                     true,
+			L2VNI:                    true,
+			L3VNI:                    true,
+			RoCE:                     true,
+			MCLAG:                    true,
+			ESLAG:                    true,
+			ECMPRoCEQPN:              false,
 		},
 		NOSType:  meta.NOSTypeSONiCCLSPlusBroadcom,
 		Platform: "x86_64-cel_seastone_2-r0", // TODO get rid of or update
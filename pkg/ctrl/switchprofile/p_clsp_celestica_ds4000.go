@@ -18,14 +18,15 @@ var CLSPCelesticaDS4000 = wiringapi.SwitchProfile{
 		OtherNames:    []string{"Celestica Silverstone2"},
 		SwitchSilicon: SiliconBroadcomTH3,
 		Features: wiringapi.SwitchProfileFeatures{ // TODO update
-			Subinterfaces: false,
-			ACLs:          true,
-			L2VNI:         false,
-			L3VNI:         false,
-			RoCE:          true,
-			MCLAG:         false,
-			ESLAG:         false,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            false,
+			PortChannelSubinterfaces: false,
+			ACLs:                     true,
+			L2VNI:                    false,
+			L3VNI:                    false,
+			RoCE:                     true,
+			MCLAG:                    false,
+			ESLAG:                    false,
+			ECMPRoCEQPN:              false,
 		},
 		NOSType:  meta.NOSTypeSONiCCLSPlusBroadcom,
 		Platform: "x86_64-cel_silverstone-r0", // TODO get rid of or update
@@ -18,14 +18,15 @@ var CLSPCelesticaDS4101 = wiringapi.SwitchProfile{
 		OtherNames:    []string{"Celestica Greystone"},
 		SwitchSilicon: SiliconBroadcomTH4G,
 		Features: wiringapi.SwitchProfileFeatures{ // TODO update
-			Subinterfaces: false,
-			ACLs:          true,
-			L2VNI:         false,
-			L3VNI:         false,
-			RoCE:          true,
-			MCLAG:         false,
-			ESLAG:         false,
-			ECMPRoCEQPN:   true,
+			Subinterfaces:            false,
+			PortChannelSubinterfaces: false,
+			ACLs:                     true,
+			L2VNI:                    false,
+			L3VNI:                    false,
+			RoCE:                     true,
+			MCLAG:                    false,
+			ESLAG:                    false,
+			ECMPRoCEQPN:              true,
 		},
 		NOSType:  meta.NOSTypeSONiCCLSPlusBroadcom,
 		Platform: "x86_64-cel_ds4101-r0", // TODO get rid of or update
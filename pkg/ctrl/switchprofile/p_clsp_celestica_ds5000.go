@@ -18,14 +18,15 @@ var CLSPCelesticaDS5000 = wiringapi.SwitchProfile{
 		OtherNames:    []string{"Celestica Moonstone"},
 		SwitchSilicon: SiliconBroadcomTH5,
 		Features: wiringapi.SwitchProfileFeatures{ // TODO update
-			Subinterfaces: true,
-			ACLs:          true,
-			L2VNI:         false,
-			L3VNI:         true,
-			RoCE:          true,
-			MCLAG:         false,
-			ESLAG:         false,
-			ECMPRoCEQPN:   true,
+			Subinterfaces:            true,
+			PortChannelSubinterfaces: true,
+			ACLs:                     true,
+			L2VNI:                    false,
+			L3VNI:                    true,
+			RoCE:                     true,
+			MCLAG:                    false,
+			ESLAG:                    false,
+			ECMPRoCEQPN:              true,
 		},
 		Notes:    "Doesn't support non-L3 VPC modes due to the lack of L2VNI support.", // TODO get rid of or update
 		NOSType:  meta.NOSTypeSONiCCLSPlusBroadcom,
@@ -18,14 +18,15 @@ var CLSPVS = wiringapi.SwitchProfile{
 		DisplayName:   "Virtual Switch CLS+",
 		SwitchSilicon: SiliconVS,
 		Features: wiringapi.SwitchProfileFeatures{
-			Subinterfaces: true,
-			ACLs:          false,
-			L2VNI:         true,
-			L3VNI:         true,
-			RoCE:          true,
-			MCLAG:         true,
-			ESLAG:         true,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            true,
+			PortChannelSubinterfaces: true,
+			ACLs:                     false,
+			L2VNI:                    true,
+			L3VNI:                    true,
+			RoCE:                     true,
+			MCLAG:                    true,
+			ESLAG:                    true,
+			ECMPRoCEQPN:              false,
 		},
 		NOSType:  meta.NOSTypeSONiCCLSPlusVS,
 		Platform: "x86_64-kvm_x86_64-r0",
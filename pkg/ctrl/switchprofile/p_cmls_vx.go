@@ -17,14 +17,15 @@ var CumulusVX = wiringapi.SwitchProfile{
 		DisplayName:   "Cumulus VX",
 		SwitchSilicon: SiliconVS,
 		Features: wiringapi.SwitchProfileFeatures{
-			Subinterfaces: true,
-			ACLs:          false,
-			L2VNI:         true,
-			L3VNI:         true,
-			RoCE:          true,
-			MCLAG:         true,
-			ESLAG:         true,
-			ECMPRoCEQPN:   false,
+			Subinterfaces:            true,
+			PortChannelSubinterfaces: true,
+			ACLs:                     false,
+			L2VNI:                    true,
+			L3VNI:                    true,
+			RoCE:                     true,
+			MCLAG:                    true,
+			ESLAG:                    true,
+			ECMPRoCEQPN:              false,
 		},
 		NOSType:  meta.NOSTypeCumulusVX,
 		Platform: "x86_64-kvm_x86_64-r0",
@@ -28,10 +28,12 @@ import (
 	kapierrors "k8s.io/apimachinery/pkg/api/errors"
 	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	kctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -58,7 +60,7 @@ func SetupVPCReconcilerWith(mgr kctrl.Manager, cfg *meta.FabricConfig, libMngr *
 	// TODO only enqueue related VPCs
 	return errors.Wrapf(kctrl.NewControllerManagedBy(mgr).
 		Named("VPC").
-		For(&vpcapi.VPC{}).
+		For(&vpcapi.VPC{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		// It's enough to trigger just a single VPC update in this case as it'll update DHCP config for all VPCs
 		Watches(&wiringapi.Switch{}, handler.EnqueueRequestsFromMapFunc(r.enqueueOneVPC)).
 		Complete(r), "failed to setup vpc controller")
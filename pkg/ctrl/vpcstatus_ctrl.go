@@ -0,0 +1,324 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	dhcpapi "go.githedgehog.com/fabric/api/dhcp/v1beta1"
+	vpcapi "go.githedgehog.com/fabric/api/vpc/v1beta1"
+	"go.githedgehog.com/fabric/pkg/manager/librarian"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kapierrors "k8s.io/apimachinery/pkg/api/errors"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	kctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// +kubebuilder:rbac:groups=vpc.githedgehog.com,resources=vpcs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=vpc.githedgehog.com,resources=vpcs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=dhcp.githedgehog.com,resources=dhcpsubnets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=agent.githedgehog.com,resources=catalogs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=agent.githedgehog.com,resources=agents,verbs=get;list;watch
+
+// VPCStatusReconciler only reports the VPC status (VNIs, switches, DHCP usage and conditions). It's separate from the
+// VPCReconciler so agent applies and DHCP leases don't trigger the VNIs catalog and DHCP subnets updates.
+type VPCStatusReconciler struct {
+	kclient.Client
+	libr *librarian.Manager
+}
+
+func SetupVPCStatusReconcilerWith(mgr kctrl.Manager, libMngr *librarian.Manager) error {
+	r := &VPCStatusReconciler{
+		Client: mgr.GetClient(),
+		libr:   libMngr,
+	}
+
+	return errors.Wrapf(kctrl.NewControllerManagedBy(mgr).
+		Named("VPCStatus").
+		For(&vpcapi.VPC{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&agentapi.Catalog{}, handler.EnqueueRequestsFromMapFunc(r.enqueueByVNIsCatalog)).
+		Watches(&agentapi.Agent{}, enqueueOldAndNew(enqueueByAgentVPCs), builder.WithPredicates(agentAppliedChangedPredicate)).
+		Watches(&dhcpapi.DHCPSubnet{}, handler.EnqueueRequestsFromMapFunc(r.enqueueByDHCPSubnetLabel), builder.WithPredicates(dhcpSubnetUsageChangedPredicate)).
+		Complete(r), "failed to setup vpc status controller")
+}
+
+// enqueueOldAndNew is like handler.EnqueueRequestsFromMapFunc but on updates it maps both the old and the new object,
+// so the objects that are no longer referenced by the updated one are reconciled as well
+func enqueueOldAndNew(mapFn func(obj kclient.Object) []reconcile.Request) handler.Funcs {
+	add := func(q workqueue.TypedRateLimitingInterface[reconcile.Request], objs ...kclient.Object) {
+		for _, obj := range objs {
+			for _, req := range mapFn(obj) {
+				q.Add(req)
+			}
+		}
+	}
+
+	return handler.Funcs{
+		CreateFunc: func(_ context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			add(q, e.Object)
+		},
+		UpdateFunc: func(_ context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			add(q, e.ObjectOld, e.ObjectNew)
+		},
+		DeleteFunc: func(_ context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			add(q, e.Object)
+		},
+		GenericFunc: func(_ context.Context, e event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			add(q, e.Object)
+		},
+	}
+}
+
+func (r *VPCStatusReconciler) enqueueByVNIsCatalog(_ context.Context, obj kclient.Object) []reconcile.Request {
+	res := []reconcile.Request{}
+
+	cat, ok := obj.(*agentapi.Catalog)
+	if !ok || cat.Name != librarian.CatVNIs || cat.Namespace != librarian.Namespace {
+		return res
+	}
+
+	for vpcName := range cat.Spec.VPCVNIs {
+		res = append(res, reconcile.Request{
+			NamespacedName: kclient.ObjectKey{Name: vpcName, Namespace: librarian.Namespace},
+		})
+	}
+
+	return res
+}
+
+// agentAppliedChangedPredicate filters out agent heartbeats and only passes agent spec changes and config applies
+var agentAppliedChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldAgent, okOld := e.ObjectOld.(*agentapi.Agent)
+		newAgent, okNew := e.ObjectNew.(*agentapi.Agent)
+		if !okOld || !okNew {
+			return true
+		}
+
+		return oldAgent.Generation != newAgent.Generation || oldAgent.Status.LastAppliedGen != newAgent.Status.LastAppliedGen
+	},
+}
+
+func enqueueByAgentVPCs(obj kclient.Object) []reconcile.Request {
+	res := []reconcile.Request{}
+
+	agent, ok := obj.(*agentapi.Agent)
+	if !ok {
+		return res
+	}
+
+	for vpcName := range agent.Spec.VPCs {
+		res = append(res, reconcile.Request{
+			NamespacedName: kclient.ObjectKey{Name: vpcName, Namespace: agent.Namespace},
+		})
+	}
+
+	return res
+}
+
+// dhcpSubnetUsageChangedPredicate filters out lease renewals and only passes DHCP range and allocations count changes
+var dhcpSubnetUsageChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldSubnet, okOld := e.ObjectOld.(*dhcpapi.DHCPSubnet)
+		newSubnet, okNew := e.ObjectNew.(*dhcpapi.DHCPSubnet)
+		if !okOld || !okNew {
+			return true
+		}
+
+		return oldSubnet.Labels[vpcapi.LabelVPC] != newSubnet.Labels[vpcapi.LabelVPC] ||
+			oldSubnet.Labels[vpcapi.LabelSubnet] != newSubnet.Labels[vpcapi.LabelSubnet] ||
+			oldSubnet.Spec.StartIP != newSubnet.Spec.StartIP || oldSubnet.Spec.EndIP != newSubnet.Spec.EndIP ||
+			dhcpAllocatedCount(oldSubnet) != dhcpAllocatedCount(newSubnet)
+	},
+}
+
+func (r *VPCStatusReconciler) enqueueByDHCPSubnetLabel(_ context.Context, obj kclient.Object) []reconcile.Request {
+	res := []reconcile.Request{}
+
+	if vpcName, exists := obj.GetLabels()[vpcapi.LabelVPC]; exists && vpcName != "" {
+		res = append(res, reconcile.Request{
+			NamespacedName: kclient.ObjectKey{Name: vpcName, Namespace: obj.GetNamespace()},
+		})
+	}
+
+	return res
+}
+
+func (r *VPCStatusReconciler) Reconcile(ctx context.Context, req kctrl.Request) (kctrl.Result, error) {
+	vpc := &vpcapi.VPC{}
+	if err := r.Get(ctx, req.NamespacedName, vpc); err != nil {
+		if kapierrors.IsNotFound(err) {
+			return kctrl.Result{}, nil
+		}
+
+		return kctrl.Result{}, errors.Wrapf(err, "error getting vpc %s", req.NamespacedName)
+	}
+
+	if vpc.DeletionTimestamp != nil {
+		return kctrl.Result{}, nil
+	}
+
+	if err := r.updateStatus(ctx, vpc); err != nil {
+		return kctrl.Result{}, errors.Wrapf(err, "error updating vpc status")
+	}
+
+	return kctrl.Result{}, nil
+}
+
+func (r *VPCStatusReconciler) updateStatus(ctx context.Context, vpc *vpcapi.VPC) error {
+	status := vpcapi.VPCStatus{
+		Switches:   []string{},
+		Subnets:    map[string]vpcapi.VPCSubnetStatus{},
+		Conditions: slices.Clone(vpc.Status.Conditions),
+	}
+
+	var err error
+	status.VNI, status.SubnetVNIs, err = r.libr.GetVPCVNIs(ctx, r.Client, vpc.Name)
+	if err != nil {
+		return errors.Wrapf(err, "error getting vpc vnis")
+	}
+
+	dhcpSubnets := &dhcpapi.DHCPSubnetList{}
+	if err := r.List(ctx, dhcpSubnets, kclient.InNamespace(vpc.Namespace), kclient.MatchingLabels{vpcapi.LabelVPC: vpc.Name}); err != nil {
+		return errors.Wrapf(err, "error listing dhcp subnets")
+	}
+
+	for _, dhcp := range dhcpSubnets.Items {
+		subnetName := dhcp.Labels[vpcapi.LabelSubnet]
+		if _, exists := vpc.Spec.Subnets[subnetName]; !exists {
+			continue
+		}
+
+		status.Subnets[subnetName] = vpcapi.VPCSubnetStatus{
+			DHCPAllocated: dhcpAllocatedCount(&dhcp),
+			DHCPTotal:     dhcpRangeSize(dhcp.Spec.StartIP, dhcp.Spec.EndIP),
+		}
+	}
+
+	agents := &agentapi.AgentList{}
+	if err := r.List(ctx, agents, kclient.InNamespace(vpc.Namespace)); err != nil {
+		return errors.Wrapf(err, "error listing agents")
+	}
+
+	pending := []string{}
+	for _, agent := range agents.Items {
+		if _, exists := agent.Spec.VPCs[vpc.Name]; !exists {
+			continue
+		}
+
+		status.Switches = append(status.Switches, agent.Name)
+		if agent.Status.LastAppliedGen != agent.Generation {
+			pending = append(pending, agent.Name)
+		}
+	}
+	slices.Sort(status.Switches)
+	slices.Sort(pending)
+
+	setVPCConditions(&status.Conditions, status.Switches, pending)
+
+	if equality.Semantic.DeepEqual(vpc.Status, status) {
+		return nil
+	}
+
+	vpc.Status = status
+	if err := r.Status().Update(ctx, vpc); err != nil {
+		return errors.Wrapf(err, "error updating vpc status")
+	}
+
+	return nil
+}
+
+func setVPCConditions(conditions *[]kmetav1.Condition, switches, pending []string) {
+	ready := kmetav1.Condition{
+		Type:    vpcapi.VPCConditionReady,
+		Status:  kmetav1.ConditionTrue,
+		Reason:  "Applied",
+		Message: fmt.Sprintf("Config applied on all switches: %s", strings.Join(switches, ", ")),
+	}
+	degraded := kmetav1.Condition{
+		Type:    vpcapi.VPCConditionDegraded,
+		Status:  kmetav1.ConditionFalse,
+		Reason:  "Applied",
+		Message: "No switches with pending config",
+	}
+
+	switch {
+	case len(switches) == 0:
+		ready.Status = kmetav1.ConditionFalse
+		ready.Reason = "NoSwitches"
+		ready.Message = "VPC isn't configured on any switch"
+		degraded.Reason = "NoSwitches"
+		degraded.Message = "VPC isn't configured on any switch"
+	case len(pending) == len(switches):
+		ready.Status = kmetav1.ConditionFalse
+		ready.Reason = "ApplyPending"
+		ready.Message = fmt.Sprintf("Config not yet applied on switches: %s", strings.Join(pending, ", "))
+		degraded.Reason = "ApplyPending"
+		degraded.Message = ready.Message
+	case len(pending) > 0:
+		ready.Status = kmetav1.ConditionFalse
+		ready.Reason = "PartiallyApplied"
+		ready.Message = fmt.Sprintf("Config not yet applied on switches: %s", strings.Join(pending, ", "))
+		degraded.Status = kmetav1.ConditionTrue
+		degraded.Reason = "PartiallyApplied"
+		degraded.Message = ready.Message
+	}
+
+	kmeta.SetStatusCondition(conditions, ready)
+	kmeta.SetStatusCondition(conditions, degraded)
+}
+
+// dhcpAllocatedCount returns the number of IPs leased by the DHCP server, not counting the offers in progress
+func dhcpAllocatedCount(dhcp *dhcpapi.DHCPSubnet) uint32 {
+	allocated := uint32(0)
+	for _, lease := range dhcp.Status.Allocated {
+		if !lease.Discover {
+			allocated++
+		}
+	}
+
+	return allocated
+}
+
+// dhcpRangeSize returns the number of IPs in the inclusive DHCP range (capped at MaxUint32 for the large IPv6 ranges)
+// or 0 if the range isn't valid
+func dhcpRangeSize(start, end string) uint32 {
+	startIP, err := netip.ParseAddr(start)
+	if err != nil {
+		return 0
+	}
+	endIP, err := netip.ParseAddr(end)
+	if err != nil {
+		return 0
+	}
+
+	startIP, endIP = startIP.Unmap().WithZone(""), endIP.Unmap().WithZone("")
+	if startIP.Is4() != endIP.Is4() || endIP.Less(startIP) {
+		return 0
+	}
+
+	size := new(big.Int).Sub(new(big.Int).SetBytes(endIP.AsSlice()), new(big.Int).SetBytes(startIP.AsSlice()))
+	size.Add(size, big.NewInt(1))
+	if !size.IsUint64() || size.Uint64() > math.MaxUint32 {
+		return math.MaxUint32
+	}
+
+	return uint32(size.Uint64())
+}
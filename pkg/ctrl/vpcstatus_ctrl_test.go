@@ -0,0 +1,196 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package ctrl
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	dhcpapi "go.githedgehog.com/fabric/api/dhcp/v1beta1"
+	vpcapi "go.githedgehog.com/fabric/api/vpc/v1beta1"
+	"go.githedgehog.com/fabric/pkg/manager/librarian"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestDHCPRangeSize(t *testing.T) {
+	for _, tt := range []struct {
+		start    string
+		end      string
+		expected uint32
+	}{
+		{"10.0.0.10", "10.0.0.10", 1},
+		{"10.0.0.10", "10.0.0.254", 245},
+		{"10.0.0.250", "10.0.1.4", 11},
+		{"10.0.0.10", "10.0.0.9", 0},
+		{"", "10.0.0.9", 0},
+		{"10.0.0.10", "invalid", 0},
+		{"fd00::10", "fd00::20", 17},
+		{"fd00::", "fd00::ffff:ffff:ffff", math.MaxUint32},
+		{"10.0.0.10", "fd00::20", 0},
+	} {
+		t.Run(tt.start+"-"+tt.end, func(t *testing.T) {
+			require.Equal(t, tt.expected, dhcpRangeSize(tt.start, tt.end))
+		})
+	}
+}
+
+func TestSetVPCConditions(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		switches []string
+		pending  []string
+		ready    kmetav1.ConditionStatus
+		degraded kmetav1.ConditionStatus
+		reason   string
+	}{
+		{"no-switches", nil, nil, kmetav1.ConditionFalse, kmetav1.ConditionFalse, "NoSwitches"},
+		{"all-applied", []string{"leaf-1", "leaf-2"}, nil, kmetav1.ConditionTrue, kmetav1.ConditionFalse, "Applied"},
+		{"all-pending", []string{"leaf-1", "leaf-2"}, []string{"leaf-1", "leaf-2"}, kmetav1.ConditionFalse, kmetav1.ConditionFalse, "ApplyPending"},
+		{"partially-applied", []string{"leaf-1", "leaf-2"}, []string{"leaf-2"}, kmetav1.ConditionFalse, kmetav1.ConditionTrue, "PartiallyApplied"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions := []kmetav1.Condition{}
+			setVPCConditions(&conditions, tt.switches, tt.pending)
+
+			ready := kmeta.FindStatusCondition(conditions, vpcapi.VPCConditionReady)
+			require.NotNil(t, ready)
+			require.Equal(t, tt.ready, ready.Status)
+			require.Equal(t, tt.reason, ready.Reason)
+
+			degraded := kmeta.FindStatusCondition(conditions, vpcapi.VPCConditionDegraded)
+			require.NotNil(t, degraded)
+			require.Equal(t, tt.degraded, degraded.Status)
+			require.Equal(t, tt.reason, degraded.Reason)
+		})
+	}
+}
+
+func TestVPCStatusUpdate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, agentapi.AddToScheme(scheme))
+	require.NoError(t, dhcpapi.AddToScheme(scheme))
+	require.NoError(t, vpcapi.AddToScheme(scheme))
+
+	ns := kmetav1.NamespaceDefault
+	vpc := &vpcapi.VPC{
+		ObjectMeta: kmetav1.ObjectMeta{Name: "vpc-1", Namespace: ns},
+		Spec: vpcapi.VPCSpec{
+			Subnets: map[string]*vpcapi.VPCSubnet{
+				"subnet-1": {Subnet: "10.0.1.0/24"},
+			},
+		},
+	}
+	catalog := &agentapi.Catalog{
+		ObjectMeta: kmetav1.ObjectMeta{Name: librarian.CatVNIs, Namespace: librarian.Namespace},
+		Spec: agentapi.CatalogSpec{
+			VPCVNIs:       map[string]uint32{"vpc-1": 100},
+			VPCSubnetVNIs: map[string]map[string]uint32{"vpc-1": {"subnet-1": 101}},
+		},
+	}
+	dhcp := &dhcpapi.DHCPSubnet{
+		ObjectMeta: kmetav1.ObjectMeta{Name: "vpc-1--subnet-1", Namespace: ns, Labels: map[string]string{
+			vpcapi.LabelVPC:    "vpc-1",
+			vpcapi.LabelSubnet: "subnet-1",
+		}},
+		Spec: dhcpapi.DHCPSubnetSpec{StartIP: "10.0.1.10", EndIP: "10.0.1.19"},
+		Status: dhcpapi.DHCPSubnetStatus{Allocated: map[string]dhcpapi.DHCPAllocated{
+			"aa:aa:aa:aa:aa:01": {IP: "10.0.1.10"},
+			"aa:aa:aa:aa:aa:02": {IP: "10.0.1.11"},
+			"aa:aa:aa:aa:aa:03": {IP: "10.0.1.12", Discover: true},
+		}},
+	}
+	applied := &agentapi.Agent{
+		ObjectMeta: kmetav1.ObjectMeta{Name: "leaf-1", Namespace: ns, Generation: 2},
+		Spec:       agentapi.AgentSpec{VPCs: map[string]vpcapi.VPCSpec{"vpc-1": {}}},
+		Status:     agentapi.AgentStatus{LastAppliedGen: 2},
+	}
+	pending := &agentapi.Agent{
+		ObjectMeta: kmetav1.ObjectMeta{Name: "leaf-2", Namespace: ns, Generation: 3},
+		Spec:       agentapi.AgentSpec{VPCs: map[string]vpcapi.VPCSpec{"vpc-1": {}}},
+		Status:     agentapi.AgentStatus{LastAppliedGen: 2},
+	}
+	other := &agentapi.Agent{
+		ObjectMeta: kmetav1.ObjectMeta{Name: "leaf-3", Namespace: ns, Generation: 1},
+	}
+
+	kube := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(vpc, catalog, dhcp, applied, pending, other).
+		WithStatusSubresource(&vpcapi.VPC{}).
+		Build()
+	r := &VPCStatusReconciler{Client: kube, libr: librarian.NewManager(nil)}
+
+	_, err := r.Reconcile(t.Context(), reconcile.Request{NamespacedName: kclient.ObjectKeyFromObject(vpc)})
+	require.NoError(t, err)
+
+	updated := &vpcapi.VPC{}
+	require.NoError(t, kube.Get(t.Context(), kclient.ObjectKeyFromObject(vpc), updated))
+	require.Equal(t, uint32(100), updated.Status.VNI)
+	require.Equal(t, map[string]uint32{"subnet-1": 101}, updated.Status.SubnetVNIs)
+	require.Equal(t, []string{"leaf-1", "leaf-2"}, updated.Status.Switches)
+	require.Equal(t, map[string]vpcapi.VPCSubnetStatus{"subnet-1": {DHCPAllocated: 2, DHCPTotal: 10}}, updated.Status.Subnets)
+
+	ready := kmeta.FindStatusCondition(updated.Status.Conditions, vpcapi.VPCConditionReady)
+	require.NotNil(t, ready)
+	require.Equal(t, kmetav1.ConditionFalse, ready.Status)
+	require.Equal(t, "PartiallyApplied", ready.Reason)
+
+	// once the pending switch applies the config and the VPC is removed from the other one, the VPC becomes ready
+	pending.Status.LastAppliedGen = 3
+	require.NoError(t, kube.Update(t.Context(), pending))
+	applied.Spec.VPCs = nil
+	require.NoError(t, kube.Update(t.Context(), applied))
+
+	_, err = r.Reconcile(t.Context(), reconcile.Request{NamespacedName: kclient.ObjectKeyFromObject(vpc)})
+	require.NoError(t, err)
+
+	require.NoError(t, kube.Get(t.Context(), kclient.ObjectKeyFromObject(vpc), updated))
+	require.Equal(t, []string{"leaf-2"}, updated.Status.Switches)
+
+	ready = kmeta.FindStatusCondition(updated.Status.Conditions, vpcapi.VPCConditionReady)
+	require.NotNil(t, ready)
+	require.Equal(t, kmetav1.ConditionTrue, ready.Status)
+	require.Equal(t, "Applied", ready.Reason)
+}
+
+func TestEnqueueByAgentVPCsOnRemoval(t *testing.T) {
+	oldAgent := &agentapi.Agent{
+		ObjectMeta: kmetav1.ObjectMeta{Name: "leaf-1", Namespace: kmetav1.NamespaceDefault},
+		Spec:       agentapi.AgentSpec{VPCs: map[string]vpcapi.VPCSpec{"vpc-1": {}, "vpc-2": {}}},
+	}
+	newAgent := oldAgent.DeepCopy()
+	delete(newAgent.Spec.VPCs, "vpc-2")
+
+	q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer q.ShutDown()
+
+	enqueueOldAndNew(enqueueByAgentVPCs).Update(t.Context(), event.UpdateEvent{ObjectOld: oldAgent, ObjectNew: newAgent}, q)
+
+	require.Equal(t, 2, q.Len())
+}
+
+func TestDHCPSubnetUsageChangedPredicate(t *testing.T) {
+	old := &dhcpapi.DHCPSubnet{
+		Spec: dhcpapi.DHCPSubnetSpec{StartIP: "10.0.1.10", EndIP: "10.0.1.19"},
+		Status: dhcpapi.DHCPSubnetStatus{Allocated: map[string]dhcpapi.DHCPAllocated{
+			"aa:aa:aa:aa:aa:01": {IP: "10.0.1.10"},
+		}},
+	}
+
+	renewed := old.DeepCopy()
+	renewed.Status.Allocated["aa:aa:aa:aa:aa:01"] = dhcpapi.DHCPAllocated{IP: "10.0.1.10", Expiry: kmetav1.Now()}
+	require.False(t, dhcpSubnetUsageChangedPredicate.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: renewed}))
+
+	allocated := old.DeepCopy()
+	allocated.Status.Allocated["aa:aa:aa:aa:aa:02"] = dhcpapi.DHCPAllocated{IP: "10.0.1.11"}
+	require.True(t, dhcpSubnetUsageChangedPredicate.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: allocated}))
+}
@@ -159,6 +159,7 @@ func GenerateProfilesRef(ctx context.Context, targetDir string) error {
 
 		resCatalog += "**Supported features:**\n\n"
 		resCatalog += "- Subinterfaces: " + strconv.FormatBool(sp.Spec.Features.Subinterfaces) + "\n"
+		resCatalog += "- Port channel subinterfaces: " + strconv.FormatBool(sp.Spec.Features.PortChannelSubinterfaces) + "\n"
 		resCatalog += "- ACLs: " + strconv.FormatBool(sp.Spec.Features.ACLs) + "\n"
 		resCatalog += "- L2VNI: " + strconv.FormatBool(sp.Spec.Features.L2VNI) + "\n"
 		resCatalog += "- L3VNI: " + strconv.FormatBool(sp.Spec.Features.L3VNI) + "\n"
@@ -166,6 +167,7 @@ func GenerateProfilesRef(ctx context.Context, targetDir string) error {
 		resCatalog += "- MCLAG: " + strconv.FormatBool(sp.Spec.Features.MCLAG) + "\n"
 		resCatalog += "- ESLAG: " + strconv.FormatBool(sp.Spec.Features.ESLAG) + "\n"
 		resCatalog += "- ECMP RoCE QPN hashing: " + strconv.FormatBool(sp.Spec.Features.ECMPRoCEQPN) + "\n"
+		resCatalog += "- sFlow: " + strconv.FormatBool(sp.Spec.Features.SFlow) + "\n"
 		resCatalog += "\n"
 
 		if sp.Spec.MaxPorts > 0 && len(sp.Spec.Pipelines) > 0 {
@@ -268,7 +270,9 @@ func generateFeatureMatrix(sps []*wiringapi.SwitchProfile) string {
 	featuresType := reflect.TypeOf(wiringapi.SwitchProfileFeatures{})
 
 	fieldDisplayNames := map[string]string{
-		"ECMPRoCEQPN": "QPN",
+		"ECMPRoCEQPN":              "QPN",
+		"SFlow":                    "sFlow",
+		"PortChannelSubinterfaces": "PC Subinterfaces",
 	}
 
 	var fieldNames []string
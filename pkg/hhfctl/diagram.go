@@ -0,0 +1,802 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package hhfctl
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
+	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
+	vpcapi "go.githedgehog.com/fabric/api/vpc/v1beta1"
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	"go.githedgehog.com/fabric/pkg/util/kubeutil"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	kyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+type WiringDiagramFormat string
+
+const (
+	WiringDiagramFormatDOT     WiringDiagramFormat = "dot"
+	WiringDiagramFormatMermaid WiringDiagramFormat = "mermaid"
+	WiringDiagramFormatDrawio  WiringDiagramFormat = "drawio"
+)
+
+var WiringDiagramFormats = []WiringDiagramFormat{
+	WiringDiagramFormatDOT,
+	WiringDiagramFormatMermaid,
+	WiringDiagramFormatDrawio,
+}
+
+type WiringDiagramOptions struct {
+	// Format is the diagram format to render
+	Format WiringDiagramFormat
+	// Input is the wiring YAML file to render the diagram from, objects are loaded from the cluster if empty
+	Input string
+	// Output is the file to write the diagram to, stdout is used if empty or "-"
+	Output string
+	// Live enables coloring the links by the LLDP and oper status reported by the agents, agents are loaded from the
+	// cluster or from the input file if specified
+	Live bool
+}
+
+func WiringDiagram(ctx context.Context, opts WiringDiagramOptions) error {
+	if !slices.Contains(WiringDiagramFormats, opts.Format) {
+		return fmt.Errorf("unsupported diagram format %q", opts.Format) //nolint:goerr113
+	}
+
+	var data *wiringDiagramData
+	var err error
+	if opts.Input != "" {
+		data, err = loadWiringDiagramFile(opts.Input)
+	} else {
+		data, err = loadWiringDiagramCluster(ctx, opts.Live)
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.Live && len(data.Agents) == 0 {
+		slog.Warn("No agents found, links will not be colored by the live status")
+	}
+
+	d := buildWiringDiagram(data, opts.Live)
+
+	buf := &bytes.Buffer{}
+	switch opts.Format {
+	case WiringDiagramFormatDOT:
+		err = renderWiringDiagramDOT(buf, d)
+	case WiringDiagramFormatMermaid:
+		err = renderWiringDiagramMermaid(buf, d)
+	case WiringDiagramFormatDrawio:
+		err = renderWiringDiagramDrawio(buf, d)
+	}
+	if err != nil {
+		return fmt.Errorf("rendering %s diagram: %w", opts.Format, err)
+	}
+
+	if opts.Output == "" || opts.Output == "-" {
+		if _, err := io.Copy(os.Stdout, buf); err != nil {
+			return fmt.Errorf("writing diagram: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := os.WriteFile(opts.Output, buf.Bytes(), 0o644); err != nil { //nolint:gosec
+		return fmt.Errorf("writing diagram to %s: %w", opts.Output, err)
+	}
+
+	slog.Info("Wiring diagram saved", "format", opts.Format, "file", opts.Output)
+
+	return nil
+}
+
+type wiringDiagramData struct {
+	Switches    []wiringapi.Switch
+	Servers     []wiringapi.Server
+	Connections []wiringapi.Connection
+	Agents      map[string]*agentapi.Agent
+}
+
+func loadWiringDiagramCluster(ctx context.Context, live bool) (*wiringDiagramData, error) {
+	kube, err := kubeutil.NewClient(ctx, "", wiringapi.AddToScheme, agentapi.AddToScheme)
+	if err != nil {
+		return nil, fmt.Errorf("creating kube client: %w", err)
+	}
+
+	switches := &wiringapi.SwitchList{}
+	if err := kube.List(ctx, switches); err != nil {
+		return nil, fmt.Errorf("listing switches: %w", err)
+	}
+
+	servers := &wiringapi.ServerList{}
+	if err := kube.List(ctx, servers); err != nil {
+		return nil, fmt.Errorf("listing servers: %w", err)
+	}
+
+	conns := &wiringapi.ConnectionList{}
+	if err := kube.List(ctx, conns); err != nil {
+		return nil, fmt.Errorf("listing connections: %w", err)
+	}
+
+	data := &wiringDiagramData{
+		Switches:    switches.Items,
+		Servers:     servers.Items,
+		Connections: conns.Items,
+		Agents:      map[string]*agentapi.Agent{},
+	}
+
+	if live {
+		agents := &agentapi.AgentList{}
+		if err := kube.List(ctx, agents); err != nil {
+			return nil, fmt.Errorf("listing agents: %w", err)
+		}
+
+		for idx := range agents.Items {
+			data.Agents[agents.Items[idx].Name] = &agents.Items[idx]
+		}
+	}
+
+	return data, nil
+}
+
+func loadWiringDiagramFile(path string) (*wiringDiagramData, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening wiring file: %w", err)
+	}
+	defer f.Close()
+
+	data, err := loadWiringDiagramData(f)
+	if err != nil {
+		return nil, fmt.Errorf("loading wiring file %s: %w", path, err)
+	}
+
+	return data, nil
+}
+
+func loadWiringDiagramData(r io.Reader) (*wiringDiagramData, error) {
+	scheme, err := kubeutil.NewScheme(wiringapi.AddToScheme, vpcapi.AddToScheme, agentapi.AddToScheme)
+	if err != nil {
+		return nil, fmt.Errorf("creating scheme: %w", err)
+	}
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+	data := &wiringDiagramData{
+		Agents: map[string]*agentapi.Agent{},
+	}
+
+	reader := kyaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading yaml document: %w", err)
+		}
+
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		obj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("decoding object: %w", err)
+		}
+
+		switch obj := obj.(type) {
+		case *wiringapi.Switch:
+			data.Switches = append(data.Switches, *obj)
+		case *wiringapi.Server:
+			data.Servers = append(data.Servers, *obj)
+		case *wiringapi.Connection:
+			data.Connections = append(data.Connections, *obj)
+		case *agentapi.Agent:
+			data.Agents[obj.Name] = obj
+		}
+	}
+
+	return data, nil
+}
+
+type diagramNodeKind string
+
+const (
+	diagramNodeGateway  diagramNodeKind = "gateway"
+	diagramNodeSpine    diagramNodeKind = "spine"
+	diagramNodeLeaf     diagramNodeKind = "leaf"
+	diagramNodeServer   diagramNodeKind = "server"
+	diagramNodeExternal diagramNodeKind = "external"
+)
+
+// diagramNodeKinds is the list of node kinds in the top to bottom order they are placed in the diagram
+var diagramNodeKinds = []diagramNodeKind{
+	diagramNodeGateway,
+	diagramNodeSpine,
+	diagramNodeLeaf,
+	diagramNodeServer,
+	diagramNodeExternal,
+}
+
+var diagramNodeColors = map[diagramNodeKind]string{
+	diagramNodeGateway:  "#f8cecc",
+	diagramNodeSpine:    "#dae8fc",
+	diagramNodeLeaf:     "#d5e8d4",
+	diagramNodeServer:   "#fff2cc",
+	diagramNodeExternal: "#e1d5e7",
+}
+
+type diagramLinkState string
+
+const (
+	diagramLinkUnknown   diagramLinkState = ""
+	diagramLinkUp        diagramLinkState = "up"
+	diagramLinkDown      diagramLinkState = "down"
+	diagramLinkMiscabled diagramLinkState = "miscabled"
+)
+
+var diagramLinkColors = map[diagramLinkState]string{
+	diagramLinkUnknown:   "#9e9e9e",
+	diagramLinkUp:        "#2e7d32",
+	diagramLinkDown:      "#c62828",
+	diagramLinkMiscabled: "#ef6c00",
+}
+
+type diagramNode struct {
+	ID    string
+	Name  string
+	Kind  diagramNodeKind
+	Group string
+}
+
+type diagramLink struct {
+	Connection string
+	Type       string
+	From       string
+	FromPort   string
+	To         string
+	ToPort     string
+	State      diagramLinkState
+}
+
+// Label returns the link label with the local port names of both ends
+func (l *diagramLink) Label() string {
+	if l.ToPort == "" {
+		return l.FromPort
+	}
+
+	return l.FromPort + " - " + l.ToPort
+}
+
+type diagramGroup struct {
+	Name  string
+	Nodes []*diagramNode
+}
+
+type wiringDiagram struct {
+	Nodes  []*diagramNode
+	Links  []diagramLink
+	Groups []diagramGroup
+	Live   bool
+}
+
+// LinkColor returns the color of the link based on its live state or an empty string if live status isn't used
+func (d *wiringDiagram) LinkColor(link *diagramLink) string {
+	if !d.Live {
+		return ""
+	}
+
+	return diagramLinkColors[link.State]
+}
+
+// Ungrouped returns the nodes that don't belong to any redundancy group
+func (d *wiringDiagram) Ungrouped() []*diagramNode {
+	return slices.DeleteFunc(slices.Clone(d.Nodes), func(node *diagramNode) bool {
+		return node.Group != ""
+	})
+}
+
+func buildWiringDiagram(data *wiringDiagramData, live bool) *wiringDiagram {
+	nodes := map[string]*diagramNode{}
+	node := func(key, name string, kind diagramNodeKind) *diagramNode {
+		if n, ok := nodes[key]; ok {
+			return n
+		}
+		n := &diagramNode{Name: name, Kind: kind}
+		nodes[key] = n
+
+		return n
+	}
+
+	for _, sw := range data.Switches {
+		kind := diagramNodeLeaf
+		if sw.Spec.Role.IsSpine() {
+			kind = diagramNodeSpine
+		}
+		node(sw.Name, sw.Name, kind).Group = sw.Spec.Redundancy.Group
+	}
+	for _, server := range data.Servers {
+		node(server.Name, server.Name, diagramNodeServer)
+	}
+
+	conns := slices.Clone(data.Connections)
+	slices.SortFunc(conns, func(a, b wiringapi.Connection) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	type rawLink struct {
+		from     *diagramNode
+		fromPort wiringapi.BasePortName
+		to       *diagramNode
+		toPort   string
+		switches []wiringapi.BasePortName
+	}
+
+	rawLinks := map[*wiringapi.Connection][]rawLink{}
+	for idx := range conns {
+		conn := &conns[idx]
+		spec := &conn.Spec
+
+		switchToSwitch := func(a, b wiringapi.BasePortName) {
+			rawLinks[conn] = append(rawLinks[conn], rawLink{
+				from:     node(a.DeviceName(), a.DeviceName(), diagramNodeLeaf),
+				fromPort: a,
+				to:       node(b.DeviceName(), b.DeviceName(), diagramNodeLeaf),
+				toPort:   b.LocalPortName(),
+				switches: []wiringapi.BasePortName{a, b},
+			})
+		}
+		switchToOther := func(sw wiringapi.BasePortName, other *diagramNode, otherPort string) {
+			rawLinks[conn] = append(rawLinks[conn], rawLink{
+				from:     node(sw.DeviceName(), sw.DeviceName(), diagramNodeLeaf),
+				fromPort: sw,
+				to:       other,
+				toPort:   otherPort,
+				switches: []wiringapi.BasePortName{sw},
+			})
+		}
+		serverLinks := func(links []wiringapi.ServerToSwitchLink) {
+			for _, link := range links {
+				server := node(link.Server.DeviceName(), link.Server.DeviceName(), diagramNodeServer)
+				switchToOther(link.Switch, server, link.Server.LocalPortName())
+			}
+		}
+
+		switch {
+		case spec.Unbundled != nil:
+			serverLinks([]wiringapi.ServerToSwitchLink{spec.Unbundled.Link})
+		case spec.Bundled != nil:
+			serverLinks(spec.Bundled.Links)
+		case spec.MCLAG != nil:
+			serverLinks(spec.MCLAG.Links)
+		case spec.ESLAG != nil:
+			serverLinks(spec.ESLAG.Links)
+		case spec.MCLAGDomain != nil:
+			for _, link := range append(slices.Clone(spec.MCLAGDomain.PeerLinks), spec.MCLAGDomain.SessionLinks...) {
+				switchToSwitch(link.Switch1, link.Switch2)
+			}
+		case spec.Fabric != nil:
+			for _, link := range spec.Fabric.Links {
+				node(link.Spine.DeviceName(), link.Spine.DeviceName(), diagramNodeSpine)
+				switchToSwitch(link.Spine.BasePortName, link.Leaf.BasePortName)
+			}
+		case spec.Mesh != nil:
+			for _, link := range spec.Mesh.Links {
+				switchToSwitch(link.Leaf1.BasePortName, link.Leaf2.BasePortName)
+			}
+		case spec.Gateway != nil:
+			for _, link := range spec.Gateway.Links {
+				gw := node(link.Gateway.DeviceName(), link.Gateway.DeviceName(), diagramNodeGateway)
+				switchToOther(link.Switch.BasePortName, gw, link.Gateway.LocalPortName())
+			}
+		case spec.VPCLoopback != nil:
+			for _, link := range spec.VPCLoopback.Links {
+				switchToSwitch(link.Switch1, link.Switch2)
+			}
+		case spec.External != nil:
+			ext := node("external/"+conn.Name, conn.Name, diagramNodeExternal)
+			for _, port := range spec.External.SwitchPorts() {
+				switchToOther(port, ext, "")
+			}
+		case spec.StaticExternal != nil:
+			ext := node("external/"+conn.Name, conn.Name, diagramNodeExternal)
+			switchToOther(spec.StaticExternal.Link.Switch.BasePortName, ext, "")
+		}
+	}
+
+	d := &wiringDiagram{Live: live}
+	for _, n := range nodes {
+		d.Nodes = append(d.Nodes, n)
+	}
+	slices.SortFunc(d.Nodes, func(a, b *diagramNode) int {
+		return cmp.Or(
+			cmp.Compare(slices.Index(diagramNodeKinds, a.Kind), slices.Index(diagramNodeKinds, b.Kind)),
+			strings.Compare(a.Group, b.Group),
+			strings.Compare(a.Name, b.Name),
+		)
+	})
+	for idx, n := range d.Nodes {
+		n.ID = fmt.Sprintf("n%d", idx)
+
+		if n.Group == "" {
+			continue
+		}
+		if len(d.Groups) == 0 || d.Groups[len(d.Groups)-1].Name != n.Group {
+			d.Groups = append(d.Groups, diagramGroup{Name: n.Group})
+		}
+		d.Groups[len(d.Groups)-1].Nodes = append(d.Groups[len(d.Groups)-1].Nodes, n)
+	}
+
+	for idx := range conns {
+		conn := &conns[idx]
+		for _, raw := range rawLinks[conn] {
+			link := diagramLink{
+				Connection: conn.Name,
+				Type:       conn.Spec.Type(),
+				From:       raw.from.ID,
+				FromPort:   raw.fromPort.LocalPortName(),
+				To:         raw.to.ID,
+				ToPort:     raw.toPort,
+			}
+			if live {
+				link.State = diagramLinkLiveState(data.Agents, conn, raw.switches)
+			}
+
+			d.Links = append(d.Links, link)
+		}
+	}
+
+	return d
+}
+
+// diagramLinkLiveState returns the link state based on the oper status of the switch ports reported by the agents and
+// the cabling verification status of the connection
+func diagramLinkLiveState(agents map[string]*agentapi.Agent, conn *wiringapi.Connection, ports []wiringapi.BasePortName) diagramLinkState {
+	state := diagramLinkUnknown
+	for _, port := range ports {
+		for _, link := range conn.Status.Links {
+			if link.Port == port.PortName() && link.State == wiringapi.ConnectionLinkStateMiscabled {
+				return diagramLinkMiscabled
+			}
+		}
+
+		agent, ok := agents[port.DeviceName()]
+		if !ok {
+			continue
+		}
+		iface, ok := agent.Status.State.Interfaces[port.LocalPortName()]
+		if !ok {
+			continue
+		}
+
+		if iface.OperStatus != agentapi.OperStatusUp {
+			state = diagramLinkDown
+		} else if state == diagramLinkUnknown {
+			state = diagramLinkUp
+		}
+	}
+
+	return state
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+func renderWiringDiagramDOT(w io.Writer, d *wiringDiagram) error {
+	b := &strings.Builder{}
+
+	b.WriteString("graph wiring {\n")
+	b.WriteString("\trankdir=TB;\n")
+	b.WriteString("\tnode [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+	b.WriteString("\tedge [fontname=\"Helvetica\", fontsize=10];\n")
+
+	dotNode := func(indent string, n *diagramNode) {
+		fmt.Fprintf(b, "%s%s [label=%s, fillcolor=%s];\n", indent, n.ID, dotQuote(n.Name), dotQuote(diagramNodeColors[n.Kind]))
+	}
+
+	for idx, group := range d.Groups {
+		fmt.Fprintf(b, "\n\tsubgraph cluster_%d {\n", idx)
+		fmt.Fprintf(b, "\t\tlabel=%s;\n", dotQuote(group.Name))
+		b.WriteString("\t\tstyle=dashed;\n")
+		for _, n := range group.Nodes {
+			dotNode("\t\t", n)
+		}
+		b.WriteString("\t}\n")
+	}
+
+	b.WriteString("\n")
+	for _, n := range d.Ungrouped() {
+		dotNode("\t", n)
+	}
+
+	b.WriteString("\n")
+	for _, kind := range diagramNodeKinds {
+		ids := []string{}
+		for _, n := range d.Nodes {
+			if n.Kind == kind {
+				ids = append(ids, n.ID)
+			}
+		}
+		if len(ids) > 0 {
+			fmt.Fprintf(b, "\t{ rank=same; %s; }\n", strings.Join(ids, "; "))
+		}
+	}
+
+	b.WriteString("\n")
+	for _, link := range d.Links {
+		attrs := []string{
+			"taillabel=" + dotQuote(link.FromPort),
+		}
+		if link.ToPort != "" {
+			attrs = append(attrs, "headlabel="+dotQuote(link.ToPort))
+		}
+		attrs = append(attrs, "tooltip="+dotQuote(link.Connection+" ("+link.Type+")"))
+		if color := d.LinkColor(&link); color != "" {
+			attrs = append(attrs, "color="+dotQuote(color), "penwidth=2")
+		}
+
+		fmt.Fprintf(b, "\t%s -- %s [%s];\n", link.From, link.To, strings.Join(attrs, ", "))
+	}
+
+	b.WriteString("}\n")
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("writing diagram: %w", err)
+	}
+
+	return nil
+}
+
+func mermaidQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+}
+
+func renderWiringDiagramMermaid(w io.Writer, d *wiringDiagram) error {
+	b := &strings.Builder{}
+
+	b.WriteString("graph TD\n")
+
+	for idx, group := range d.Groups {
+		fmt.Fprintf(b, "    subgraph g%d [%s]\n", idx, mermaidQuote(group.Name))
+		for _, n := range group.Nodes {
+			fmt.Fprintf(b, "        %s[%s]\n", n.ID, mermaidQuote(n.Name))
+		}
+		b.WriteString("    end\n")
+	}
+
+	for _, n := range d.Ungrouped() {
+		fmt.Fprintf(b, "    %s[%s]\n", n.ID, mermaidQuote(n.Name))
+	}
+
+	for _, link := range d.Links {
+		fmt.Fprintf(b, "    %s ---|%s| %s\n", link.From, mermaidQuote(link.Label()), link.To)
+	}
+
+	for _, kind := range diagramNodeKinds {
+		ids := []string{}
+		for _, n := range d.Nodes {
+			if n.Kind == kind {
+				ids = append(ids, n.ID)
+			}
+		}
+		if len(ids) > 0 {
+			fmt.Fprintf(b, "    classDef %s fill:%s\n", kind, diagramNodeColors[kind])
+			fmt.Fprintf(b, "    class %s %s\n", strings.Join(ids, ","), kind)
+		}
+	}
+
+	for idx, link := range d.Links {
+		if color := d.LinkColor(&link); color != "" {
+			fmt.Fprintf(b, "    linkStyle %d stroke:%s,stroke-width:2px\n", idx, color)
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("writing diagram: %w", err)
+	}
+
+	return nil
+}
+
+type drawioFile struct {
+	XMLName xml.Name      `xml:"mxfile"`
+	Host    string        `xml:"host,attr"`
+	Diagram drawioDiagram `xml:"diagram"`
+}
+
+type drawioDiagram struct {
+	ID    string      `xml:"id,attr"`
+	Name  string      `xml:"name,attr"`
+	Model drawioModel `xml:"mxGraphModel"`
+}
+
+type drawioModel struct {
+	Cells []drawioCell `xml:"root>mxCell"`
+}
+
+type drawioCell struct {
+	ID          string          `xml:"id,attr"`
+	Value       string          `xml:"value,attr,omitempty"`
+	Style       string          `xml:"style,attr,omitempty"`
+	Vertex      string          `xml:"vertex,attr,omitempty"`
+	Edge        string          `xml:"edge,attr,omitempty"`
+	Connectable string          `xml:"connectable,attr,omitempty"`
+	Parent      string          `xml:"parent,attr,omitempty"`
+	Source      string          `xml:"source,attr,omitempty"`
+	Target      string          `xml:"target,attr,omitempty"`
+	Geometry    *drawioGeometry `xml:"mxGeometry"`
+}
+
+type drawioGeometry struct {
+	X        float64      `xml:"x,attr,omitempty"`
+	Y        float64      `xml:"y,attr,omitempty"`
+	Width    float64      `xml:"width,attr,omitempty"`
+	Height   float64      `xml:"height,attr,omitempty"`
+	Relative string       `xml:"relative,attr,omitempty"`
+	As       string       `xml:"as,attr"`
+	Offset   *drawioPoint `xml:"mxPoint,omitempty"`
+}
+
+type drawioPoint struct {
+	As string `xml:"as,attr"`
+}
+
+const (
+	drawioNodeWidth  = 120
+	drawioNodeHeight = 40
+	drawioNodeGapX   = 40
+	drawioNodeGapY   = 160
+	drawioGroupPad   = 20
+)
+
+func renderWiringDiagramDrawio(w io.Writer, d *wiringDiagram) error {
+	cells := []drawioCell{
+		{ID: "0"},
+		{ID: "1", Parent: "0"},
+	}
+
+	// nodes are placed in rows by kind and already sorted by group, so the redundancy group members are adjacent
+	geometry := map[string]*drawioGeometry{}
+	row := 0
+	for _, kind := range diagramNodeKinds {
+		col := 0
+		for _, n := range d.Nodes {
+			if n.Kind != kind {
+				continue
+			}
+
+			geometry[n.ID] = &drawioGeometry{
+				X:      float64(drawioGroupPad + col*(drawioNodeWidth+drawioNodeGapX)),
+				Y:      float64(drawioGroupPad + row*(drawioNodeHeight+drawioNodeGapY)),
+				Width:  drawioNodeWidth,
+				Height: drawioNodeHeight,
+				As:     "geometry",
+			}
+			col++
+		}
+		if col > 0 {
+			row++
+		}
+	}
+
+	// groups go first to be rendered below the nodes
+	for idx, group := range d.Groups {
+		minX, minY, maxX, maxY := -1.0, -1.0, 0.0, 0.0
+		for _, n := range group.Nodes {
+			g := geometry[n.ID]
+			if minX < 0 || g.X < minX {
+				minX = g.X
+			}
+			if minY < 0 || g.Y < minY {
+				minY = g.Y
+			}
+			maxX = max(maxX, g.X+g.Width)
+			maxY = max(maxY, g.Y+g.Height)
+		}
+
+		cells = append(cells, drawioCell{
+			ID:     fmt.Sprintf("g%d", idx),
+			Value:  group.Name,
+			Style:  "rounded=1;dashed=1;fillColor=none;verticalAlign=top;align=left;spacingLeft=4;",
+			Vertex: "1",
+			Parent: "1",
+			Geometry: &drawioGeometry{
+				X:      minX - drawioGroupPad/2,
+				Y:      minY - drawioGroupPad,
+				Width:  maxX - minX + drawioGroupPad,
+				Height: maxY - minY + drawioGroupPad*3/2,
+				As:     "geometry",
+			},
+		})
+	}
+
+	for _, n := range d.Nodes {
+		cells = append(cells, drawioCell{
+			ID:       n.ID,
+			Value:    n.Name,
+			Style:    fmt.Sprintf("rounded=1;whiteSpace=wrap;html=1;fillColor=%s;", diagramNodeColors[n.Kind]),
+			Vertex:   "1",
+			Parent:   "1",
+			Geometry: geometry[n.ID],
+		})
+	}
+
+	for idx, link := range d.Links {
+		style := "endArrow=none;html=1;"
+		if color := d.LinkColor(&link); color != "" {
+			style += fmt.Sprintf("strokeColor=%s;strokeWidth=2;", color)
+		}
+
+		edgeID := fmt.Sprintf("e%d", idx)
+		cells = append(cells, drawioCell{
+			ID:       edgeID,
+			Style:    style,
+			Edge:     "1",
+			Parent:   "1",
+			Source:   link.From,
+			Target:   link.To,
+			Geometry: &drawioGeometry{Relative: "1", As: "geometry"},
+		})
+
+		// port names are placed next to the corresponding link ends
+		for _, label := range []struct {
+			suffix string
+			value  string
+			x      float64
+		}{
+			{suffix: "s", value: link.FromPort, x: -0.7},
+			{suffix: "t", value: link.ToPort, x: 0.7},
+		} {
+			if label.value == "" {
+				continue
+			}
+
+			cells = append(cells, drawioCell{
+				ID:          edgeID + label.suffix,
+				Value:       label.value,
+				Style:       "edgeLabel;html=1;align=center;verticalAlign=middle;resizable=0;points=[];fontSize=10;",
+				Vertex:      "1",
+				Connectable: "0",
+				Parent:      edgeID,
+				Geometry:    &drawioGeometry{X: label.x, Relative: "1", As: "geometry", Offset: &drawioPoint{As: "offset"}},
+			})
+		}
+	}
+
+	out, err := xml.MarshalIndent(drawioFile{
+		Host: "hhfctl",
+		Diagram: drawioDiagram{
+			ID:    "wiring",
+			Name:  "Wiring",
+			Model: drawioModel{Cells: cells},
+		},
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling drawio diagram: %w", err)
+	}
+
+	if _, err := w.Write(append(out, '\n')); err != nil {
+		return fmt.Errorf("writing drawio diagram: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package hhfctl
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const diagramTestWiring = `
+apiVersion: wiring.githedgehog.com/v1beta1
+kind: Switch
+metadata:
+  name: spine-01
+spec:
+  role: spine
+  profile: vs
+---
+apiVersion: wiring.githedgehog.com/v1beta1
+kind: Switch
+metadata:
+  name: leaf-01
+spec:
+  role: server-leaf
+  profile: vs
+  redundancy:
+    group: eslag-1
+    type: eslag
+---
+apiVersion: wiring.githedgehog.com/v1beta1
+kind: Switch
+metadata:
+  name: leaf-02
+spec:
+  role: server-leaf
+  profile: vs
+  redundancy:
+    group: eslag-1
+    type: eslag
+---
+apiVersion: wiring.githedgehog.com/v1beta1
+kind: Server
+metadata:
+  name: server-01
+---
+apiVersion: wiring.githedgehog.com/v1beta1
+kind: Connection
+metadata:
+  name: server-01--eslag--leaf-01--leaf-02
+spec:
+  eslag:
+    links:
+    - server: {port: server-01/enp2s1}
+      switch: {port: leaf-01/E1/1}
+    - server: {port: server-01/enp2s2}
+      switch: {port: leaf-02/E1/1}
+---
+apiVersion: wiring.githedgehog.com/v1beta1
+kind: Connection
+metadata:
+  name: spine-01--fabric--leaf-01
+spec:
+  fabric:
+    links:
+    - spine: {port: spine-01/E1/1, ip: 172.30.0.0/31}
+      leaf: {port: leaf-01/E1/8, ip: 172.30.0.1/31}
+---
+apiVersion: wiring.githedgehog.com/v1beta1
+kind: Connection
+metadata:
+  name: leaf-02--external--edge
+spec:
+  external:
+    link:
+      switch: {port: leaf-02/E1/5}
+---
+apiVersion: vpc.githedgehog.com/v1beta1
+kind: VPC
+metadata:
+  name: vpc-1
+---
+apiVersion: agent.githedgehog.com/v1beta1
+kind: Agent
+metadata:
+  name: leaf-01
+status:
+  state:
+    interfaces:
+      E1/1: {oper: up}
+      E1/8: {oper: down}
+`
+
+func TestWiringDiagram(t *testing.T) {
+	data, err := loadWiringDiagramData(strings.NewReader(diagramTestWiring))
+	require.NoError(t, err)
+	require.Len(t, data.Switches, 3)
+	require.Len(t, data.Servers, 1)
+	require.Len(t, data.Connections, 3)
+	require.Len(t, data.Agents, 1)
+
+	t.Run("dot", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, renderWiringDiagramDOT(buf, buildWiringDiagram(data, false)))
+		require.Equal(t, `graph wiring {
+	rankdir=TB;
+	node [shape=box, style="rounded,filled", fontname="Helvetica"];
+	edge [fontname="Helvetica", fontsize=10];
+
+	subgraph cluster_0 {
+		label="eslag-1";
+		style=dashed;
+		n1 [label="leaf-01", fillcolor="#d5e8d4"];
+		n2 [label="leaf-02", fillcolor="#d5e8d4"];
+	}
+
+	n0 [label="spine-01", fillcolor="#dae8fc"];
+	n3 [label="server-01", fillcolor="#fff2cc"];
+	n4 [label="leaf-02--external--edge", fillcolor="#e1d5e7"];
+
+	{ rank=same; n0; }
+	{ rank=same; n1; n2; }
+	{ rank=same; n3; }
+	{ rank=same; n4; }
+
+	n2 -- n4 [taillabel="E1/5", tooltip="leaf-02--external--edge (external)"];
+	n1 -- n3 [taillabel="E1/1", headlabel="enp2s1", tooltip="server-01--eslag--leaf-01--leaf-02 (eslag)"];
+	n2 -- n3 [taillabel="E1/1", headlabel="enp2s2", tooltip="server-01--eslag--leaf-01--leaf-02 (eslag)"];
+	n0 -- n1 [taillabel="E1/1", headlabel="E1/8", tooltip="spine-01--fabric--leaf-01 (fabric)"];
+}
+`, buf.String())
+	})
+
+	t.Run("mermaid-live", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, renderWiringDiagramMermaid(buf, buildWiringDiagram(data, true)))
+		require.Equal(t, `graph TD
+    subgraph g0 ["eslag-1"]
+        n1["leaf-01"]
+        n2["leaf-02"]
+    end
+    n0["spine-01"]
+    n3["server-01"]
+    n4["leaf-02--external--edge"]
+    n2 ---|"E1/5"| n4
+    n1 ---|"E1/1 - enp2s1"| n3
+    n2 ---|"E1/1 - enp2s2"| n3
+    n0 ---|"E1/1 - E1/8"| n1
+    classDef spine fill:#dae8fc
+    class n0 spine
+    classDef leaf fill:#d5e8d4
+    class n1,n2 leaf
+    classDef server fill:#fff2cc
+    class n3 server
+    classDef external fill:#e1d5e7
+    class n4 external
+    linkStyle 0 stroke:#9e9e9e,stroke-width:2px
+    linkStyle 1 stroke:#2e7d32,stroke-width:2px
+    linkStyle 2 stroke:#9e9e9e,stroke-width:2px
+    linkStyle 3 stroke:#c62828,stroke-width:2px
+`, buf.String())
+	})
+
+	t.Run("drawio", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, renderWiringDiagramDrawio(buf, buildWiringDiagram(data, false)))
+
+		file := &drawioFile{}
+		require.NoError(t, xml.Unmarshal(buf.Bytes(), file))
+
+		vertices, edges := 0, 0
+		for _, cell := range file.Diagram.Model.Cells {
+			if cell.Vertex == "1" && cell.Connectable == "" {
+				vertices++
+			}
+			if cell.Edge == "1" {
+				edges++
+			}
+		}
+		require.Equal(t, 6, vertices, "5 nodes and 1 redundancy group")
+		require.Equal(t, 4, edges)
+	})
+}
@@ -26,6 +26,7 @@ import (
 	agentapi "go.githedgehog.com/fabric/api/agent/v1beta1"
 	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
 	"go.githedgehog.com/fabric/pkg/util/kubeutil"
+	"go.githedgehog.com/fabric/pkg/util/pointer"
 	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -265,12 +266,12 @@ func SwitchRoCE(ctx context.Context, name string, value *bool) error {
 	}
 
 	if value == nil {
-		sw.Spec.RoCE = !sw.Spec.RoCE
+		sw.Spec.RoCE = pointer.To(!sw.Spec.IsRoCEEnabled())
 	} else {
-		sw.Spec.RoCE = *value
+		sw.Spec.RoCE = pointer.To(*value)
 	}
 
-	slog.Info("Setting RoCE mode", "switch", name, "roce", sw.Spec.RoCE)
+	slog.Info("Setting RoCE mode", "switch", name, "roce", *sw.Spec.RoCE)
 
 	err = kube.Update(ctx, sw)
 	if err != nil {
@@ -292,12 +293,12 @@ func SwitchECMPRoCEQPN(ctx context.Context, name string, value *bool) error {
 	}
 
 	if value == nil {
-		sw.Spec.ECMP.RoCEQPN = !sw.Spec.ECMP.RoCEQPN
+		sw.Spec.ECMP.RoCEQPN = pointer.To(!sw.Spec.ECMP.IsRoCEQPNEnabled())
 	} else {
-		sw.Spec.ECMP.RoCEQPN = *value
+		sw.Spec.ECMP.RoCEQPN = pointer.To(*value)
 	}
 
-	slog.Info("Setting ECMP RoCE QPN", "switch", name, "qpn", sw.Spec.ECMP.RoCEQPN)
+	slog.Info("Setting ECMP RoCE QPN", "switch", name, "qpn", *sw.Spec.ECMP.RoCEQPN)
 
 	err = kube.Update(ctx, sw)
 	if err != nil {
@@ -64,6 +64,12 @@ func WiringExport(ctx context.Context, opts WiringExportOptions) error {
 		}
 	}
 
+	if opts.VPCs {
+		if err := kubeutil.PrintObjectList(ctx, kube, out, &vpcapi.IPv6NamespaceList{}, objs); err != nil {
+			return fmt.Errorf("printing ipv6 namespaces: %w", err)
+		}
+	}
+
 	if opts.VPCs {
 		if err := kubeutil.PrintObjectList(ctx, kube, out, &vpcapi.VPCList{}, objs); err != nil {
 			return fmt.Errorf("printing vpcs: %w", err)
@@ -76,6 +82,10 @@ func WiringExport(ctx context.Context, opts WiringExportOptions) error {
 		if err := kubeutil.PrintObjectList(ctx, kube, out, &vpcapi.VPCPeeringList{}, objs); err != nil {
 			return fmt.Errorf("printing vpc peerings: %w", err)
 		}
+
+		if err := kubeutil.PrintObjectList(ctx, kube, out, &vpcapi.SecurityPolicyList{}, objs); err != nil {
+			return fmt.Errorf("printing security policies: %w", err)
+		}
 	}
 
 	if opts.Externals {
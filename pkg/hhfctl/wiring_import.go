@@ -0,0 +1,386 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package hhfctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
+	wiringapi "go.githedgehog.com/fabric/api/wiring/v1beta1"
+	"go.githedgehog.com/fabric/pkg/util/kubeutil"
+	kmetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WiringImportTypes is the list of connection types supported by the CSV import. Other connection types require
+// additional parameters (e.g. IPs) and should be defined in the wiring YAML directly.
+var WiringImportTypes = []string{
+	wiringapi.ConnectionTypeUnbundled,
+	wiringapi.ConnectionTypeBundled,
+	wiringapi.ConnectionTypeESLAG,
+	wiringapi.ConnectionTypeExternal,
+	wiringapi.ConnectionTypeVPCLoopback,
+}
+
+type WiringImportOptions struct {
+	CSV    string
+	Output string
+}
+
+// WiringImport generates Server and Connection objects from the cabling CSV with the rows in the following format:
+// switch, port, remote device, remote port, connection type and optional bundle group. Rows of the same connection
+// type sharing the bundle group are combined into a single connection (e.g. bundled or eslag).
+func WiringImport(ctx context.Context, opts WiringImportOptions) error {
+	f, err := os.Open(opts.CSV)
+	if err != nil {
+		return fmt.Errorf("opening csv file: %w", err)
+	}
+	defer f.Close()
+
+	servers, conns, err := importWiringCSV(ctx, f)
+	if err != nil {
+		return fmt.Errorf("importing %s: %w", opts.CSV, err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := printWiringImport(buf, servers, conns); err != nil {
+		return err
+	}
+
+	if opts.Output == "" || opts.Output == "-" {
+		if _, err := io.Copy(os.Stdout, buf); err != nil {
+			return fmt.Errorf("writing wiring: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := os.WriteFile(opts.Output, buf.Bytes(), 0o644); err != nil { //nolint:gosec
+		return fmt.Errorf("writing wiring to %s: %w", opts.Output, err)
+	}
+
+	slog.Info("Wiring imported", "servers", len(servers), "connections", len(conns), "file", opts.Output)
+
+	return nil
+}
+
+type wiringImportRow struct {
+	Line         int
+	Switch       string
+	Port         string
+	RemoteDevice string
+	RemotePort   string
+	Type         string
+	BundleGroup  string
+}
+
+func (row wiringImportRow) switchPort() wiringapi.BasePortName {
+	return wiringapi.BasePortName{Port: row.Switch + "/" + row.Port}
+}
+
+func (row wiringImportRow) remotePort() wiringapi.BasePortName {
+	return wiringapi.BasePortName{Port: row.RemoteDevice + "/" + row.RemotePort}
+}
+
+func readWiringImportRows(r io.Reader) ([]wiringImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	rows := []wiringImportRow{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading csv: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		for idx := range record {
+			record[idx] = strings.TrimSpace(record[idx])
+		}
+
+		if len(rows) == 0 && strings.EqualFold(record[0], "switch") {
+			continue // header
+		}
+		if len(record) == 1 && record[0] == "" {
+			continue
+		}
+		if len(record) < 5 || len(record) > 6 {
+			return nil, fmt.Errorf("line %d: expected 5 or 6 columns (switch, port, remote device, remote port, type, bundle group), got %d", line, len(record)) //nolint:goerr113
+		}
+
+		row := wiringImportRow{
+			Line:         line,
+			Switch:       record[0],
+			Port:         record[1],
+			RemoteDevice: record[2],
+			RemotePort:   record[3],
+			Type:         strings.ToLower(record[4]),
+		}
+		if len(record) == 6 {
+			row.BundleGroup = record[5]
+		}
+
+		if row.Switch == "" || row.Port == "" {
+			return nil, fmt.Errorf("line %d: switch and port are required", line) //nolint:goerr113
+		}
+
+		switch row.Type {
+		case wiringapi.ConnectionTypeUnbundled, wiringapi.ConnectionTypeBundled, wiringapi.ConnectionTypeESLAG:
+			if row.RemoteDevice == "" || row.RemotePort == "" {
+				return nil, fmt.Errorf("line %d: remote device and port are required for %s connection", line, row.Type) //nolint:goerr113
+			}
+			if row.Type == wiringapi.ConnectionTypeUnbundled && row.BundleGroup != "" {
+				return nil, fmt.Errorf("line %d: bundle group isn't allowed for unbundled connection", line) //nolint:goerr113
+			}
+		case wiringapi.ConnectionTypeVPCLoopback:
+			if row.RemoteDevice != "" && row.RemoteDevice != row.Switch {
+				return nil, fmt.Errorf("line %d: vpc-loopback remote device should be the same switch %s", line, row.Switch) //nolint:goerr113
+			}
+			if row.RemotePort == "" {
+				return nil, fmt.Errorf("line %d: remote port is required for vpc-loopback connection", line) //nolint:goerr113
+			}
+		case wiringapi.ConnectionTypeExternal:
+			// remote device and port are informational only
+		case "":
+			return nil, fmt.Errorf("line %d: connection type is required", line) //nolint:goerr113
+		default:
+			return nil, fmt.Errorf("line %d: connection type %q isn't supported for import, supported: %s, other types require additional parameters and should be defined in the wiring YAML", //nolint:goerr113
+				line, row.Type, strings.Join(WiringImportTypes, ", "))
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func importWiringCSV(ctx context.Context, r io.Reader) ([]*wiringapi.Server, []*wiringapi.Connection, error) {
+	rows, err := readWiringImportRows(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usedPorts := map[string]int{}
+	for _, row := range rows {
+		ports := []string{row.switchPort().Port}
+		if row.Type == wiringapi.ConnectionTypeVPCLoopback {
+			ports = append(ports, row.Switch+"/"+row.RemotePort)
+		} else if row.Type != wiringapi.ConnectionTypeExternal {
+			ports = append(ports, row.remotePort().Port)
+		}
+
+		for _, port := range ports {
+			if prev, exist := usedPorts[port]; exist {
+				return nil, nil, fmt.Errorf("line %d: port %s is already used in line %d", row.Line, port, prev) //nolint:goerr113
+			}
+			usedPorts[port] = row.Line
+		}
+	}
+
+	// group rows into connections preserving the order of the first row of each group
+	groups := [][]wiringImportRow{}
+	groupIdx := map[string]int{}
+	for _, row := range rows {
+		key := ""
+		switch {
+		case row.Type == wiringapi.ConnectionTypeVPCLoopback:
+			key = row.Type + "/" + row.Switch + "/" + row.BundleGroup
+		case row.BundleGroup != "":
+			key = row.Type + "/" + row.BundleGroup
+		}
+
+		if key != "" {
+			if idx, exist := groupIdx[key]; exist {
+				groups[idx] = append(groups[idx], row)
+
+				continue
+			}
+			groupIdx[key] = len(groups)
+		}
+
+		groups = append(groups, []wiringImportRow{row})
+	}
+
+	servers := []*wiringapi.Server{}
+	serverNames := map[string]bool{}
+	conns := []*wiringapi.Connection{}
+	connNames := map[string]bool{}
+
+	for _, group := range groups {
+		spec := wiringImportConnectionSpec(group)
+
+		lines := []string{}
+		for _, row := range group {
+			lines = append(lines, fmt.Sprintf("%d", row.Line))
+		}
+
+		name := spec.GenerateName()
+		if name == wiringapi.INVALID {
+			return nil, nil, fmt.Errorf("lines %s: can't generate %s connection name, all links should have the same server and switch", //nolint:goerr113
+				strings.Join(lines, ", "), group[0].Type)
+		}
+		for idx := 2; connNames[name]; idx++ {
+			name = fmt.Sprintf("%s--%d", spec.GenerateName(), idx)
+		}
+		connNames[name] = true
+
+		conn := &wiringapi.Connection{
+			TypeMeta: kmetav1.TypeMeta{
+				APIVersion: wiringapi.GroupVersion.String(),
+				Kind:       wiringapi.KindConnection,
+			},
+			ObjectMeta: kmetav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: spec,
+		}
+		conn.Default()
+		if _, err := conn.Validate(ctx, nil, nil); err != nil {
+			return nil, nil, fmt.Errorf("lines %s: validating connection %s: %w", strings.Join(lines, ", "), name, err)
+		}
+		conns = append(conns, conn)
+
+		if !slices.Contains(wiringapi.ConnectionTypesServerFacing, group[0].Type) {
+			continue
+		}
+
+		for _, row := range group {
+			if serverNames[row.RemoteDevice] {
+				continue
+			}
+			serverNames[row.RemoteDevice] = true
+
+			server := &wiringapi.Server{
+				TypeMeta: kmetav1.TypeMeta{
+					APIVersion: wiringapi.GroupVersion.String(),
+					Kind:       wiringapi.KindServer,
+				},
+				ObjectMeta: kmetav1.ObjectMeta{
+					Name: row.RemoteDevice,
+				},
+			}
+			server.Default()
+			if _, err := server.Validate(ctx, nil, nil); err != nil {
+				return nil, nil, fmt.Errorf("line %d: validating server %s: %w", row.Line, row.RemoteDevice, err)
+			}
+			servers = append(servers, server)
+		}
+	}
+
+	slices.SortFunc(servers, func(a, b *wiringapi.Server) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	slices.SortFunc(conns, func(a, b *wiringapi.Connection) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return servers, conns, nil
+}
+
+func wiringImportConnectionSpec(group []wiringImportRow) wiringapi.ConnectionSpec {
+	spec := wiringapi.ConnectionSpec{}
+
+	serverLinks := []wiringapi.ServerToSwitchLink{}
+	for _, row := range group {
+		serverLinks = append(serverLinks, wiringapi.ServerToSwitchLink{
+			Server: row.remotePort(),
+			Switch: row.switchPort(),
+		})
+	}
+
+	switch group[0].Type {
+	case wiringapi.ConnectionTypeUnbundled:
+		spec.Unbundled = &wiringapi.ConnUnbundled{
+			Link: serverLinks[0],
+		}
+	case wiringapi.ConnectionTypeBundled:
+		spec.Bundled = &wiringapi.ConnBundled{
+			Links: serverLinks,
+		}
+	case wiringapi.ConnectionTypeESLAG:
+		spec.ESLAG = &wiringapi.ConnESLAG{
+			Links: serverLinks,
+		}
+	case wiringapi.ConnectionTypeVPCLoopback:
+		spec.VPCLoopback = &wiringapi.ConnVPCLoopback{}
+		for _, row := range group {
+			spec.VPCLoopback.Links = append(spec.VPCLoopback.Links, wiringapi.SwitchToSwitchLink{
+				Switch1: row.switchPort(),
+				Switch2: wiringapi.BasePortName{Port: row.Switch + "/" + row.RemotePort},
+			})
+		}
+	case wiringapi.ConnectionTypeExternal:
+		spec.External = &wiringapi.ConnExternal{}
+		if len(group) == 1 && group[0].BundleGroup == "" {
+			spec.External.Link = wiringapi.ConnExternalLink{Switch: group[0].switchPort()}
+		} else {
+			for _, row := range group {
+				spec.External.Links = append(spec.External.Links, wiringapi.ConnExternalLink{Switch: row.switchPort()})
+			}
+		}
+	}
+
+	return spec
+}
+
+func printWiringImport(w io.Writer, servers []*wiringapi.Server, conns []*wiringapi.Connection) error {
+	objs := 0
+	printList := func(kind string, list []kclient.Object) error {
+		if len(list) == 0 {
+			return nil
+		}
+
+		if objs > 0 {
+			if _, err := fmt.Fprintf(w, "---\n"); err != nil {
+				return fmt.Errorf("writing separator: %w", err)
+			}
+		}
+		if _, err := fmt.Fprintf(w, "#\n# %sList\n#\n", kind); err != nil {
+			return fmt.Errorf("writing comment: %w", err)
+		}
+
+		for idx, obj := range list {
+			if idx > 0 {
+				if _, err := fmt.Fprintf(w, "---\n"); err != nil {
+					return fmt.Errorf("writing separator: %w", err)
+				}
+			}
+			if err := kubeutil.PrintObject(obj, w, false); err != nil {
+				return fmt.Errorf("printing %s %s: %w", kind, obj.GetName(), err)
+			}
+			objs++
+		}
+
+		return nil
+	}
+
+	serverObjs := []kclient.Object{}
+	for _, server := range servers {
+		serverObjs = append(serverObjs, server)
+	}
+	if err := printList(wiringapi.KindServer, serverObjs); err != nil {
+		return err
+	}
+
+	connObjs := []kclient.Object{}
+	for _, conn := range conns {
+		connObjs = append(connObjs, conn)
+	}
+
+	return printList(wiringapi.KindConnection, connObjs)
+}
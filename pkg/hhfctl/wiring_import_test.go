@@ -0,0 +1,160 @@
+// Copyright 2026 Hedgehog
+// SPDX-License-Identifier: Apache-2.0
+
+package hhfctl
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWiringImport(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name    string
+		csv     string
+		servers []string
+		conns   []string
+		err     string
+	}{
+		{
+			name: "all-types",
+			csv: `switch,port,remote device,remote port,type,bundle group
+# comment
+leaf-01, E1/1, server-01, enp2s1, unbundled
+leaf-01, E1/2, server-02, enp2s1, bundled, b1
+leaf-01, E1/3, server-02, enp2s2, bundled, b1
+leaf-02, E1/1, server-03, enp2s1, eslag, e1
+leaf-03, E1/1, server-03, enp2s2, eslag, e1
+leaf-01, E1/4, server-04, enp2s1, unbundled
+leaf-01, E1/5, server-04, enp2s2, unbundled
+leaf-01, E1/6, leaf-01, E1/7, vpc-loopback
+leaf-01, E1/8, leaf-01, E1/9, vpc-loopback
+leaf-02, E1/10, edge-01, xe-0/0/1, external
+leaf-03, E1/10, edge-01, xe-0/0/2, external, x1
+leaf-03, E1/11, edge-01, xe-0/0/3, external, x1
+`,
+			servers: []string{"server-01", "server-02", "server-03", "server-04"},
+			conns: []string{
+				"leaf-01--vpc-loopback",
+				"leaf-02--external",
+				"leaf-03--external",
+				"server-01--unbundled--leaf-01",
+				"server-02--bundled--leaf-01",
+				"server-03--eslag--leaf-02--leaf-03",
+				"server-04--unbundled--leaf-01",
+				"server-04--unbundled--leaf-01--2",
+			},
+		},
+		{
+			name: "unsupported-type",
+			csv:  "spine-01,E1/1,leaf-01,E1/1,fabric\n",
+			err:  `line 1: connection type "fabric" isn't supported`,
+		},
+		{
+			name: "columns",
+			csv:  "leaf-01,E1/1,server-01\n",
+			err:  "line 1: expected 5 or 6 columns",
+		},
+		{
+			name: "duplicate-port",
+			csv:  "leaf-01,E1/1,server-01,enp2s1,unbundled\nleaf-01,E1/1,server-02,enp2s1,unbundled\n",
+			err:  "line 2: port leaf-01/E1/1 is already used in line 1",
+		},
+		{
+			name: "bundled-multiple-switches",
+			csv:  "leaf-01,E1/1,server-01,enp2s1,bundled,b1\nleaf-02,E1/1,server-01,enp2s2,bundled,b1\n",
+			err:  "lines 1, 2: can't generate bundled connection name",
+		},
+		{
+			name: "vpc-loopback-other-switch",
+			csv:  "leaf-01,E1/1,leaf-02,E1/2,vpc-loopback\n",
+			err:  "line 1: vpc-loopback remote device should be the same switch leaf-01",
+		},
+		{
+			name: "invalid-server-name",
+			csv:  "leaf-01,E1/1,Server_01,enp2s1,unbundled\n",
+			err:  "validating connection",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			servers, conns, err := importWiringCSV(ctx, strings.NewReader(tt.csv))
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+
+				return
+			}
+			require.NoError(t, err)
+
+			serverNames := []string{}
+			for _, server := range servers {
+				serverNames = append(serverNames, server.Name)
+			}
+			require.Equal(t, tt.servers, serverNames)
+
+			connNames := []string{}
+			for _, conn := range conns {
+				connNames = append(connNames, conn.Name)
+			}
+			require.Equal(t, tt.conns, connNames)
+		})
+	}
+}
+
+func TestWiringImportPrint(t *testing.T) {
+	servers, conns, err := importWiringCSV(context.Background(), strings.NewReader(`
+leaf-01,E1/1,server-01,enp2s1,bundled,b1
+leaf-01,E1/2,server-01,enp2s2,bundled,b1
+leaf-01,E1/3,,,external
+`))
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, printWiringImport(buf, servers, conns))
+	require.Equal(t, `#
+# ServerList
+#
+apiVersion: wiring.githedgehog.com/v1beta1
+kind: Server
+metadata:
+  name: server-01
+  namespace: default
+spec: {}
+---
+#
+# ConnectionList
+#
+apiVersion: wiring.githedgehog.com/v1beta1
+kind: Connection
+metadata:
+  name: leaf-01--external
+  namespace: default
+spec:
+  external:
+    link:
+      switch:
+        port: leaf-01/E1/3
+---
+apiVersion: wiring.githedgehog.com/v1beta1
+kind: Connection
+metadata:
+  name: server-01--bundled--leaf-01
+  namespace: default
+spec:
+  bundled:
+    links:
+    - server:
+        port: server-01/enp2s1
+      switch:
+        port: leaf-01/E1/1
+    - server:
+        port: server-01/enp2s2
+      switch:
+        port: leaf-01/E1/2
+`, buf.String())
+}
@@ -443,6 +443,16 @@ func (m *Manager) GetVPCVNI(ctx context.Context, kube kclient.Client, vpc string
 	return 0, errors.Errorf("failed to find VPC VNI for vpc %s", vpc)
 }
 
+// GetVPCVNIs returns the VPC and its subnets VNIs, it's not an error if they aren't allocated yet
+func (m *Manager) GetVPCVNIs(ctx context.Context, kube kclient.Client, vpc string) (uint32, map[string]uint32, error) {
+	vnisCat, err := m.getCatalog(ctx, kube, CatVNIs)
+	if err != nil {
+		return 0, nil, errors.Errorf("failed to get VNIs catalog %s", CatVNIs)
+	}
+
+	return vnisCat.Spec.VPCVNIs[vpc], maps.Clone(vnisCat.Spec.VPCSubnetVNIs[vpc]), nil
+}
+
 func (m *Manager) GetExternalVNI(ctx context.Context, kube kclient.Client, external string) (uint32, error) {
 	vnisCat, err := m.getCatalog(ctx, kube, CatVNIs)
 	if err != nil {
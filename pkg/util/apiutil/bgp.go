@@ -201,7 +201,7 @@ func GetBGPNeighbors(ctx context.Context, kube kclient.Reader, fabCfg *meta.Fabr
 			continue
 		}
 
-		if conn.Spec.External.Link.Switch.DeviceName() != sw.Name {
+		if conn.Spec.External.SwitchName() != sw.Name {
 			continue
 		}
 
@@ -223,7 +223,11 @@ func GetBGPNeighbors(ctx context.Context, kube kclient.Reader, fabCfg *meta.Fabr
 		neigh.RemoteName = ext.Name
 		neigh.Expected = true
 		neigh.Type = BGPNeighborTypeExternal
-		neigh.Port = conn.Spec.External.Link.Switch.LocalPortName()
+		ports := []string{}
+		for _, port := range conn.Spec.External.SwitchPorts() {
+			ports = append(ports, port.LocalPortName())
+		}
+		neigh.Port = strings.Join(ports, ",")
 		neigh.ConnectionName = conn.Name
 		neigh.ConnectionType = conn.Spec.Type()
 
@@ -37,6 +37,33 @@ type LLDPNeighborStatus struct {
 	Actual         []LLDPNeighbor   `json:"actual,omitempty"`
 }
 
+// LinkStatus returns the cabling verification status of the link on the specified switch port based on the expected
+// and actual LLDP neighbors
+func (s *LLDPNeighborStatus) LinkStatus(port string) wiringapi.ConnectionLinkStatus {
+	status := wiringapi.ConnectionLinkStatus{
+		Port:  port,
+		State: wiringapi.ConnectionLinkStateNoNeighbor,
+	}
+	if s.Expected.Name != "" {
+		status.Expected = s.Expected.Name + "/" + s.Expected.Port
+	}
+
+	for idx, actual := range s.Actual {
+		matches := s.Expected.Name == "" || actual.Name == s.Expected.Name && actual.Port == s.Expected.Port
+		if idx == 0 || matches {
+			status.Observed = actual.Name + "/" + actual.Port
+			status.State = wiringapi.ConnectionLinkStateMiscabled
+		}
+		if matches {
+			status.State = wiringapi.ConnectionLinkStateVerified
+
+			break
+		}
+	}
+
+	return status
+}
+
 func GetLLDPNeighbors(ctx context.Context, kube kclient.Reader, sw *wiringapi.Switch) (map[string]LLDPNeighborStatus, error) {
 	if sw == nil {
 		return nil, fmt.Errorf("switch is nil") //nolint:goerr113
@@ -127,16 +154,15 @@ func GetLLDPNeighbors(ctx context.Context, kube kclient.Reader, sw *wiringapi.Sw
 				return nil, fmt.Errorf("switch profile not found for %s", kDevice) //nolint:goerr113
 			}
 
-			if statusType == LLDPNeighborTypeFabric {
-				if sp, exist := swSP[vDevice]; exist {
-					port, err := sp.Spec.NormalizePortName(vPort)
-					if err != nil {
-						return nil, fmt.Errorf("normalizing port name %s: %w", vPort, err)
-					}
-					vPort = port
-				} else {
-					return nil, fmt.Errorf("switch profile not found for %s", vDevice) //nolint:goerr113
+			// remote side could be a switch not only for fabric links, e.g. for MCLAG peer links
+			if sp, exist := swSP[vDevice]; exist {
+				port, err := sp.Spec.NormalizePortName(vPort)
+				if err != nil {
+					return nil, fmt.Errorf("normalizing port name %s: %w", vPort, err)
 				}
+				vPort = port
+			} else if statusType == LLDPNeighborTypeFabric {
+				return nil, fmt.Errorf("switch profile not found for %s", vDevice) //nolint:goerr113
 			}
 
 			status, ok := out[kPort]
@@ -171,12 +197,15 @@ func GetLLDPNeighbors(ctx context.Context, kube kclient.Reader, sw *wiringapi.Sw
 				} else {
 					return nil, fmt.Errorf("expected neighbor name not found for %s while type if fabric", ifaceName) //nolint:goerr113
 				}
+			}
 
-				ports, ok := swNOS2API[status.Expected.Name]
-				if !ok {
-					return nil, fmt.Errorf("NOS ports mapping for %s not found", status.Expected.Name) //nolint:goerr113
-				}
-
+			// neighbor switch reports NOS port names, so use its mapping if it's a known switch and fall back to the
+			// expected neighbor mapping (e.g. if its hostname doesn't match the switch name)
+			ports, ok := swNOS2API[neighbor.SystemName]
+			if !ok {
+				ports, ok = swNOS2API[status.Expected.Name]
+			}
+			if ok {
 				if apiPort, ok := ports[port]; ok {
 					port = apiPort
 				} else {